@@ -3,7 +3,10 @@
 //
 // Usage:
 //
-//	go run ./cmd/codegen -schema ./schema/ -out ./pkg/docx/oxml/
+//	go run ./cmd/codegen -schema ./schema/ -out ./pkg/docx/oxml/ -tests
+//
+// With -tests it also writes a zz_gen_*_test.go file per schema exercising
+// the generated accessors.
 package main
 
 import (
@@ -21,10 +24,11 @@ import (
 func main() {
 	schemaDir := flag.String("schema", "", "Path to YAML schema directory")
 	outDir := flag.String("out", "", "Output directory for generated .go files")
+	tests := flag.Bool("tests", false, "Also generate zz_gen_*_test.go files")
 	flag.Parse()
 
 	if *schemaDir == "" || *outDir == "" {
-		fmt.Fprintf(os.Stderr, "Usage: codegen -schema <dir> -out <dir>\n")
+		fmt.Fprintf(os.Stderr, "Usage: codegen -schema <dir> -out <dir> [-tests]\n")
 		os.Exit(1)
 	}
 
@@ -45,7 +49,7 @@ func main() {
 		}
 
 		schemaPath := filepath.Join(*schemaDir, name)
-		if err := processSchema(schemaPath, *outDir); err != nil {
+		if err := processSchema(schemaPath, *outDir, *tests); err != nil {
 			fmt.Fprintf(os.Stderr, "Error processing %q: %v\n", schemaPath, err)
 			os.Exit(1)
 		}
@@ -58,7 +62,7 @@ func main() {
 	fmt.Printf("Done. Generated %d file(s).\n", count)
 }
 
-func processSchema(schemaPath, outDir string) error {
+func processSchema(schemaPath, outDir string, withTests bool) error {
 	data, err := os.ReadFile(schemaPath)
 	if err != nil {
 		return fmt.Errorf("reading %s: %w", schemaPath, err)
@@ -86,5 +90,23 @@ func processSchema(schemaPath, outDir string) error {
 		return fmt.Errorf("writing %s: %w", outPath, err)
 	}
 
+	if !withTests {
+		return nil
+	}
+	tests, err := gen.GenerateTests()
+	if err != nil {
+		return fmt.Errorf("generating tests: %w", err)
+	}
+	testPath := filepath.Join(outDir, "zz_gen_"+baseName+"_test.go")
+	if tests == nil {
+		if err := os.Remove(testPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	if err := os.WriteFile(testPath, tests, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", testPath, err)
+	}
+
 	return nil
 }
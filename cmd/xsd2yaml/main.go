@@ -0,0 +1,116 @@
+// Command xsd2yaml converts the ECMA-376 transitional XML schemas into a
+// YAML schema file for codegen. Only the complex types named in the
+// whitelist are emitted; see codegen.ParseXsdWhitelist for its format.
+//
+// Usage:
+//
+//	go run ./cmd/xsd2yaml -xsd ./ecma376/ -whitelist ./types.txt -out ./schema/table.yaml
+//
+// Attributes of an enumerated simple type list its values for validation;
+// -enum ST_Jc=enum.WdParagraphAlignment types them as an enum package type
+// instead.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/user/go-docx/internal/codegen"
+
+	"gopkg.in/yaml.v3"
+)
+
+// nsFlags collects repeated -ns prefix=uri flags.
+type nsFlags map[string]string
+
+func (f nsFlags) String() string { return "" }
+
+func (f nsFlags) Set(v string) error {
+	pfx, uri, ok := strings.Cut(v, "=")
+	if !ok || pfx == "" || uri == "" {
+		return fmt.Errorf("expected prefix=uri, got %q", v)
+	}
+	f[uri] = pfx
+	return nil
+}
+
+// enumFlags collects repeated -enum simpleType=goType flags.
+type enumFlags map[string]string
+
+func (f enumFlags) String() string { return "" }
+
+func (f enumFlags) Set(v string) error {
+	st, goType, ok := strings.Cut(v, "=")
+	if !ok || st == "" || goType == "" {
+		return fmt.Errorf("expected simpleType=goType, got %q", v)
+	}
+	f[st] = goType
+	return nil
+}
+
+func main() {
+	xsdDir := flag.String("xsd", "", "Directory holding the .xsd files (wml.xsd, dml-*.xsd, ...)")
+	whitelist := flag.String("whitelist", "", "File listing the complex types to emit")
+	pkg := flag.String("package", "oxml", "Go package name written to the schema")
+	out := flag.String("out", "", "Output YAML file (default stdout)")
+	prefixes := nsFlags{}
+	for uri, pfx := range codegen.DefaultXsdPrefixes {
+		prefixes[uri] = pfx
+	}
+	flag.Var(prefixes, "ns", "Tag prefix for a namespace, as prefix=uri (repeatable)")
+	enums := enumFlags{}
+	flag.Var(enums, "enum", "Enum type for a simple type, as ST_Name=enum.Type (repeatable)")
+	flag.Parse()
+
+	if *xsdDir == "" || *whitelist == "" {
+		fmt.Fprintf(os.Stderr, "Usage: xsd2yaml -xsd <dir> -whitelist <file> [-package <name>] [-ns prefix=uri] [-enum ST_Name=enum.Type] [-out <file>]\n")
+		os.Exit(1)
+	}
+
+	if err := run(*xsdDir, *whitelist, *pkg, *out, prefixes, enums); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(xsdDir, whitelistPath, pkg, out string, prefixes, enums map[string]string) error {
+	set, err := codegen.LoadXsdDir(xsdDir, prefixes)
+	if err != nil {
+		return fmt.Errorf("loading schemas from %s: %w", xsdDir, err)
+	}
+	set.SetEnumTypes(enums)
+
+	f, err := os.Open(whitelistPath)
+	if err != nil {
+		return err
+	}
+	types, err := codegen.ParseXsdWhitelist(f)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", whitelistPath, err)
+	}
+
+	schema, err := set.Convert(pkg, types)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(schema); err != nil {
+		return fmt.Errorf("encoding YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("encoding YAML: %w", err)
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	return os.WriteFile(out, buf.Bytes(), 0o644)
+}
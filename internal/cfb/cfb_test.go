@@ -0,0 +1,89 @@
+package cfb
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	streams := map[string][]byte{
+		"EncryptionInfo":         bytes.Repeat([]byte("info"), 100),
+		"EncryptedPackage":       bytes.Repeat([]byte{1, 2, 3, 4, 5, 6, 7}, 3000),
+		"\x06DataSpaces/Version": []byte("version"),
+		"\x06DataSpaces/DataSpaceInfo/StrongEncryptionDataSpace": []byte("dsinfo"),
+		"Empty": {},
+	}
+	w := NewWriter()
+	for path, data := range streams {
+		w.AddStream(path, data)
+	}
+	var buf bytes.Buffer
+	if _, err := w.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	if len(data)%512 != 0 {
+		t.Errorf("file size %d is not a multiple of the sector size", len(data))
+	}
+
+	r, err := Open(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	for path, want := range streams {
+		got, err := r.ReadStream(path)
+		if err != nil {
+			t.Errorf("ReadStream(%q): %v", path, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("ReadStream(%q) returned %d bytes, want %d", path, len(got), len(want))
+		}
+	}
+	if _, err := r.ReadStream("encryptioninfo"); err != nil {
+		t.Errorf("expected case-insensitive lookup: %v", err)
+	}
+	if _, err := r.ReadStream("Missing"); err == nil {
+		t.Error("expected error for missing stream")
+	}
+	if _, err := r.ReadStream("\x06DataSpaces"); err == nil {
+		t.Error("expected error reading a storage as a stream")
+	}
+}
+
+func TestRoundTrip_LargeNeedsDifat(t *testing.T) {
+	t.Parallel()
+	// More than 109 FAT sectors (109*128*512 bytes) need DIFAT sectors.
+	big := make([]byte, 8<<20)
+	for i := range big {
+		big[i] = byte(i * 7)
+	}
+	w := NewWriter()
+	w.AddStream("EncryptedPackage", big)
+	data := w.Bytes()
+
+	r, err := Open(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	got, err := r.ReadStream("EncryptedPackage")
+	if err != nil {
+		t.Fatalf("ReadStream: %v", err)
+	}
+	if !bytes.Equal(got, big) {
+		t.Error("large stream content differs")
+	}
+}
+
+func TestOpen_NotCompoundFile(t *testing.T) {
+	t.Parallel()
+	data := []byte("PK\x03\x04 definitely a zip file, padded to be long enough")
+	data = append(data, make([]byte, 512)...)
+	if IsCompoundFile(bytes.NewReader(data)) {
+		t.Error("IsCompoundFile = true for a zip header")
+	}
+	if _, err := Open(bytes.NewReader(data), int64(len(data))); err != ErrNotCompoundFile {
+		t.Errorf("Open error = %v, want ErrNotCompoundFile", err)
+	}
+}
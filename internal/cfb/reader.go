@@ -0,0 +1,303 @@
+// Package cfb reads and writes Compound File Binary files ([MS-CFB]), the
+// OLE container format Office uses to wrap password-protected OOXML
+// packages.
+//
+// Only what those containers need is supported: reading streams by path
+// from version 3 and 4 files, and writing version 3 files holding a tree of
+// storages and streams. Timestamps, CLSIDs and state bits are not preserved.
+package cfb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+// Signature is the magic number that starts every compound file.
+var Signature = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+// Special sector numbers.
+const (
+	maxRegSect = 0xFFFFFFFA
+	difSect    = 0xFFFFFFFC
+	fatSect    = 0xFFFFFFFD
+	endOfChain = 0xFFFFFFFE
+	freeSect   = 0xFFFFFFFF
+	noStream   = 0xFFFFFFFF
+)
+
+// Directory entry object types.
+const (
+	typeEmpty   = 0
+	typeStorage = 1
+	typeStream  = 2
+	typeRoot    = 5
+)
+
+const (
+	headerSize     = 512
+	dirEntrySize   = 128
+	miniSectorSize = 64
+	miniCutoff     = 4096
+	headerDifatLen = 109
+)
+
+// ErrNotCompoundFile is returned by Open when the data does not start with
+// the compound file signature.
+var ErrNotCompoundFile = errors.New("cfb: not a compound file")
+
+// IsCompoundFile reports whether r starts with the compound file signature.
+func IsCompoundFile(r io.ReaderAt) bool {
+	var sig [8]byte
+	if _, err := r.ReadAt(sig[:], 0); err != nil {
+		return false
+	}
+	return bytes.Equal(sig[:], Signature)
+}
+
+// dirEntry is one parsed directory entry.
+type dirEntry struct {
+	name        string
+	objType     byte
+	left, right uint32
+	child       uint32
+	start       uint32
+	size        uint64
+}
+
+// Reader gives access to the streams of a compound file.
+type Reader struct {
+	r          io.ReaderAt
+	size       int64
+	sectorSize int64
+	fat        []uint32
+	miniFat    []uint32
+	dir        []dirEntry
+	ministream []byte
+}
+
+// Open parses the header, allocation tables and directory of the compound
+// file in r.
+func Open(r io.ReaderAt, size int64) (*Reader, error) {
+	if size < headerSize || !IsCompoundFile(r) {
+		return nil, ErrNotCompoundFile
+	}
+	hdr := make([]byte, headerSize)
+	if _, err := r.ReadAt(hdr, 0); err != nil {
+		return nil, fmt.Errorf("cfb: reading header: %w", err)
+	}
+	le := binary.LittleEndian
+	if le.Uint16(hdr[28:]) != 0xFFFE {
+		return nil, errors.New("cfb: invalid byte order mark")
+	}
+	shift := le.Uint16(hdr[30:])
+	if shift != 9 && shift != 12 {
+		return nil, fmt.Errorf("cfb: unsupported sector shift %d", shift)
+	}
+	cr := &Reader{r: r, size: size, sectorSize: 1 << shift}
+	nFat := le.Uint32(hdr[44:])
+	firstDir := le.Uint32(hdr[48:])
+	firstMiniFat := le.Uint32(hdr[60:])
+	firstDifat := le.Uint32(hdr[68:])
+
+	// Collect FAT sector numbers from the header and the DIFAT chain.
+	maxSectors := uint32(size / cr.sectorSize)
+	var fatSectors []uint32
+	for i := 0; i < headerDifatLen && uint32(len(fatSectors)) < nFat; i++ {
+		fatSectors = append(fatSectors, le.Uint32(hdr[76+4*i:]))
+	}
+	perSector := int(cr.sectorSize / 4)
+	for sect, n := firstDifat, uint32(0); sect <= maxRegSect && uint32(len(fatSectors)) < nFat; n++ {
+		if n > maxSectors {
+			return nil, errors.New("cfb: DIFAT chain loops")
+		}
+		buf, err := cr.readSector(sect)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < perSector-1 && uint32(len(fatSectors)) < nFat; i++ {
+			fatSectors = append(fatSectors, le.Uint32(buf[4*i:]))
+		}
+		sect = le.Uint32(buf[4*(perSector-1):])
+	}
+	for _, sect := range fatSectors {
+		buf, err := cr.readSector(sect)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < perSector; i++ {
+			cr.fat = append(cr.fat, le.Uint32(buf[4*i:]))
+		}
+	}
+
+	dirData, err := cr.readChain(firstDir, -1)
+	if err != nil {
+		return nil, fmt.Errorf("cfb: reading directory: %w", err)
+	}
+	for off := 0; off+dirEntrySize <= len(dirData); off += dirEntrySize {
+		cr.dir = append(cr.dir, parseDirEntry(dirData[off:off+dirEntrySize], shift == 9))
+	}
+	if len(cr.dir) == 0 || cr.dir[0].objType != typeRoot {
+		return nil, errors.New("cfb: missing root entry")
+	}
+
+	if firstMiniFat <= maxRegSect {
+		data, err := cr.readChain(firstMiniFat, -1)
+		if err != nil {
+			return nil, fmt.Errorf("cfb: reading mini FAT: %w", err)
+		}
+		for i := 0; i+4 <= len(data); i += 4 {
+			cr.miniFat = append(cr.miniFat, le.Uint32(data[i:]))
+		}
+	}
+	root := cr.dir[0]
+	if root.size > 0 {
+		if cr.ministream, err = cr.readChain(root.start, int64(root.size)); err != nil {
+			return nil, fmt.Errorf("cfb: reading mini stream: %w", err)
+		}
+	}
+	return cr, nil
+}
+
+func parseDirEntry(b []byte, v3 bool) dirEntry {
+	le := binary.LittleEndian
+	nameLen := int(le.Uint16(b[64:]))
+	if nameLen > 64 {
+		nameLen = 64
+	}
+	units := make([]uint16, 0, nameLen/2)
+	for i := 0; i+1 < nameLen; i += 2 {
+		if u := le.Uint16(b[i:]); u != 0 {
+			units = append(units, u)
+		}
+	}
+	size := le.Uint64(b[120:])
+	if v3 {
+		size &= 0xFFFFFFFF
+	}
+	return dirEntry{
+		name:    string(utf16.Decode(units)),
+		objType: b[66],
+		left:    le.Uint32(b[68:]),
+		right:   le.Uint32(b[72:]),
+		child:   le.Uint32(b[76:]),
+		start:   le.Uint32(b[116:]),
+		size:    size,
+	}
+}
+
+// readSector returns the content of regular sector n.
+func (cr *Reader) readSector(n uint32) ([]byte, error) {
+	off := (int64(n) + 1) * cr.sectorSize
+	if n > maxRegSect || off+cr.sectorSize > cr.size {
+		return nil, fmt.Errorf("cfb: sector %d out of range", n)
+	}
+	buf := make([]byte, cr.sectorSize)
+	if _, err := cr.r.ReadAt(buf, off); err != nil {
+		return nil, fmt.Errorf("cfb: reading sector %d: %w", n, err)
+	}
+	return buf, nil
+}
+
+// readChain follows the FAT chain starting at start. When size is
+// non-negative the result is truncated to size bytes.
+func (cr *Reader) readChain(start uint32, size int64) ([]byte, error) {
+	var out []byte
+	for sect, n := start, 0; sect != endOfChain; n++ {
+		if n > len(cr.fat) || int(sect) >= len(cr.fat) {
+			return nil, errors.New("cfb: broken sector chain")
+		}
+		buf, err := cr.readSector(sect)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, buf...)
+		if size >= 0 && int64(len(out)) >= size {
+			break
+		}
+		sect = cr.fat[sect]
+	}
+	if size >= 0 {
+		if int64(len(out)) < size {
+			return nil, errors.New("cfb: stream shorter than its declared size")
+		}
+		out = out[:size]
+	}
+	return out, nil
+}
+
+// readMiniChain follows the mini FAT chain starting at start.
+func (cr *Reader) readMiniChain(start uint32, size int64) ([]byte, error) {
+	out := make([]byte, 0, size)
+	for sect, n := start, 0; int64(len(out)) < size; n++ {
+		if n > len(cr.miniFat) || int(sect) >= len(cr.miniFat) {
+			return nil, errors.New("cfb: broken mini sector chain")
+		}
+		off := int64(sect) * miniSectorSize
+		if off+miniSectorSize > int64(len(cr.ministream)) {
+			return nil, fmt.Errorf("cfb: mini sector %d out of range", sect)
+		}
+		out = append(out, cr.ministream[off:off+miniSectorSize]...)
+		sect = cr.miniFat[sect]
+	}
+	return out[:size], nil
+}
+
+// ReadStream returns the content of the stream at path, whose components
+// are separated by "/", e.g. "EncryptionInfo" or "\x06DataSpaces/Version".
+// Names are matched case-insensitively, as in the format itself.
+func (cr *Reader) ReadStream(path string) ([]byte, error) {
+	id := uint32(0)
+	for _, name := range strings.Split(path, "/") {
+		id = cr.findChild(cr.dir[id].child, name)
+		if id == noStream {
+			return nil, fmt.Errorf("cfb: stream %q not found", path)
+		}
+	}
+	e := cr.dir[id]
+	if e.objType != typeStream {
+		return nil, fmt.Errorf("cfb: %q is not a stream", path)
+	}
+	if e.size < miniCutoff {
+		return cr.readMiniChain(e.start, int64(e.size))
+	}
+	if e.size > uint64(cr.size) {
+		return nil, fmt.Errorf("cfb: stream %q larger than the file", path)
+	}
+	return cr.readChain(e.start, int64(e.size))
+}
+
+// findChild searches the sibling tree rooted at id for name.
+func (cr *Reader) findChild(id uint32, name string) uint32 {
+	for n := 0; id != noStream && int(id) < len(cr.dir) && n <= len(cr.dir); n++ {
+		c := compareNames(name, cr.dir[id].name)
+		switch {
+		case c == 0:
+			return id
+		case c < 0:
+			id = cr.dir[id].left
+		default:
+			id = cr.dir[id].right
+		}
+	}
+	return noStream
+}
+
+// compareNames orders directory entry names as [MS-CFB] requires: shorter
+// names first, then by upper-cased UTF-16 code units.
+func compareNames(a, b string) int {
+	ua, ub := utf16.Encode([]rune(strings.ToUpper(a))), utf16.Encode([]rune(strings.ToUpper(b)))
+	if len(ua) != len(ub) {
+		return len(ua) - len(ub)
+	}
+	for i := range ua {
+		if ua[i] != ub[i] {
+			return int(ua[i]) - int(ub[i])
+		}
+	}
+	return 0
+}
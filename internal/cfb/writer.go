@@ -0,0 +1,288 @@
+package cfb
+
+import (
+	"encoding/binary"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	sectorSize   = 512 // version 3 files
+	fatPerSector = sectorSize / 4
+	colorBlack   = 1
+)
+
+// Writer collects streams and serializes them as a version 3 compound file.
+// Storages are created implicitly from the stream paths.
+type Writer struct {
+	root *node
+}
+
+type node struct {
+	name     string
+	isStream bool
+	data     []byte
+	children map[string]*node
+
+	// Assigned while serializing.
+	id          uint32
+	left, right uint32
+	child       uint32
+	start       uint32
+}
+
+// NewWriter returns an empty Writer.
+func NewWriter() *Writer {
+	return &Writer{root: &node{name: "Root Entry", children: map[string]*node{}}}
+}
+
+// AddStream adds a stream at path, whose components are separated by "/".
+// Intermediate storages are created as needed; a stream already at path is
+// replaced.
+func (w *Writer) AddStream(path string, data []byte) {
+	parts := strings.Split(path, "/")
+	dir := w.root
+	for _, name := range parts[:len(parts)-1] {
+		next, ok := dir.children[name]
+		if !ok {
+			next = &node{name: name, children: map[string]*node{}}
+			dir.children[name] = next
+		}
+		dir = next
+	}
+	name := parts[len(parts)-1]
+	dir.children[name] = &node{name: name, isStream: true, data: data}
+}
+
+// WriteTo serializes the compound file to out.
+func (w *Writer) WriteTo(out io.Writer) (int64, error) {
+	n, err := out.Write(w.Bytes())
+	return int64(n), err
+}
+
+// Bytes serializes the compound file.
+func (w *Writer) Bytes() []byte {
+	// 1. Number the directory entries and link each storage's children
+	// into a balanced binary tree.
+	var entries []*node
+	var number func(n *node)
+	number = func(n *node) {
+		n.id = uint32(len(entries))
+		entries = append(entries, n)
+		n.left, n.right, n.child = noStream, noStream, noStream
+		kids := make([]*node, 0, len(n.children))
+		for _, c := range n.children {
+			kids = append(kids, c)
+		}
+		sort.Slice(kids, func(i, j int) bool { return compareNames(kids[i].name, kids[j].name) < 0 })
+		for _, c := range kids {
+			number(c)
+		}
+		n.child = linkTree(kids)
+	}
+	number(w.root)
+
+	// 2. Lay out small streams in the mini stream.
+	var ministream []byte
+	var miniFat []uint32
+	for _, e := range entries {
+		e.start = endOfChain
+		if !e.isStream || len(e.data) == 0 || len(e.data) >= miniCutoff {
+			continue
+		}
+		e.start = uint32(len(miniFat))
+		count := (len(e.data) + miniSectorSize - 1) / miniSectorSize
+		for i := 0; i < count; i++ {
+			miniFat = append(miniFat, uint32(len(miniFat)+1))
+		}
+		miniFat[len(miniFat)-1] = endOfChain
+		ministream = append(ministream, pad(e.data, miniSectorSize)...)
+	}
+
+	// 3. Allocate regular sectors: mini stream, large streams, mini FAT,
+	// directory, then FAT and DIFAT.
+	var fat []uint32
+	var body []byte
+	alloc := func(data []byte) uint32 {
+		if len(data) == 0 {
+			return endOfChain
+		}
+		start := uint32(len(fat))
+		count := (len(data) + sectorSize - 1) / sectorSize
+		for i := 0; i < count; i++ {
+			fat = append(fat, uint32(len(fat)+1))
+		}
+		fat[len(fat)-1] = endOfChain
+		body = append(body, pad(data, sectorSize)...)
+		return start
+	}
+	w.root.start = alloc(ministream)
+	for _, e := range entries {
+		if e.isStream && len(e.data) >= miniCutoff {
+			e.start = alloc(e.data)
+		}
+	}
+	le := binary.LittleEndian
+	miniFatBytes := make([]byte, 4*len(miniFat))
+	for i, v := range miniFat {
+		le.PutUint32(miniFatBytes[4*i:], v)
+	}
+	firstMiniFat := alloc(miniFatBytes)
+	nMiniFat := (len(miniFatBytes) + sectorSize - 1) / sectorSize
+
+	dirBytes := make([]byte, 0, len(entries)*dirEntrySize)
+	for _, e := range entries {
+		dirBytes = append(dirBytes, e.dirEntry(uint64(len(ministream)))...)
+	}
+	for len(dirBytes)%sectorSize != 0 {
+		dirBytes = append(dirBytes, emptyDirEntry()...)
+	}
+	firstDir := alloc(dirBytes)
+
+	nFat, nDifat := 0, 0
+	for {
+		total := len(fat) + nFat + nDifat
+		needFat := (total + fatPerSector - 1) / fatPerSector
+		needDifat := 0
+		if needFat > headerDifatLen {
+			needDifat = (needFat - headerDifatLen + fatPerSector - 2) / (fatPerSector - 1)
+		}
+		if needFat == nFat && needDifat == nDifat {
+			break
+		}
+		nFat, nDifat = needFat, needDifat
+	}
+	fatStart := uint32(len(fat))
+	for i := 0; i < nFat; i++ {
+		fat = append(fat, fatSect)
+	}
+	difatStart := uint32(len(fat))
+	for i := 0; i < nDifat; i++ {
+		fat = append(fat, difSect)
+	}
+	for len(fat)%fatPerSector != 0 {
+		fat = append(fat, freeSect)
+	}
+	fatBytes := make([]byte, 4*len(fat))
+	for i, v := range fat {
+		le.PutUint32(fatBytes[4*i:], v)
+	}
+	body = append(body, fatBytes...)
+
+	// DIFAT sectors list the FAT sectors beyond the first 109.
+	for i := 0; i < nDifat; i++ {
+		sect := make([]byte, sectorSize)
+		for j := 0; j < fatPerSector-1; j++ {
+			k := headerDifatLen + i*(fatPerSector-1) + j
+			v := uint32(freeSect)
+			if k < nFat {
+				v = fatStart + uint32(k)
+			}
+			le.PutUint32(sect[4*j:], v)
+		}
+		next := uint32(endOfChain)
+		if i+1 < nDifat {
+			next = difatStart + uint32(i+1)
+		}
+		le.PutUint32(sect[4*(fatPerSector-1):], next)
+		body = append(body, sect...)
+	}
+
+	// 4. Header.
+	hdr := make([]byte, headerSize)
+	copy(hdr, Signature)
+	le.PutUint16(hdr[24:], 0x003E)
+	le.PutUint16(hdr[26:], 3)
+	le.PutUint16(hdr[28:], 0xFFFE)
+	le.PutUint16(hdr[30:], 9)
+	le.PutUint16(hdr[32:], 6)
+	le.PutUint32(hdr[44:], uint32(nFat))
+	le.PutUint32(hdr[48:], firstDir)
+	le.PutUint32(hdr[56:], miniCutoff)
+	le.PutUint32(hdr[60:], firstMiniFat)
+	le.PutUint32(hdr[64:], uint32(nMiniFat))
+	firstDifat := uint32(endOfChain)
+	if nDifat > 0 {
+		firstDifat = difatStart
+	}
+	le.PutUint32(hdr[68:], firstDifat)
+	le.PutUint32(hdr[72:], uint32(nDifat))
+	for i := 0; i < headerDifatLen; i++ {
+		v := uint32(freeSect)
+		if i < nFat {
+			v = fatStart + uint32(i)
+		}
+		le.PutUint32(hdr[76+4*i:], v)
+	}
+	return append(hdr, body...)
+}
+
+// linkTree links sorted sibling entries into a balanced binary tree and
+// returns the id of its root.
+func linkTree(sorted []*node) uint32 {
+	if len(sorted) == 0 {
+		return noStream
+	}
+	mid := len(sorted) / 2
+	n := sorted[mid]
+	n.left = linkTree(sorted[:mid])
+	n.right = linkTree(sorted[mid+1:])
+	return n.id
+}
+
+// dirEntry serializes n as a directory entry. rootSize is the size of the
+// mini stream, recorded on the root entry.
+func (n *node) dirEntry(rootSize uint64) []byte {
+	b := make([]byte, dirEntrySize)
+	le := binary.LittleEndian
+	units := utf16.Encode([]rune(n.name))
+	if len(units) > 31 {
+		units = units[:31]
+	}
+	for i, u := range units {
+		le.PutUint16(b[2*i:], u)
+	}
+	le.PutUint16(b[64:], uint16(2*(len(units)+1)))
+	var size uint64
+	switch {
+	case n.id == 0:
+		b[66] = typeRoot
+		size = rootSize
+	case n.isStream:
+		b[66] = typeStream
+		size = uint64(len(n.data))
+	default:
+		b[66] = typeStorage
+	}
+	b[67] = colorBlack
+	le.PutUint32(b[68:], n.left)
+	le.PutUint32(b[72:], n.right)
+	le.PutUint32(b[76:], n.child)
+	start := n.start
+	if !n.isStream && n.id != 0 {
+		start = 0
+	}
+	le.PutUint32(b[116:], start)
+	le.PutUint64(b[120:], size)
+	return b
+}
+
+func emptyDirEntry() []byte {
+	b := make([]byte, dirEntrySize)
+	le := binary.LittleEndian
+	b[66] = typeEmpty
+	le.PutUint32(b[68:], noStream)
+	le.PutUint32(b[72:], noStream)
+	le.PutUint32(b[76:], noStream)
+	return b
+}
+
+// pad returns data zero-padded to a multiple of size.
+func pad(data []byte, size int) []byte {
+	if rem := len(data) % size; rem != 0 {
+		return append(data[:len(data):len(data)], make([]byte, size-rem)...)
+	}
+	return data
+}
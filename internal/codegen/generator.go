@@ -2,53 +2,72 @@ package codegen
 
 import (
 	"bytes"
+	"cmp"
 	"embed"
 	"fmt"
 	"go/format"
+	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 )
 
-// go run ./cmd/codegen -schema ./schema/ -out ./pkg/docx/oxml/
+// go run ./cmd/codegen -schema ./schema/ -out ./pkg/docx/oxml/ -tests
 
-//go:embed templates/element.go.tmpl
+//go:embed templates/element.go.tmpl templates/element_test.go.tmpl
 var templateFS embed.FS
 
 // Generator generates Go source code from a Schema.
 type Generator struct {
-	schema Schema
-	tmpl   *template.Template
+	schema   Schema
+	tmpl     *template.Template
+	testTmpl *template.Template
 }
 
 // NewGenerator creates a new Generator for the given schema.
 func NewGenerator(schema Schema) (*Generator, error) {
-	tmplContent, err := templateFS.ReadFile("templates/element.go.tmpl")
+	tmpl, err := parseTemplate("element.go.tmpl")
 	if err != nil {
-		return nil, fmt.Errorf("codegen: reading template: %w", err)
+		return nil, err
+	}
+	testTmpl, err := parseTemplate("element_test.go.tmpl")
+	if err != nil {
+		return nil, err
 	}
+	return &Generator{schema: schema, tmpl: tmpl, testTmpl: testTmpl}, nil
+}
 
-	tmpl, err := template.New("element").Parse(string(tmplContent))
+func parseTemplate(name string) (*template.Template, error) {
+	content, err := templateFS.ReadFile("templates/" + name)
+	if err != nil {
+		return nil, fmt.Errorf("codegen: reading template: %w", err)
+	}
+	tmpl, err := template.New(name).Parse(string(content))
 	if err != nil {
 		return nil, fmt.Errorf("codegen: parsing template: %w", err)
 	}
-
-	return &Generator{schema: schema, tmpl: tmpl}, nil
+	return tmpl, nil
 }
 
 // Generate produces gofmt-formatted Go source code.
 func (g *Generator) Generate() ([]byte, error) {
-	data := g.buildTemplateData()
-
+	data, err := g.buildTemplateData()
+	if err != nil {
+		return nil, err
+	}
 	var buf bytes.Buffer
 	if err := g.tmpl.Execute(&buf, data); err != nil {
 		return nil, fmt.Errorf("codegen: executing template: %w", err)
 	}
+	return formatSource(buf.Bytes())
+}
 
-	formatted, err := format.Source(buf.Bytes())
+// formatSource gofmts generated source.
+func formatSource(src []byte) ([]byte, error) {
+	formatted, err := format.Source(src)
 	if err != nil {
-		return buf.Bytes(), fmt.Errorf("codegen: gofmt failed: %w\n--- raw output ---\n%s", err, buf.String())
+		return src, fmt.Errorf("codegen: gofmt failed: %w\n--- raw output ---\n%s", err, src)
 	}
-
 	return formatted, nil
 }
 
@@ -64,6 +83,7 @@ type elementData struct {
 	Name                  string
 	Tag                   string
 	Doc                   string
+	Unordered             bool
 	ZeroOrOneChildren     []childData
 	ZeroOrMoreChildren    []childData
 	OneAndOnlyOneChildren []childData
@@ -71,6 +91,33 @@ type elementData struct {
 	OptionalAttributes    []attrData
 	RequiredAttributes    []attrData
 	ChoiceGroups          []choiceGroupData
+	MetaChildren          []childMetaData // children and choice members in declaration order
+	MetaAttributes        []attrData      // attributes whose values can be checked
+	ValAccessors          []valAccessorData
+}
+
+// valAccessorData describes the <GoName>Val accessors for a child's value.
+type valAccessorData struct {
+	GoName      string // accessor base name, e.g. "Bold"
+	ChildGoName string // Go name of the child, e.g. "B"
+	Tag         string // child tag, e.g. "w:b"
+	AttrName    string // attribute holding the value, e.g. "w:val"
+	ParentType  string // parent struct name
+	GoType      string // value type, e.g. "bool"
+	Toggle      bool   // a child without the attribute is on
+	ParseExpr   string // expression to parse string "val" → typed value
+	FormatExpr  string // expression to format typed "v" → string
+	TestValues  string // comma-separated test values for the generated test
+}
+
+// childMetaData describes a child for the runtime schema metadata.
+type childMetaData struct {
+	Tag         string
+	Type        string
+	Cardinality string // Go constant name, e.g. "cardZeroOrOne"
+	Group       int    // 1-based choice group index, 0 if not in a group
+	Choice      string // repeating choice name, "" if not in one
+	Successors  []string
 }
 
 type childData struct {
@@ -90,6 +137,7 @@ type attrData struct {
 	ZeroExpr    string // zero value expression (required attrs)
 	ParseExpr   string // expression to parse string "val" → typed value
 	FormatExpr  string // expression to format typed "v" → string
+	CheckExpr   string // func(string) error validating a value, "" if any string is valid
 }
 
 type choiceGroupData struct {
@@ -110,17 +158,24 @@ type choiceData struct {
 
 // --- Build template data ---
 
-func (g *Generator) buildTemplateData() templateData {
+func (g *Generator) buildTemplateData() (templateData, error) {
 	data := templateData{
 		Package: g.schema.Package,
 		Imports: g.schema.Imports,
 	}
+	useType := func(typ string) {
+		vt, ok := valueAttrTypes[strings.TrimPrefix(typ, "*")]
+		if ok && strings.Contains(vt.goType, "docx.") && !slices.Contains(data.Imports, docxImport) {
+			data.Imports = append(slices.Clip(data.Imports), docxImport)
+		}
+	}
 
 	for _, el := range g.schema.Elements {
 		ed := elementData{
-			Name: el.Name,
-			Tag:  el.Tag,
-			Doc:  el.Doc,
+			Name:      el.Name,
+			Tag:       el.Tag,
+			Doc:       el.Doc,
+			Unordered: el.Unordered,
 		}
 
 		for _, ch := range el.Children {
@@ -132,6 +187,14 @@ func (g *Generator) buildTemplateData() templateData {
 				Successors: ch.Successors,
 			}
 
+			ed.MetaChildren = append(ed.MetaChildren, childMetaData{
+				Tag:         ch.Tag,
+				Type:        ch.Type,
+				Cardinality: cardinalityConst[ch.Cardinality],
+				Choice:      ch.Choice,
+				Successors:  ch.Successors,
+			})
+
 			switch ch.Cardinality {
 			case "zero_or_one":
 				ed.ZeroOrOneChildren = append(ed.ZeroOrOneChildren, cd)
@@ -142,10 +205,20 @@ func (g *Generator) buildTemplateData() templateData {
 			case "one_or_more":
 				ed.OneOrMoreChildren = append(ed.OneOrMoreChildren, cd)
 			}
+
+			if ch.ValAccessor != nil {
+				va, err := buildValAccessor(el.Name, ch)
+				if err != nil {
+					return data, err
+				}
+				useType(ch.ValAccessor.Type)
+				ed.ValAccessors = append(ed.ValAccessors, va)
+			}
 		}
 
 		for _, attr := range el.Attributes {
 			goType, zeroExpr, defaultExpr, parseExpr, formatExpr := resolveAttrType(attr)
+			useType(attr.Type)
 
 			ad := attrData{
 				GoName:     ExportName(attr.Name),
@@ -154,6 +227,10 @@ func (g *Generator) buildTemplateData() templateData {
 				ParentType: el.Name,
 				ParseExpr:  parseExpr,
 				FormatExpr: formatExpr,
+				CheckExpr:  checkExpr(attr),
+			}
+			if ad.CheckExpr != "" {
+				ed.MetaAttributes = append(ed.MetaAttributes, ad)
 			}
 
 			if attr.Required {
@@ -168,7 +245,16 @@ func (g *Generator) buildTemplateData() templateData {
 			}
 		}
 
-		for _, cg := range el.ChoiceGroups {
+		for gi, cg := range el.ChoiceGroups {
+			for _, ch := range cg.Choices {
+				ed.MetaChildren = append(ed.MetaChildren, childMetaData{
+					Tag:         ch.Tag,
+					Type:        ch.Type,
+					Cardinality: cardinalityConst["zero_or_one"],
+					Group:       gi + 1,
+					Successors:  cg.Successors,
+				})
+			}
 			tags := make([]string, len(cg.Choices))
 			choices := make([]choiceData, len(cg.Choices))
 			for i, ch := range cg.Choices {
@@ -193,7 +279,35 @@ func (g *Generator) buildTemplateData() templateData {
 		data.Elements = append(data.Elements, ed)
 	}
 
-	return data
+	return data, nil
+}
+
+// buildValAccessor returns the accessor data for the val_accessor of ch, a
+// child of parent.
+func buildValAccessor(parent string, ch Child) (valAccessorData, error) {
+	spec := ch.ValAccessor
+	if ch.Cardinality != "zero_or_one" {
+		return valAccessorData{}, fmt.Errorf("codegen: %s.%s: val_accessor needs a zero_or_one child, not %s", parent, ch.Name, ch.Cardinality)
+	}
+	va := valAccessorData{
+		GoName:      ExportName(cmp.Or(spec.Name, ch.Name)),
+		ChildGoName: ExportName(ch.Name),
+		Tag:         ch.Tag,
+		AttrName:    cmp.Or(spec.AttrName, "w:val"),
+		ParentType:  parent,
+	}
+	typ := spec.Type
+	if typ == "toggle" {
+		va.Toggle = true
+		typ = "bool"
+	}
+	goType, _, _, parseExpr, formatExpr := resolveAttrType(Attribute{Type: typ})
+	if typ == "" || strings.HasPrefix(goType, "*") {
+		return valAccessorData{}, fmt.Errorf("codegen: %s.%s: unsupported val_accessor type %q", parent, ch.Name, spec.Type)
+	}
+	va.GoType, va.ParseExpr, va.FormatExpr = goType, parseExpr, formatExpr
+	va.TestValues = testValues(spec.Type, goType)
+	return va, nil
 }
 
 // resolveAttrType returns (goType, zeroExpr, defaultExpr, parseExpr, formatExpr)
@@ -217,6 +331,17 @@ func resolveAttrType(attr Attribute) (goType, zeroExpr, defaultExpr, parseExpr,
 			"parseBoolAttr(val)", "formatBoolAttr(v)"
 
 	default:
+		if vt, ok := valueAttrTypes[strings.TrimPrefix(attr.Type, "*")]; ok {
+			if strings.HasPrefix(attr.Type, "*") && !strings.HasPrefix(vt.goType, "*") {
+				// Optional pointer to a value type
+				return "*" + vt.goType, "nil", "nil",
+					fmt.Sprintf("parseOptionalAttr(val, %s)", vt.parseFn),
+					fmt.Sprintf("format%sAttr(*v)", vt.suffix)
+			}
+			return vt.goType, vt.zero, vt.zero,
+				fmt.Sprintf("parse%sAttr(val)", vt.suffix),
+				fmt.Sprintf("format%sAttr(v)", vt.suffix)
+		}
 		// Enum or custom type, e.g. "enum.WdAlignParagraph"
 		if strings.HasPrefix(attr.Type, "*") {
 			// Optional pointer-to-enum
@@ -234,6 +359,75 @@ func resolveAttrType(attr Attribute) (goType, zeroExpr, defaultExpr, parseExpr,
 	}
 }
 
+// valueAttr describes an attribute type whose values convert to a Go value
+// type through helpers in the generated package: parse<suffix>Attr and
+// format<suffix>Attr, and parseFn, which returns an error for invalid input.
+type valueAttr struct {
+	goType  string
+	zero    string
+	suffix  string
+	parseFn string
+}
+
+// valueAttrTypes holds the measurement and other typed attribute types. A
+// "*" prefix makes an optional attribute a pointer that is nil when absent,
+// as for enums; hex_color is always a pointer, nil meaning "auto". The
+// percentage types differ in the unit of their integer form: thousandths of
+// a percent for DrawingML attributes, fiftieths for WordprocessingML ones
+// and whole percents for the w:w character scale.
+var valueAttrTypes = map[string]valueAttr{
+	"twips":             {"docx.Length", "0", "Twips", "parseTwips"},
+	"half_points":       {"docx.Length", "0", "HalfPoints", "parseHalfPoints"},
+	"eighth_points":     {"docx.Length", "0", "EighthPoints", "parseEighthPoints"},
+	"emu":               {"docx.Length", "0", "Emu", "parseEmu"},
+	"universal_measure": {"docx.Length", "0", "UniversalMeasure", "parseUniversalMeasure"},
+	"drawing_pct":       {"float64", "0", "DrawingPct", "parseDrawingPct"},
+	"wml_pct":           {"float64", "0", "WmlPct", "parseWmlPct"},
+	"text_scale":        {"int", "0", "TextScale", "parseTextScale"},
+	"st_on_off":         {"bool", "false", "OnOff", "parseOnOff"},
+	"hex_color":         {"*docx.RGBColor", "nil", "HexColor", "parseHexColor"},
+}
+
+// docxImport is the package of docx.Length and docx.RGBColor, imported
+// automatically by files that use a valueAttrTypes type of either.
+const docxImport = "github.com/user/go-docx/pkg/docx"
+
+// cardinalityConst maps schema cardinalities to the constants the runtime
+// metadata uses.
+var cardinalityConst = map[string]string{
+	"zero_or_one":      "cardZeroOrOne",
+	"zero_or_more":     "cardZeroOrMore",
+	"one_and_only_one": "cardOneAndOnlyOne",
+	"one_or_more":      "cardOneOrMore",
+}
+
+// checkExpr returns the expression for a func(string) error that validates
+// values of an attribute, or "" for strings without a list of values.
+func checkExpr(attr Attribute) string {
+	typ := attr.Type
+	switch typ {
+	case "string":
+		if len(attr.Values) == 0 {
+			return ""
+		}
+		quoted := make([]string, len(attr.Values))
+		for i, v := range attr.Values {
+			quoted[i] = strconv.Quote(v)
+		}
+		return fmt.Sprintf("checkValuesAttr(%s)", strings.Join(quoted, ", "))
+	case "int":
+		return "checkIntAttr"
+	case "int64":
+		return "checkInt64Attr"
+	case "bool":
+		return "checkBoolAttr"
+	}
+	if vt, ok := valueAttrTypes[strings.TrimPrefix(typ, "*")]; ok {
+		return fmt.Sprintf("checkValueAttr(%s)", vt.parseFn)
+	}
+	return fmt.Sprintf("checkEnumAttr(%sFromXml)", strings.TrimPrefix(typ, "*"))
+}
+
 // ExportName ensures the first character is uppercase (Go exported).
 func ExportName(name string) string {
 	if name == "" {
@@ -150,6 +150,29 @@ func TestOneOrMore_Generates5Methods(t *testing.T) {
 	assertContains(t, code, "At least one must be present")
 }
 
+func TestOneOrMore_RepeatingChoiceInMetadata(t *testing.T) {
+	t.Parallel()
+	code := generateCode(t, Schema{
+		Package: "oxml",
+		Elements: []Element{
+			{Name: "CT_P", Tag: "w:p"},
+			{Name: "CT_Tbl", Tag: "w:tbl"},
+			{
+				Name: "CT_Tc",
+				Tag:  "w:tc",
+				Children: []Child{
+					{Name: "P", Tag: "w:p", Type: "CT_P", Cardinality: "one_or_more", Choice: "block"},
+					{Name: "Tbl", Tag: "w:tbl", Type: "CT_Tbl", Cardinality: "one_or_more", Choice: "block"},
+				},
+			},
+		},
+	})
+
+	assertContains(t, code, `{tag: "w:p", typ: "CT_P", card: cardOneOrMore, choice: "block"}`)
+	assertContains(t, code, `{tag: "w:tbl", typ: "CT_Tbl", card: cardOneOrMore, choice: "block"}`)
+	assertContains(t, code, "func (e *CT_Tc) AddTbl() *CT_Tbl")
+}
+
 // --- Acceptance criterion: attributes ---
 
 func TestOptionalAttribute_GeneratesGetterSetter(t *testing.T) {
@@ -366,6 +389,16 @@ func TestGenerate_StructEmbedsElement(t *testing.T) {
 	assertContains(t, code, "Element")
 }
 
+func TestGenerate_RegistersWrapper(t *testing.T) {
+	t.Parallel()
+	code := generateCode(t, Schema{
+		Package:  "oxml",
+		Elements: []Element{{Name: "CT_P", Tag: "w:p"}},
+	})
+
+	assertContains(t, code, "wrap: func(el Element) Node { return &CT_P{el} },")
+}
+
 func TestGenerate_HeaderComment(t *testing.T) {
 	t.Parallel()
 	code := generateCode(t, Schema{
@@ -459,6 +492,181 @@ func TestGenerate_SuccessorsPreserveOrder(t *testing.T) {
 	assertContains(t, code, `"w:b", "w:c", "w:d"`)
 }
 
+// --- val_accessor ---
+
+func TestValAccessor_Toggle(t *testing.T) {
+	t.Parallel()
+	code := generateCode(t, Schema{
+		Package: "oxml",
+		Elements: []Element{{
+			Name: "CT_RPr",
+			Tag:  "w:rPr",
+			Children: []Child{{
+				Name: "B", Tag: "w:b", Type: "CT_OnOff", Cardinality: "zero_or_one",
+				ValAccessor: &ValAccessor{Name: "Bold", Type: "toggle"},
+			}},
+		}},
+	})
+
+	assertContains(t, code, "func (e *CT_RPr) BoldVal() *bool")
+	assertContains(t, code, "func (e *CT_RPr) SetBoldVal(val *bool)")
+	assertContains(t, code, "A <w:b> without the attribute is on.")
+	assertContains(t, code, "v := true")
+	assertContains(t, code, `child.RemoveAttr("w:val")`)
+	assertContains(t, code, "e.RemoveB()")
+}
+
+func TestValAccessor_TypedValue(t *testing.T) {
+	t.Parallel()
+	code := generateCode(t, Schema{
+		Package: "oxml",
+		Elements: []Element{{
+			Name: "CT_PPr",
+			Tag:  "w:pPr",
+			Children: []Child{
+				{
+					Name: "Jc", Tag: "w:jc", Type: "CT_Jc", Cardinality: "zero_or_one",
+					ValAccessor: &ValAccessor{Type: "enum.WdParagraphAlignment"},
+				},
+				{
+					Name: "Ind", Tag: "w:ind", Type: "CT_Ind", Cardinality: "zero_or_one",
+					ValAccessor: &ValAccessor{Name: "IndLeft", Type: "twips", AttrName: "w:left"},
+				},
+			},
+		}},
+	})
+
+	assertContains(t, code, "func (e *CT_PPr) JcVal() *enum.WdParagraphAlignment")
+	assertContains(t, code, "mustParseEnum(val, enum.WdParagraphAlignmentFromXml)")
+	assertContains(t, code, "func (e *CT_PPr) IndLeftVal() *docx.Length")
+	assertContains(t, code, `child.SetAttr("w:left", formatTwipsAttr(v))`)
+	assertContains(t, code, `"github.com/user/go-docx/pkg/docx"`)
+}
+
+func TestValAccessor_Errors(t *testing.T) {
+	t.Parallel()
+	for _, ch := range []Child{
+		{Name: "R", Tag: "w:r", Type: "CT_R", Cardinality: "zero_or_more", ValAccessor: &ValAccessor{Type: "string"}},
+		{Name: "Jc", Tag: "w:jc", Type: "CT_Jc", Cardinality: "zero_or_one", ValAccessor: &ValAccessor{Type: "*enum.WdParagraphAlignment"}},
+		{Name: "Color", Tag: "w:color", Type: "CT_Color", Cardinality: "zero_or_one", ValAccessor: &ValAccessor{Type: "hex_color"}},
+		{Name: "Sz", Tag: "w:sz", Type: "CT_HpsMeasure", Cardinality: "zero_or_one", ValAccessor: &ValAccessor{}},
+	} {
+		gen, err := NewGenerator(Schema{Package: "oxml", Elements: []Element{{Name: "CT_X", Tag: "w:x", Children: []Child{ch}}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := gen.Generate(); err == nil {
+			t.Errorf("Generate() with val_accessor %+v on %s child succeeded", *ch.ValAccessor, ch.Cardinality)
+		}
+	}
+}
+
+func TestGenerateTests_ValAccessors(t *testing.T) {
+	t.Parallel()
+	gen, err := NewGenerator(Schema{
+		Package: "oxml",
+		Imports: []string{"github.com/user/go-docx/pkg/docx/enum"},
+		Elements: []Element{{
+			Name: "CT_RPr",
+			Tag:  "w:rPr",
+			Children: []Child{
+				{Name: "B", Tag: "w:b", Type: "CT_OnOff", Cardinality: "zero_or_one", ValAccessor: &ValAccessor{Name: "Bold", Type: "toggle"}},
+				{Name: "Sz", Tag: "w:sz", Type: "CT_HpsMeasure", Cardinality: "zero_or_one", ValAccessor: &ValAccessor{Type: "half_points"}},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := gen.GenerateTests()
+	if err != nil {
+		t.Fatalf("GenerateTests error: %v", err)
+	}
+	code := string(out)
+
+	assertContains(t, code, "func TestGenerated_CT_RPr(t *testing.T)")
+	assertContains(t, code, `t.Run("BoldVal"`)
+	assertContains(t, code, `OxmlElement("w:rPr")`)
+	assertContains(t, code, "[]bool{false, true}")
+	assertContains(t, code, "[]docx.Length{docx.Pt(1)}")
+	assertContains(t, code, `"github.com/user/go-docx/pkg/docx"`)
+	assertNotContains(t, code, `"github.com/user/go-docx/pkg/docx/enum"`)
+}
+
+func TestGenerateTests_ChildrenAndAttributes(t *testing.T) {
+	t.Parallel()
+	gen, err := NewGenerator(Schema{
+		Package: "oxml",
+		Imports: []string{"github.com/user/go-docx/pkg/docx/enum"},
+		Elements: []Element{{
+			Name: "CT_P",
+			Tag:  "w:p",
+			Children: []Child{
+				{Name: "PPr", Tag: "w:pPr", Type: "CT_PPr", Cardinality: "zero_or_one", Successors: []string{"w:r"}},
+				{Name: "R", Tag: "w:r", Type: "CT_R", Cardinality: "zero_or_more"},
+				{Name: "Body", Tag: "w:body", Type: "CT_Body", Cardinality: "one_and_only_one"},
+			},
+			ChoiceGroups: []ChoiceGroup{{
+				Name:    "Fill",
+				Choices: []Choice{{Name: "NoFill", Tag: "a:noFill", Type: "CT_NoFill"}, {Name: "SolidFill", Tag: "a:solidFill", Type: "CT_SolidFill"}},
+			}},
+			Attributes: []Attribute{
+				{Name: "Jc", AttrName: "w:jc", Type: "enum.WdParagraphAlignment", Required: true},
+				{Name: "Hidden", AttrName: "w:hidden", Type: "bool", Default: ptr("true")},
+				{Name: "Ind", AttrName: "w:ind", Type: "*twips"},
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := gen.GenerateTests()
+	if err != nil {
+		t.Fatalf("GenerateTests error: %v", err)
+	}
+	code := string(out)
+
+	assertContains(t, code, `order := []string{"w:body", "a:noFill", "a:solidFill", "w:pPr", "w:r"}`)
+	assertContains(t, code, "child := e.GetOrAddPPr()\n\t\tassertChildOrder(t, \"CT_P\", e.E, child.E)")
+	assertContains(t, code, "WrapElement(child.E).(*CT_PPr)")
+	assertContains(t, code, "e.RemovePPr()")
+	assertContains(t, code, `testElement("w:p", order, "w:r")`)
+	assertContains(t, code, "e.AddR()\n\t\tchild := e.AddR()")
+	assertNotContains(t, code, `t.Run("Body"`)
+	assertContains(t, code, `testElement("w:p", order, "a:noFill", "a:solidFill")`)
+	assertContains(t, code, "child := e.GetOrChangeToSolidFill()")
+	assertContains(t, code, "WrapElement(child.E).(*CT_SolidFill)")
+	assertContains(t, code, "e.RemoveFill()")
+	assertContains(t, code, "[]enum.WdParagraphAlignment{enumTestValue(enum.WdParagraphAlignment.ToXml)}")
+	assertContains(t, code, "if got := e.Hidden(); got != true {")
+	assertContains(t, code, "if got := e.Ind(); got != nil {")
+	assertContains(t, code, "[]docx.Length{docx.Pt(1)}")
+	assertContains(t, code, `"github.com/user/go-docx/pkg/docx"`)
+	assertContains(t, code, `"github.com/user/go-docx/pkg/docx/enum"`)
+}
+
+func TestSchemaOrder_UsesLongestSuccessorChain(t *testing.T) {
+	t.Parallel()
+	got := schemaOrder(Element{Children: []Child{
+		{Tag: "w:b", Successors: []string{"w:c"}},
+		{Tag: "w:a", Successors: []string{"w:b", "w:c"}},
+		{Tag: "w:x"},
+	}})
+	assertEqual(t, "w:x w:a w:b w:c", strings.Join(got, " "))
+}
+
+func TestGenerateTests_NothingToTest(t *testing.T) {
+	t.Parallel()
+	gen, err := NewGenerator(Schema{Package: "oxml", Elements: []Element{{Name: "CT_P", Tag: "w:p"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := gen.GenerateTests()
+	if err != nil || out != nil {
+		t.Errorf("GenerateTests() = %q, %v, want nil, nil", out, err)
+	}
+}
+
 // --- AttrType resolution ---
 
 func TestResolveAttrType_String(t *testing.T) {
@@ -495,6 +703,76 @@ func TestResolveAttrType_Int64(t *testing.T) {
 	assertEqual(t, "formatInt64Attr(v)", format)
 }
 
+func TestResolveAttrType_Twips(t *testing.T) {
+	t.Parallel()
+	goType, zero, _, parse, format := resolveAttrType(Attribute{Type: "twips"})
+	assertEqual(t, "docx.Length", goType)
+	assertEqual(t, "0", zero)
+	assertEqual(t, "parseTwipsAttr(val)", parse)
+	assertEqual(t, "formatTwipsAttr(v)", format)
+}
+
+func TestResolveAttrType_OptionalHalfPoints(t *testing.T) {
+	t.Parallel()
+	goType, zero, def, parse, format := resolveAttrType(Attribute{Type: "*half_points"})
+	assertEqual(t, "*docx.Length", goType)
+	assertEqual(t, "nil", zero)
+	assertEqual(t, "nil", def)
+	assertEqual(t, "parseOptionalAttr(val, parseHalfPoints)", parse)
+	assertEqual(t, "formatHalfPointsAttr(*v)", format)
+}
+
+func TestResolveAttrType_HexColorIsAlwaysPointer(t *testing.T) {
+	t.Parallel()
+	for _, typ := range []string{"hex_color", "*hex_color"} {
+		goType, _, def, parse, format := resolveAttrType(Attribute{Type: typ})
+		assertEqual(t, "*docx.RGBColor", goType)
+		assertEqual(t, "nil", def)
+		assertEqual(t, "parseHexColorAttr(val)", parse)
+		assertEqual(t, "formatHexColorAttr(v)", format)
+	}
+}
+
+func TestGenerate_ValueAttrTypesImportDocx(t *testing.T) {
+	t.Parallel()
+	code := generateCode(t, Schema{
+		Package: "oxml",
+		Imports: []string{"github.com/user/go-docx/pkg/docx/enum"},
+		Elements: []Element{{
+			Name: "CT_PageSz",
+			Tag:  "w:pgSz",
+			Attributes: []Attribute{
+				{Name: "W", AttrName: "w:w", Type: "*twips"},
+				{Name: "Pct", AttrName: "w:pct", Type: "wml_pct", Required: true},
+				{Name: "Alpha", AttrName: "a:alpha", Type: "*drawing_pct"},
+			},
+		}},
+	})
+
+	assertContains(t, code, `"github.com/user/go-docx/pkg/docx"`)
+	assertContains(t, code, `"github.com/user/go-docx/pkg/docx/enum"`)
+	assertContains(t, code, "func (e *CT_PageSz) W() *docx.Length")
+	assertContains(t, code, "func (e *CT_PageSz) Pct() (float64, error)")
+	assertContains(t, code, `{name: "w:w", check: checkValueAttr(parseTwips)}`)
+	assertContains(t, code, `{name: "w:pct", check: checkValueAttr(parseWmlPct)}`)
+	assertContains(t, code, "func (e *CT_PageSz) Alpha() *float64")
+	assertContains(t, code, `{name: "a:alpha", check: checkValueAttr(parseDrawingPct)}`)
+}
+
+func TestGenerate_NoValueAttrTypesNoDocxImport(t *testing.T) {
+	t.Parallel()
+	code := generateCode(t, Schema{
+		Package: "oxml",
+		Elements: []Element{{
+			Name:       "CT_Spacing",
+			Tag:        "w:spacing",
+			Attributes: []Attribute{{Name: "Before", AttrName: "w:before", Type: "int"}},
+		}},
+	})
+
+	assertNotContains(t, code, `"github.com/user/go-docx/pkg/docx"`)
+}
+
 // --- Helpers ---
 
 func generateCode(t *testing.T, schema Schema) string {
@@ -531,6 +809,8 @@ func assertEqual(t *testing.T, want, got string) {
 	}
 }
 
+func ptr[T any](v T) *T { return &v }
+
 func truncate(s string, n int) string {
 	if len(s) <= n {
 		return s
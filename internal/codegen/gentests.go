@@ -0,0 +1,216 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// --- Generated tests ---
+//
+// GenerateTests emits, for each element, a test that every insertable child
+// lands in schema order among all the element's other children, that
+// Remove clears it, that optional attributes read back their defaults when
+// absent, and that attribute values and val accessors round-trip.
+
+type testTemplateData struct {
+	Package  string
+	Imports  []string
+	Elements []elementTestData
+}
+
+type elementTestData struct {
+	Name         string
+	Tag          string
+	Order        []string // all child tags in schema order
+	Children     []childTestData
+	Attributes   []attrTestData
+	ValAccessors []valAccessorData
+}
+
+type childTestData struct {
+	GoName string   // subtest name, e.g. "PPr"
+	Insert string   // method adding the child, e.g. "GetOrAddPPr"
+	Repeat bool     // the child may repeat: insert it twice
+	Skip   []string // tags left out of the fixture
+	Getter string   // getter that is nil after Remove, "" if there is no Remove
+	Remove string
+	Tag    string
+	Type   string // the child's type, which WrapElement must return
+}
+
+type attrTestData struct {
+	GoName    string
+	AttrName  string
+	Required  bool
+	Default   string // default value expression (optional attributes)
+	Pointer   bool   // the accessors take and return *ValueType
+	ValueType string
+	Values    string // comma-separated values to round-trip
+}
+
+// GenerateTests produces gofmt-formatted tests for the generated code, or
+// nil if the schema declares nothing the tests cover.
+func (g *Generator) GenerateTests() ([]byte, error) {
+	data, err := g.buildTemplateData()
+	if err != nil {
+		return nil, err
+	}
+	td := testTemplateData{Package: data.Package}
+	var exprs []string // Go expressions in the tests, to find the imports used
+	for i, el := range g.schema.Elements {
+		et := elementTestData{
+			Name:         el.Name,
+			Tag:          el.Tag,
+			Children:     childTests(el),
+			ValAccessors: data.Elements[i].ValAccessors,
+		}
+		if len(et.Children) > 0 {
+			et.Order = schemaOrder(el)
+		}
+		for _, attr := range el.Attributes {
+			at := attrTest(attr)
+			et.Attributes = append(et.Attributes, at)
+			exprs = append(exprs, at.Default, at.ValueType, at.Values)
+		}
+		for _, va := range et.ValAccessors {
+			exprs = append(exprs, va.GoType, va.TestValues)
+		}
+		if len(et.Children)+len(et.Attributes)+len(et.ValAccessors) > 0 {
+			td.Elements = append(td.Elements, et)
+		}
+	}
+	if len(td.Elements) == 0 {
+		return nil, nil
+	}
+	for _, imp := range data.Imports {
+		pkg := path.Base(imp) + "."
+		if slices.ContainsFunc(exprs, func(v string) bool { return strings.Contains(v, pkg) }) {
+			td.Imports = append(td.Imports, imp)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := g.testTmpl.Execute(&buf, td); err != nil {
+		return nil, fmt.Errorf("codegen: executing test template: %w", err)
+	}
+	return formatSource(buf.Bytes())
+}
+
+// schemaOrder returns the tags of el's children and choice members in
+// schema order. The longest successor chain, normally that of the first
+// child, gives the order; tags it misses are put in front in declaration
+// order.
+func schemaOrder(el Element) []string {
+	var declared, chain []string
+	consider := func(tags, successors []string) {
+		declared = append(declared, tags...)
+		if seq := append(slices.Clone(tags), successors...); len(seq) > len(chain) {
+			chain = seq
+		}
+	}
+	for _, ch := range el.Children {
+		consider([]string{ch.Tag}, ch.Successors)
+	}
+	for _, cg := range el.ChoiceGroups {
+		tags := make([]string, len(cg.Choices))
+		for i, c := range cg.Choices {
+			tags[i] = c.Tag
+		}
+		consider(tags, cg.Successors)
+	}
+	var order []string
+	for _, tag := range declared {
+		if !slices.Contains(chain, tag) && !slices.Contains(order, tag) {
+			order = append(order, tag)
+		}
+	}
+	for _, tag := range chain {
+		if !slices.Contains(order, tag) {
+			order = append(order, tag)
+		}
+	}
+	return order
+}
+
+// childTests returns a test for each child of el that can be inserted.
+func childTests(el Element) []childTestData {
+	var tests []childTestData
+	for _, ch := range el.Children {
+		name := ExportName(ch.Name)
+		ct := childTestData{GoName: name, Tag: ch.Tag, Type: ch.Type, Skip: []string{ch.Tag}}
+		switch ch.Cardinality {
+		case "zero_or_one":
+			ct.Insert, ct.Getter, ct.Remove = "GetOrAdd"+name, name, "Remove"+name
+		case "zero_or_more", "one_or_more":
+			ct.Insert, ct.Repeat = "Add"+name, true
+		default:
+			continue
+		}
+		tests = append(tests, ct)
+	}
+	for _, cg := range el.ChoiceGroups {
+		group := ExportName(cg.Name)
+		tags := make([]string, len(cg.Choices))
+		for i, c := range cg.Choices {
+			tags[i] = c.Tag
+		}
+		for _, c := range cg.Choices {
+			name := ExportName(c.Name)
+			tests = append(tests, childTestData{
+				GoName: name,
+				Insert: "GetOrChangeTo" + name,
+				Skip:   tags,
+				Getter: group,
+				Remove: "Remove" + group,
+				Tag:    c.Tag,
+				Type:   c.Type,
+			})
+		}
+	}
+	return tests
+}
+
+// attrTest returns the test data for attr.
+func attrTest(attr Attribute) attrTestData {
+	goType, _, defaultExpr, _, _ := resolveAttrType(attr)
+	at := attrTestData{
+		GoName:    ExportName(attr.Name),
+		AttrName:  attr.AttrName,
+		Required:  attr.Required,
+		Pointer:   strings.HasPrefix(goType, "*"),
+		ValueType: strings.TrimPrefix(goType, "*"),
+	}
+	if !attr.Required {
+		at.Default = defaultExpr
+		if attr.Default != nil {
+			at.Default = *attr.Default
+		}
+	}
+	at.Values = testValues(strings.TrimPrefix(attr.Type, "*"), at.ValueType)
+	return at
+}
+
+// testValues returns the values of goType the generated tests set through
+// an accessor of attribute type typ.
+func testValues(typ, goType string) string {
+	switch typ {
+	case "toggle", "bool", "st_on_off":
+		return "false, true"
+	case "string":
+		return `"x"`
+	case "int", "int64":
+		return "7"
+	case "drawing_pct", "wml_pct", "text_scale":
+		return "50"
+	case "hex_color":
+		return "docx.NewRGBColor(0x3C, 0x2F, 0x80)"
+	}
+	if goType == "docx.Length" {
+		return "docx.Pt(1)"
+	}
+	// Enum: the first value with an XML form
+	return fmt.Sprintf("enumTestValue(%s.ToXml)", goType)
+}
@@ -15,12 +15,13 @@ type Schema struct {
 
 // Element describes one CT_* element class.
 type Element struct {
-	Name         string        `yaml:"name"`          // Go struct name, e.g. "CT_P"
-	Tag          string        `yaml:"tag"`           // XML tag, e.g. "w:p"
-	Doc          string        `yaml:"doc"`           // documentation comment
-	Children     []Child       `yaml:"children"`      // child elements
-	Attributes   []Attribute   `yaml:"attributes"`    // XML attributes
-	ChoiceGroups []ChoiceGroup `yaml:"choice_groups"` // ZeroOrOneChoice groups
+	Name         string        `yaml:"name"`                    // Go struct name, e.g. "CT_P"
+	Tag          string        `yaml:"tag"`                     // XML tag, e.g. "w:p"
+	Doc          string        `yaml:"doc"`                     // documentation comment
+	Children     []Child       `yaml:"children"`                // child elements
+	Attributes   []Attribute   `yaml:"attributes"`              // XML attributes
+	ChoiceGroups []ChoiceGroup `yaml:"choice_groups,omitempty"` // ZeroOrOneChoice groups
+	Unordered    bool          `yaml:"unordered,omitempty"`     // children may appear in any order (xsd:all)
 }
 
 // Child describes a child element with its cardinality.
@@ -31,27 +32,47 @@ type Element struct {
 //   - "one_and_only_one":  getter only (panics if absent)              (1 method)
 //   - "one_or_more":       List getter, Add, add, new, insert          (5 methods)
 type Child struct {
-	Name        string   `yaml:"name"`        // Go property name, e.g. "PPr"
-	Tag         string   `yaml:"tag"`         // XML tag, e.g. "w:pPr"
-	Type        string   `yaml:"type"`        // Go type name, e.g. "CT_PPr"
-	Cardinality string   `yaml:"cardinality"` // see above
-	Successors  []string `yaml:"successors"`  // tags for InsertElementBefore ordering
+	Name        string       `yaml:"name"`                   // Go property name, e.g. "PPr"
+	Tag         string       `yaml:"tag"`                    // XML tag, e.g. "w:pPr"
+	Type        string       `yaml:"type"`                   // Go type name, e.g. "CT_PPr"
+	Cardinality string       `yaml:"cardinality"`            // see above
+	Successors  []string     `yaml:"successors,flow"`        // tags for InsertElementBefore ordering
+	ValAccessor *ValAccessor `yaml:"val_accessor,omitempty"` // zero_or_one only, see ValAccessor
+	Choice      string       `yaml:"choice,omitempty"`       // repeating choice the child belongs to, see below
+}
+
+// Children sharing a Choice name are the members of a repeating choice such
+// as EG_BlockLevelElts, whose members may appear in any mix. A one_or_more
+// member is then satisfied by any member being present: a <w:tc> needs a
+// <w:p> or a <w:tbl>, not both.
+
+// ValAccessor requests <Name>Val and Set<Name>Val methods on the parent that
+// read and write one attribute of a zero_or_one child through a pointer:
+// nil means the child is absent, and setting nil removes it.
+//
+// Type is "toggle" for an ST_OnOff property such as <w:b>, where a child
+// without the attribute is on, or any non-pointer attribute type.
+type ValAccessor struct {
+	Name     string `yaml:"name,omitempty"`      // accessor base name, e.g. "Bold"; defaults to the child name
+	Type     string `yaml:"type"`                // "toggle" or an attribute type
+	AttrName string `yaml:"attr_name,omitempty"` // defaults to "w:val"
 }
 
 // Attribute describes an XML attribute on an element.
 type Attribute struct {
-	Name     string  `yaml:"name"`      // Go property name, e.g. "Val"
-	AttrName string  `yaml:"attr_name"` // XML attribute name, e.g. "w:val" or "val"
-	Type     string  `yaml:"type"`      // "string", "int", "int64", "bool", or enum
-	Required bool    `yaml:"required"`  // true = RequiredAttribute, false = OptionalAttribute
-	Default  *string `yaml:"default"`   // default value expression (only for optional)
+	Name     string   `yaml:"name"`                  // Go property name, e.g. "Val"
+	AttrName string   `yaml:"attr_name"`             // XML attribute name, e.g. "w:val" or "val"
+	Type     string   `yaml:"type"`                  // "string", "int", "int64", "bool", a valueAttrTypes type, or enum
+	Required bool     `yaml:"required"`              // true = RequiredAttribute, false = OptionalAttribute
+	Default  *string  `yaml:"default,omitempty"`     // default value expression (only for optional)
+	Values   []string `yaml:"values,omitempty,flow"` // allowed values of a "string" attribute, e.g. from an xsd:enumeration
 }
 
 // ChoiceGroup describes a ZeroOrOneChoice element group (e.g. EG_ColorChoice).
 type ChoiceGroup struct {
-	Name       string   `yaml:"name"`       // group property name, e.g. "ColorChoice"
-	Choices    []Choice `yaml:"choices"`     // member elements
-	Successors []string `yaml:"successors"` // tags for InsertElementBefore ordering
+	Name       string   `yaml:"name"`            // group property name, e.g. "ColorChoice"
+	Choices    []Choice `yaml:"choices"`         // member elements
+	Successors []string `yaml:"successors,flow"` // tags for InsertElementBefore ordering
 }
 
 // Choice describes one member of a ChoiceGroup.
@@ -0,0 +1,914 @@
+package codegen
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// XsdNamespace is the XML Schema namespace.
+const XsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// DefaultXsdPrefixes maps the ECMA-376 transitional namespaces to the tag
+// prefixes used by the schema YAML files.
+var DefaultXsdPrefixes = map[string]string{
+	"http://schemas.openxmlformats.org/wordprocessingml/2006/main":              "w",
+	"http://schemas.openxmlformats.org/officeDocument/2006/relationships":       "r",
+	"http://schemas.openxmlformats.org/officeDocument/2006/math":                "m",
+	"http://schemas.openxmlformats.org/drawingml/2006/main":                     "a",
+	"http://schemas.openxmlformats.org/drawingml/2006/chart":                    "c",
+	"http://schemas.openxmlformats.org/drawingml/2006/picture":                  "pic",
+	"http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing":    "wp",
+	"http://schemas.openxmlformats.org/officeDocument/2006/sharedTypes":         "s",
+	"http://schemas.openxmlformats.org/officeDocument/2006/extended-properties": "ep",
+	"http://schemas.openxmlformats.org/officeDocument/2006/docPropsVTypes":      "vt",
+	"http://schemas.openxmlformats.org/package/2006/metadata/core-properties":   "cp",
+	"http://schemas.openxmlformats.org/markup-compatibility/2006":               "mc",
+	"http://schemas.microsoft.com/office/word/2010/wordprocessingShape":         "wps",
+	"http://schemas.openxmlformats.org/officeDocument/2006/customXml":           "ds",
+	"http://schemas.openxmlformats.org/schemaLibrary/2006/main":                 "sl",
+	"http://schemas.openxmlformats.org/drawingml/2006/compatibility":            "comp",
+	"http://schemas.openxmlformats.org/drawingml/2006/lockedCanvas":             "lc",
+	"http://schemas.openxmlformats.org/drawingml/2006/diagram":                  "dgm",
+	"http://schemas.openxmlformats.org/drawingml/2006/chartDrawing":             "cdr",
+	"http://schemas.openxmlformats.org/officeDocument/2006/bibliography":        "b",
+	"http://schemas.openxmlformats.org/officeDocument/2006/custom-properties":   "op",
+	"http://schemas.microsoft.com/office/word/2010/wordprocessingGroup":         "wpg",
+	"http://schemas.microsoft.com/office/word/2010/wordprocessingCanvas":        "wpc",
+	"http://schemas.microsoft.com/office/word/2010/wordml":                      "w14",
+	"http://schemas.openxmlformats.org/drawingml/2006/spreadsheetDrawing":       "xdr",
+	"http://schemas.openxmlformats.org/spreadsheetml/2006/main":                 "x",
+	"http://schemas.openxmlformats.org/presentationml/2006/main":                "p",
+}
+
+// reservedChildNames are Go names a generated accessor may not take
+// because Element already uses them.
+var reservedChildNames = map[string]bool{"E": true}
+
+// XsdType selects one complex type for Convert. Type is the XSD type
+// name, optionally prefixed ("a:CT_Color") where the name alone is
+// ambiguous. Tag is the element tag the wrapper is created with; when empty
+// it is taken from the first element declared with the type. Name is the Go
+// struct name and defaults to the type's local name.
+type XsdType struct {
+	Type string
+	Tag  string
+	Name string
+}
+
+// ParseXsdWhitelist reads a whitelist of types to convert. Each line holds
+// a type followed by an optional tag and Go name, separated by spaces;
+// blank lines and text after "#" are ignored:
+//
+//	CT_P
+//	CT_OnOff   w:b
+//	a:CT_Color a:clr CT_DmlColor
+func ParseXsdWhitelist(r io.Reader) ([]XsdType, error) {
+	var types []XsdType
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		fields := strings.Fields(line)
+		switch len(fields) {
+		case 0:
+			continue
+		case 1, 2, 3:
+			t := XsdType{Type: fields[0]}
+			if len(fields) > 1 {
+				t.Tag = fields[1]
+			}
+			if len(fields) > 2 {
+				t.Name = fields[2]
+			}
+			types = append(types, t)
+		default:
+			return nil, fmt.Errorf("codegen: whitelist line %d: too many fields", n)
+		}
+	}
+	return types, sc.Err()
+}
+
+// xsdName is a namespace-qualified XSD component name.
+type xsdName struct {
+	ns, local string
+}
+
+// XsdSet is a set of XML schema documents, indexed by component name.
+type XsdSet struct {
+	prefixes     map[string]string // namespace URI → tag prefix
+	complexTypes map[xsdName]*etree.Element
+	simpleTypes  map[xsdName]*etree.Element
+	groups       map[xsdName]*etree.Element
+	attrGroups   map[xsdName]*etree.Element
+	elements     map[xsdName]*etree.Element
+	attributes   map[xsdName]*etree.Element
+	roots        []*etree.Element  // schema roots in load order
+	enumTypes    map[string]string // simple type name → enum Go type, see SetEnumTypes
+}
+
+// LoadXsdDir loads every .xsd file in dir. prefixes maps namespace URIs to
+// the tag prefixes to emit; nil means DefaultXsdPrefixes.
+func LoadXsdDir(dir string, prefixes map[string]string) (*XsdSet, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	set := NewXsdSet(prefixes)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".xsd") {
+			continue
+		}
+		f, err := os.Open(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		err = set.Add(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("codegen: %s: %w", entry.Name(), err)
+		}
+	}
+	return set, nil
+}
+
+// NewXsdSet returns an empty set. prefixes maps namespace URIs to the tag
+// prefixes to emit; nil means DefaultXsdPrefixes.
+func NewXsdSet(prefixes map[string]string) *XsdSet {
+	if prefixes == nil {
+		prefixes = DefaultXsdPrefixes
+	}
+	return &XsdSet{
+		prefixes:     prefixes,
+		complexTypes: make(map[xsdName]*etree.Element),
+		simpleTypes:  make(map[xsdName]*etree.Element),
+		groups:       make(map[xsdName]*etree.Element),
+		attrGroups:   make(map[xsdName]*etree.Element),
+		elements:     make(map[xsdName]*etree.Element),
+		attributes:   make(map[xsdName]*etree.Element),
+	}
+}
+
+// SetEnumTypes maps simple types, by local name, to the enum package types
+// their attributes are emitted as, e.g. "ST_Jc" to "enum.WdParagraphAlignment".
+// The attributes of other enumerated simple types stay strings and list
+// their values.
+func (s *XsdSet) SetEnumTypes(types map[string]string) {
+	s.enumTypes = types
+}
+
+// Add reads one schema document into the set.
+func (s *XsdSet) Add(r io.Reader) error {
+	doc := etree.NewDocument()
+	if _, err := doc.ReadFrom(r); err != nil {
+		return err
+	}
+	root := doc.Root()
+	if root == nil || root.Tag != "schema" || root.NamespaceURI() != XsdNamespace {
+		return fmt.Errorf("codegen: not an XML schema document")
+	}
+	tns := root.SelectAttrValue("targetNamespace", "")
+	for _, decl := range root.ChildElements() {
+		name := xsdName{tns, decl.SelectAttrValue("name", "")}
+		switch decl.Tag {
+		case "complexType":
+			s.complexTypes[name] = decl
+		case "simpleType":
+			s.simpleTypes[name] = decl
+		case "group":
+			s.groups[name] = decl
+		case "attributeGroup":
+			s.attrGroups[name] = decl
+		case "element":
+			s.elements[name] = decl
+		case "attribute":
+			s.attributes[name] = decl
+		}
+	}
+	s.roots = append(s.roots, root)
+	return nil
+}
+
+// Convert builds a schema for the whitelisted complex types. Children
+// whose type is not whitelisted are left out, but their tags still appear
+// in successor lists so the generated insert methods keep sequence order.
+func (s *XsdSet) Convert(pkg string, whitelist []XsdType) (Schema, error) {
+	schema := Schema{Package: pkg, Imports: []string{}}
+	goNames := make(map[xsdName]string, len(whitelist))
+	names := make([]xsdName, len(whitelist))
+	for i, w := range whitelist {
+		name, err := s.lookupType(w.Type)
+		if err != nil {
+			return schema, err
+		}
+		names[i] = name
+		goNames[name] = w.Name
+		if w.Name == "" {
+			goNames[name] = name.local
+		}
+	}
+	for i, w := range whitelist {
+		el, err := s.convertType(names[i], w, goNames)
+		if err != nil {
+			return schema, err
+		}
+		schema.Elements = append(schema.Elements, el)
+	}
+	return schema, nil
+}
+
+// lookupType resolves a whitelist type to a complex type name.
+func (s *XsdSet) lookupType(t string) (xsdName, error) {
+	if pfx, local, ok := strings.Cut(t, ":"); ok {
+		for uri, p := range s.prefixes {
+			name := xsdName{uri, local}
+			if p == pfx && s.complexTypes[name] != nil {
+				return name, nil
+			}
+		}
+		return xsdName{}, fmt.Errorf("codegen: complex type %q not found", t)
+	}
+	var found []xsdName
+	for name := range s.complexTypes {
+		if name.local == t {
+			found = append(found, name)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return xsdName{}, fmt.Errorf("codegen: complex type %q not found", t)
+	case 1:
+		return found[0], nil
+	}
+	var pfxs []string
+	for _, name := range found {
+		pfxs = append(pfxs, s.prefixes[name.ns])
+	}
+	sort.Strings(pfxs)
+	return xsdName{}, fmt.Errorf("codegen: complex type %q is ambiguous, qualify it with one of %v", t, pfxs)
+}
+
+// --- Content models ---
+
+// unbounded stands for maxOccurs="unbounded".
+const unbounded = -1
+
+// xsdParticle is an element declaration reached through a content model,
+// with occurrence bounds accumulated from its enclosing particles.
+type xsdParticle struct {
+	tag      string
+	local    string
+	typ      xsdName
+	min, max int
+}
+
+// xsdSlot is a position in a flattened content model. A slot holds one
+// element, or the members of a choice; choice marks a choice that may
+// occur at most once and so becomes a choice group, and required a choice
+// that must occur at least once.
+type xsdSlot struct {
+	particles []xsdParticle
+	choice    bool
+	required  bool
+	group     string // name of the group a choice slot came from
+}
+
+func mulOccurs(a, b int) int {
+	if a == unbounded || b == unbounded {
+		if a == 0 || b == 0 {
+			return 0
+		}
+		return unbounded
+	}
+	return a * b
+}
+
+func occurs(el *etree.Element) (min, max int, err error) {
+	min, max = 1, 1
+	if v := el.SelectAttrValue("minOccurs", ""); v != "" {
+		if min, err = strconv.Atoi(v); err != nil {
+			return 0, 0, fmt.Errorf("codegen: bad minOccurs %q", v)
+		}
+	}
+	if v := el.SelectAttrValue("maxOccurs", ""); v == "unbounded" {
+		max = unbounded
+	} else if v != "" {
+		if max, err = strconv.Atoi(v); err != nil {
+			return 0, 0, fmt.Errorf("codegen: bad maxOccurs %q", v)
+		}
+	}
+	return min, max, nil
+}
+
+// contentSlots flattens the content model of a complex type, base type
+// content first.
+func (s *XsdSet) contentSlots(ct *etree.Element) ([]xsdSlot, error) {
+	var slots []xsdSlot
+	for _, child := range xsdChildren(ct) {
+		switch child.Tag {
+		case "complexContent":
+			for _, deriv := range xsdChildren(child) {
+				if deriv.Tag == "extension" {
+					base, err := s.resolveRef(deriv, deriv.SelectAttrValue("base", ""))
+					if err != nil {
+						return nil, err
+					}
+					if baseCt := s.complexTypes[base]; baseCt != nil {
+						baseSlots, err := s.contentSlots(baseCt)
+						if err != nil {
+							return nil, err
+						}
+						slots = append(slots, baseSlots...)
+					}
+				}
+				for _, p := range xsdChildren(deriv) {
+					if err := s.flatten(p, 1, 1, "", &slots); err != nil {
+						return nil, err
+					}
+				}
+			}
+		case "sequence", "choice", "all", "group":
+			if err := s.flatten(child, 1, 1, "", &slots); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return slots, nil
+}
+
+// flatten appends the slots of particle p, whose enclosing particles occur
+// between min and max times, to slots. group names the model group p is
+// the content of, if any.
+func (s *XsdSet) flatten(p *etree.Element, min, max int, group string, slots *[]xsdSlot) error {
+	pmin, pmax, err := occurs(p)
+	if err != nil {
+		return err
+	}
+	min, max = mulOccurs(min, pmin), mulOccurs(max, pmax)
+	switch p.Tag {
+	case "element":
+		part, err := s.particle(p, min, max)
+		if err != nil {
+			return err
+		}
+		*slots = append(*slots, xsdSlot{particles: []xsdParticle{part}})
+	case "sequence", "all":
+		for _, c := range xsdChildren(p) {
+			if err := s.flatten(c, min, max, "", slots); err != nil {
+				return err
+			}
+		}
+	case "group":
+		name, err := s.resolveRef(p, p.SelectAttrValue("ref", ""))
+		if err != nil {
+			return err
+		}
+		g := s.groups[name]
+		if g == nil {
+			return fmt.Errorf("codegen: group %q not found", name.local)
+		}
+		for _, c := range xsdChildren(g) {
+			if err := s.flatten(c, min, max, name.local, slots); err != nil {
+				return err
+			}
+		}
+	case "choice":
+		var members []xsdParticle
+		if err := s.choiceMembers(p, &members); err != nil {
+			return err
+		}
+		single := max == 1
+		for _, m := range members {
+			if m.max != 1 {
+				single = false
+			}
+		}
+		slot := xsdSlot{choice: single && len(members) > 1, required: min >= 1, group: group}
+		for _, m := range members {
+			m.min = 0
+			m.max = mulOccurs(max, m.max)
+			slot.particles = append(slot.particles, m)
+		}
+		if len(slot.particles) > 0 {
+			*slots = append(*slots, slot)
+		}
+	}
+	return nil
+}
+
+// choiceMembers collects the elements reachable from the alternatives of
+// a choice. Occurrence bounds are relative to one pass through the choice.
+func (s *XsdSet) choiceMembers(p *etree.Element, members *[]xsdParticle) error {
+	for _, c := range xsdChildren(p) {
+		var slots []xsdSlot
+		if err := s.flatten(c, 1, 1, "", &slots); err != nil {
+			return err
+		}
+		for _, slot := range slots {
+			*members = append(*members, slot.particles...)
+		}
+	}
+	return nil
+}
+
+// particle resolves a local element declaration or element reference.
+func (s *XsdSet) particle(el *etree.Element, min, max int) (xsdParticle, error) {
+	decl, tns := el, s.targetNamespace(el)
+	if ref := el.SelectAttrValue("ref", ""); ref != "" {
+		name, err := s.resolveRef(el, ref)
+		if err != nil {
+			return xsdParticle{}, err
+		}
+		if decl = s.elements[name]; decl == nil {
+			return xsdParticle{}, fmt.Errorf("codegen: element %q not found", ref)
+		}
+		tns = name.ns
+	}
+	local := decl.SelectAttrValue("name", "")
+	pfx, ok := s.prefixes[tns]
+	if !ok {
+		return xsdParticle{}, fmt.Errorf("codegen: no prefix for namespace %q", tns)
+	}
+	part := xsdParticle{tag: pfx + ":" + local, local: local, min: min, max: max}
+	if typ := decl.SelectAttrValue("type", ""); typ != "" {
+		name, err := s.resolveRef(decl, typ)
+		if err != nil {
+			return xsdParticle{}, err
+		}
+		part.typ = name
+	}
+	return part, nil
+}
+
+// --- Conversion ---
+
+func cardinality(min, max int) string {
+	switch {
+	case max == 1 && min >= 1:
+		return "one_and_only_one"
+	case max == 1:
+		return "zero_or_one"
+	case min >= 1:
+		return "one_or_more"
+	}
+	return "zero_or_more"
+}
+
+func childName(local string) string {
+	name := ExportName(local)
+	if reservedChildNames[name] {
+		name += "Elem"
+	}
+	return name
+}
+
+func (s *XsdSet) convertType(name xsdName, w XsdType, goNames map[xsdName]string) (Element, error) {
+	ct := s.complexTypes[name]
+	el := Element{Name: goNames[name], Tag: w.Tag, Children: []Child{}, Attributes: []Attribute{}}
+	if el.Tag == "" {
+		tag, err := s.tagFor(name)
+		if err != nil {
+			return el, err
+		}
+		el.Tag = tag
+	}
+	el.Doc = xsdDoc(ct)
+	if el.Doc == "" {
+		el.Doc = el.Tag + " element"
+	}
+
+	slots, err := s.contentSlots(ct)
+	if err != nil {
+		return el, fmt.Errorf("codegen: %s: %w", name.local, err)
+	}
+	el.Unordered = xsdChild(ct, "all") != nil
+	if cc := xsdChild(ct, "complexContent"); cc != nil {
+		for _, deriv := range xsdChildren(cc) {
+			el.Unordered = el.Unordered || xsdChild(deriv, "all") != nil
+		}
+	}
+	seen := make(map[string]bool)
+	groupNames := make(map[string]bool)
+	for i, slot := range slots {
+		var successors []string
+		succSeen := make(map[string]bool)
+		for _, later := range slots[i+1:] {
+			for _, p := range later.particles {
+				if !succSeen[p.tag] {
+					succSeen[p.tag] = true
+					successors = append(successors, p.tag)
+				}
+			}
+		}
+		if successors == nil {
+			successors = []string{}
+		}
+
+		var kept []xsdParticle
+		for _, p := range slot.particles {
+			if _, ok := goNames[p.typ]; ok && !seen[p.tag] {
+				seen[p.tag] = true
+				kept = append(kept, p)
+			}
+		}
+		if slot.choice && len(kept) > 1 {
+			cg := ChoiceGroup{Name: choiceGroupName(slot.group, groupNames), Successors: successors}
+			for _, p := range kept {
+				cg.Choices = append(cg.Choices, Choice{Name: childName(p.local), Tag: p.tag, Type: goNames[p.typ]})
+			}
+			el.ChoiceGroups = append(el.ChoiceGroups, cg)
+			continue
+		}
+		// A required repeating choice is met by any of its members.
+		var choice string
+		if slot.required && !slot.choice {
+			choice = strings.TrimPrefix(slot.group, "EG_")
+			if choice == "" {
+				choice = "Choice"
+			}
+		}
+		for _, p := range kept {
+			child := Child{
+				Name:        childName(p.local),
+				Tag:         p.tag,
+				Type:        goNames[p.typ],
+				Cardinality: cardinality(p.min, p.max),
+				Successors:  successors,
+			}
+			if choice != "" {
+				child.Cardinality = cardinality(1, p.max)
+				if len(kept) > 1 {
+					child.Choice = choice
+				}
+			}
+			el.Children = append(el.Children, child)
+		}
+	}
+
+	attrs, err := s.collectAttributes(ct)
+	if err != nil {
+		return el, fmt.Errorf("codegen: %s: %w", name.local, err)
+	}
+	el.Attributes = append(el.Attributes, attrs...)
+	return el, nil
+}
+
+// choiceGroupName names a choice group after the model group it came from,
+// as "ColorChoice" for EG_ColorChoice, or else "Choice", numbered to keep
+// names unique within an element.
+func choiceGroupName(group string, used map[string]bool) string {
+	base := strings.TrimPrefix(group, "EG_")
+	if base == "" {
+		base = "Choice"
+	}
+	name := ExportName(base)
+	for n := 2; used[name]; n++ {
+		name = ExportName(base) + strconv.Itoa(n)
+	}
+	used[name] = true
+	return name
+}
+
+// tagFor returns the tag of the element declared with type name: a global
+// element if there is one, or else the first local declaration.
+func (s *XsdSet) tagFor(name xsdName) (string, error) {
+	hasType := func(el *etree.Element) bool {
+		if el.Tag != "element" || el.SelectAttrValue("name", "") == "" {
+			return false
+		}
+		typ, err := s.resolveRef(el, el.SelectAttrValue("type", ""))
+		return err == nil && typ == name
+	}
+	tagOf := func(el *etree.Element) string {
+		return s.prefixes[s.targetNamespace(el)] + ":" + el.SelectAttrValue("name", "")
+	}
+	for _, root := range s.roots {
+		for _, el := range root.ChildElements() {
+			if hasType(el) {
+				return tagOf(el), nil
+			}
+		}
+	}
+	var local *etree.Element
+	for _, root := range s.roots {
+		walkXsd(root, func(el *etree.Element) bool {
+			if hasType(el) {
+				local = el
+			}
+			return local == nil
+		})
+		if local != nil {
+			return tagOf(local), nil
+		}
+	}
+	return "", fmt.Errorf("codegen: no element is declared with type %q, give its tag in the whitelist", name.local)
+}
+
+// --- Attributes ---
+
+func (s *XsdSet) collectAttributes(ct *etree.Element) ([]Attribute, error) {
+	var attrs []Attribute
+	seen := make(map[string]bool)
+	var visit func(parent *etree.Element) error
+	visit = func(parent *etree.Element) error {
+		for _, c := range xsdChildren(parent) {
+			switch c.Tag {
+			case "attribute":
+				attr, ok, err := s.attribute(c)
+				if err != nil {
+					return err
+				}
+				if ok && !seen[attr.AttrName] {
+					seen[attr.AttrName] = true
+					attrs = append(attrs, attr)
+				}
+			case "attributeGroup":
+				name, err := s.resolveRef(c, c.SelectAttrValue("ref", ""))
+				if err != nil {
+					return err
+				}
+				g := s.attrGroups[name]
+				if g == nil {
+					return fmt.Errorf("attribute group %q not found", name.local)
+				}
+				if err := visit(g); err != nil {
+					return err
+				}
+			case "complexContent", "simpleContent":
+				for _, deriv := range xsdChildren(c) {
+					if deriv.Tag == "extension" {
+						base, err := s.resolveRef(deriv, deriv.SelectAttrValue("base", ""))
+						if err != nil {
+							return err
+						}
+						if baseCt := s.complexTypes[base]; baseCt != nil {
+							if err := visit(baseCt); err != nil {
+								return err
+							}
+						}
+					}
+					if err := visit(deriv); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+	return attrs, visit(ct)
+}
+
+// attribute converts an attribute declaration or reference. ok is false
+// for prohibited attributes.
+func (s *XsdSet) attribute(el *etree.Element) (attr Attribute, ok bool, err error) {
+	use := el.SelectAttrValue("use", "optional")
+	if use == "prohibited" {
+		return attr, false, nil
+	}
+	decl := el
+	qualified := el.SelectAttrValue("form", "") == "qualified" ||
+		el.SelectAttrValue("form", "") == "" && s.schemaRoot(el).SelectAttrValue("attributeFormDefault", "") == "qualified"
+	ns := s.targetNamespace(el)
+	if ref := el.SelectAttrValue("ref", ""); ref != "" {
+		name, err := s.resolveRef(el, ref)
+		if err != nil {
+			return attr, false, err
+		}
+		if name.ns == "http://www.w3.org/XML/1998/namespace" {
+			return Attribute{Name: ExportName(name.local), AttrName: "xml:" + name.local, Type: "string", Required: use == "required"}, true, nil
+		}
+		if decl = s.attributes[name]; decl == nil {
+			return attr, false, fmt.Errorf("attribute %q not found", ref)
+		}
+		qualified, ns = true, name.ns
+	}
+	local := decl.SelectAttrValue("name", "")
+	attr = Attribute{Name: ExportName(local), AttrName: local, Type: "string", Required: use == "required"}
+	if qualified && ns != "" {
+		pfx, ok := s.prefixes[ns]
+		if !ok {
+			return attr, false, fmt.Errorf("no prefix for namespace %q", ns)
+		}
+		attr.AttrName = pfx + ":" + local
+	}
+	if typ := decl.SelectAttrValue("type", ""); typ != "" {
+		name, err := s.resolveRef(decl, typ)
+		if err != nil {
+			return attr, false, err
+		}
+		if goType, ok := s.enumTypes[name.local]; ok && name.ns != XsdNamespace {
+			attr.Type = goType
+			if !attr.Required {
+				attr.Type = "*" + goType
+			}
+			return attr, true, nil
+		}
+		attr.Type = s.builtinType(name, 0)
+		if attr.Type == "string" {
+			attr.Values = s.enumeration(s.simpleTypes[name], 0)
+		}
+	} else if st := xsdChild(decl, "simpleType"); st != nil {
+		attr.Type = s.simpleTypeKind(st, 0)
+		if attr.Type == "string" {
+			attr.Values = s.enumeration(st, 0)
+		}
+	}
+	if !attr.Required {
+		if def, ok := defaultExpr(attr.Type, decl.SelectAttrValue("default", "")); ok {
+			attr.Default = &def
+		}
+	}
+	return attr, true, nil
+}
+
+// defaultExpr returns the Go expression for an XSD default value, if it is
+// a valid literal for the attribute type.
+func defaultExpr(typ, v string) (string, bool) {
+	if v == "" {
+		return "", false
+	}
+	switch typ {
+	case "bool":
+		b, err := strconv.ParseBool(v)
+		return strconv.FormatBool(b), err == nil
+	case "int", "int64":
+		_, err := strconv.ParseInt(v, 10, 64)
+		return v, err == nil
+	}
+	return strconv.Quote(v), true
+}
+
+// builtinType maps a simple type to a schema YAML attribute type by
+// following restrictions down to a built-in XSD type. Unions, lists and
+// other built-ins map to "string"; the values of an enumerated string type
+// come from enumeration, or SetEnumTypes maps it to an enum type.
+func (s *XsdSet) builtinType(name xsdName, depth int) string {
+	if name.ns == XsdNamespace {
+		switch name.local {
+		case "boolean":
+			return "bool"
+		case "int", "integer", "short", "byte", "unsignedShort", "unsignedByte",
+			"nonNegativeInteger", "positiveInteger", "negativeInteger", "nonPositiveInteger":
+			return "int"
+		case "long", "unsignedInt", "unsignedLong":
+			return "int64"
+		}
+		return "string"
+	}
+	st := s.simpleTypes[name]
+	if st == nil || depth > 32 {
+		return "string"
+	}
+	return s.simpleTypeKind(st, depth+1)
+}
+
+func (s *XsdSet) simpleTypeKind(st *etree.Element, depth int) string {
+	for _, c := range xsdChildren(st) {
+		if c.Tag != "restriction" {
+			continue
+		}
+		if base := c.SelectAttrValue("base", ""); base != "" {
+			name, err := s.resolveRef(c, base)
+			if err != nil {
+				return "string"
+			}
+			return s.builtinType(name, depth)
+		}
+		if inner := xsdChild(c, "simpleType"); inner != nil {
+			return s.simpleTypeKind(inner, depth+1)
+		}
+	}
+	return "string"
+}
+
+// enumeration returns the xsd:enumeration values of st, following
+// restrictions of other named simple types, or nil if it has none.
+func (s *XsdSet) enumeration(st *etree.Element, depth int) []string {
+	if st == nil || depth > 32 {
+		return nil
+	}
+	for _, c := range xsdChildren(st) {
+		if c.Tag != "restriction" {
+			continue
+		}
+		var values []string
+		for _, e := range xsdChildren(c) {
+			if e.Tag == "enumeration" {
+				values = append(values, e.SelectAttrValue("value", ""))
+			}
+		}
+		if len(values) > 0 {
+			return values
+		}
+		if base := c.SelectAttrValue("base", ""); base != "" {
+			name, err := s.resolveRef(c, base)
+			if err != nil || name.ns == XsdNamespace {
+				return nil
+			}
+			return s.enumeration(s.simpleTypes[name], depth+1)
+		}
+		return s.enumeration(xsdChild(c, "simpleType"), depth+1)
+	}
+	return nil
+}
+
+// --- Helpers ---
+
+// resolveRef resolves a QName-valued attribute against the namespace
+// declarations in scope at el. An unprefixed name is in the default
+// namespace; the xml prefix is always bound.
+func (s *XsdSet) resolveRef(el *etree.Element, qname string) (xsdName, error) {
+	pfx, local, ok := strings.Cut(qname, ":")
+	if !ok {
+		pfx, local = "", qname
+	}
+	if pfx == "xml" {
+		return xsdName{"http://www.w3.org/XML/1998/namespace", local}, nil
+	}
+	for e := el; e != nil; e = e.Parent() {
+		for _, a := range e.Attr {
+			if pfx == "" && a.Space == "" && a.Key == "xmlns" || pfx != "" && a.Space == "xmlns" && a.Key == pfx {
+				return xsdName{a.Value, local}, nil
+			}
+		}
+	}
+	if pfx == "" {
+		return xsdName{"", local}, nil
+	}
+	return xsdName{}, fmt.Errorf("codegen: undeclared prefix in %q", qname)
+}
+
+func (s *XsdSet) schemaRoot(el *etree.Element) *etree.Element {
+	// The document itself is the parent of the root, as an untagged element.
+	for p := el.Parent(); p != nil && p.Tag != ""; p = el.Parent() {
+		el = p
+	}
+	return el
+}
+
+func (s *XsdSet) targetNamespace(el *etree.Element) string {
+	return s.schemaRoot(el).SelectAttrValue("targetNamespace", "")
+}
+
+// xsdChildren returns the XML Schema child elements of el, skipping
+// annotations.
+func xsdChildren(el *etree.Element) []*etree.Element {
+	var out []*etree.Element
+	for _, c := range el.ChildElements() {
+		if c.NamespaceURI() == XsdNamespace && c.Tag != "annotation" {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// xsdChild returns the first XML Schema child element of el named tag.
+func xsdChild(el *etree.Element, tag string) *etree.Element {
+	for _, c := range xsdChildren(el) {
+		if c.Tag == tag {
+			return c
+		}
+	}
+	return nil
+}
+
+// xsdDoc returns the first sentence of el's documentation annotation,
+// lowercased and without its final period to match the schema files.
+func xsdDoc(el *etree.Element) string {
+	for _, c := range el.ChildElements() {
+		if c.Tag != "annotation" {
+			continue
+		}
+		for _, d := range c.ChildElements() {
+			if d.Tag != "documentation" {
+				continue
+			}
+			text := strings.Join(strings.Fields(d.Text()), " ")
+			if i := strings.Index(text, ". "); i >= 0 {
+				text = text[:i]
+			}
+			text = strings.TrimSuffix(text, ".")
+			if text != "" {
+				return strings.ToLower(text[:1]) + text[1:]
+			}
+		}
+	}
+	return ""
+}
+
+// walkXsd calls fn for el's descendants in document order until fn
+// returns false.
+func walkXsd(el *etree.Element, fn func(*etree.Element) bool) bool {
+	for _, c := range el.ChildElements() {
+		if !fn(c) || !walkXsd(c, fn) {
+			return false
+		}
+	}
+	return true
+}
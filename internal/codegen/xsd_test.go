@@ -0,0 +1,283 @@
+package codegen
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func loadTestXsd(t *testing.T, whitelist string) Schema {
+	t.Helper()
+	set, err := LoadXsdDir("testdata/xsd", nil)
+	if err != nil {
+		t.Fatalf("LoadXsdDir: %v", err)
+	}
+	types, err := ParseXsdWhitelist(strings.NewReader(whitelist))
+	if err != nil {
+		t.Fatalf("ParseXsdWhitelist: %v", err)
+	}
+	schema, err := set.Convert("oxml", types)
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	return schema
+}
+
+func findElement(t *testing.T, schema Schema, name string) Element {
+	t.Helper()
+	for _, el := range schema.Elements {
+		if el.Name == name {
+			return el
+		}
+	}
+	t.Fatalf("element %s not in schema", name)
+	return Element{}
+}
+
+const testWhitelist = `
+# WordprocessingML
+CT_P
+CT_PPr
+CT_R
+CT_Text
+w:CT_Empty  w:sectPr
+CT_String   w:pStyle
+CT_OnOff    w:b
+CT_DecimalNumber w:ilvl
+w:CT_Color  w:color
+CT_Border w:top
+CT_Body
+CT_Tbl
+CT_Tc
+CT_Document
+CT_DocVars
+CT_Jc        w:jc
+CT_Underline w:u
+
+# DrawingML
+a:CT_Color  a:clr   CT_DmlColor
+CT_SRgbColor
+CT_SchemeColor
+CT_SolidColorFillProperties a:solidFill
+CT_LineProperties           a:ln
+`
+
+func TestXsd_SequenceAndExtension(t *testing.T) {
+	t.Parallel()
+	schema := loadTestXsd(t, testWhitelist)
+	pPr := findElement(t, schema, "CT_PPr")
+	if pPr.Tag != "w:pPr" {
+		t.Errorf("CT_PPr tag = %q, want w:pPr from its local declaration", pPr.Tag)
+	}
+	var names []string
+	for _, c := range pPr.Children {
+		names = append(names, c.Name+":"+c.Cardinality)
+	}
+	if got := strings.Join(names, " "); got != "PStyle:zero_or_one KeepNext:zero_or_one Jc:zero_or_one SectPr:zero_or_one" {
+		t.Errorf("CT_PPr children = %s", got)
+	}
+	want := []string{"w:keepNext", "w:spacing", "w:jc", "w:sectPr"}
+	if !reflect.DeepEqual(pPr.Children[0].Successors, want) {
+		t.Errorf("pStyle successors = %v, want %v (non-whitelisted w:spacing kept)", pPr.Children[0].Successors, want)
+	}
+	if got := pPr.Children[3].Successors; len(got) != 0 {
+		t.Errorf("sectPr successors = %v", got)
+	}
+
+	body := findElement(t, schema, "CT_Body")
+	if body.Children[0].Cardinality != "zero_or_more" || body.Children[1].Cardinality != "one_and_only_one" {
+		t.Errorf("CT_Body children = %+v", body.Children)
+	}
+	if doc := findElement(t, schema, "CT_Document"); doc.Tag != "w:document" {
+		t.Errorf("CT_Document tag = %q, want the global element", doc.Tag)
+	}
+	if findElement(t, schema, "CT_Body").Unordered || !findElement(t, schema, "CT_DocVars").Unordered {
+		t.Error("only xsd:all content should be unordered")
+	}
+	border := findElement(t, schema, "CT_Border")
+	if c := border.Children[0]; c.Type != "CT_LineProperties" || c.Tag != "w:ln" || c.Cardinality != "one_or_more" {
+		t.Errorf("CT_Border child = %+v", c)
+	}
+}
+
+func TestXsd_RepeatingChoice(t *testing.T) {
+	t.Parallel()
+	schema := loadTestXsd(t, testWhitelist)
+	p := findElement(t, schema, "CT_P")
+	if len(p.Children) != 2 || len(p.ChoiceGroups) != 0 {
+		t.Fatalf("CT_P children = %+v, choice groups = %+v", p.Children, p.ChoiceGroups)
+	}
+	if c := p.Children[0]; c.Name != "PPr" || !reflect.DeepEqual(c.Successors, []string{"w:r", "w:hyperlink"}) {
+		t.Errorf("pPr = %+v", c)
+	}
+	if c := p.Children[1]; c.Name != "R" || c.Cardinality != "zero_or_more" || len(c.Successors) != 0 {
+		t.Errorf("r = %+v", c)
+	}
+
+	r := findElement(t, schema, "CT_R")
+	var names []string
+	for _, c := range r.Children {
+		names = append(names, c.Name+":"+c.Cardinality)
+	}
+	if got := strings.Join(names, " "); got != "RPr:zero_or_one Br:zero_or_more T:zero_or_more EElem:zero_or_more" {
+		t.Errorf("CT_R children = %s", got)
+	}
+}
+
+func TestXsd_RequiredRepeatingChoice(t *testing.T) {
+	t.Parallel()
+	schema := loadTestXsd(t, testWhitelist)
+	tc := findElement(t, schema, "CT_Tc")
+	var names []string
+	for _, c := range tc.Children {
+		names = append(names, c.Name+":"+c.Cardinality+":"+c.Choice)
+	}
+	if got := strings.Join(names, " "); got != "TcPr:zero_or_one: P:one_or_more:BlockLevelElts Tbl:one_or_more:BlockLevelElts" {
+		t.Errorf("CT_Tc children = %s", got)
+	}
+	if len(tc.ChoiceGroups) != 0 {
+		t.Errorf("CT_Tc choice groups = %+v", tc.ChoiceGroups)
+	}
+}
+
+func TestXsd_ChoiceGroup(t *testing.T) {
+	t.Parallel()
+	schema := loadTestXsd(t, testWhitelist)
+	for _, name := range []string{"CT_DmlColor", "CT_SolidColorFillProperties"} {
+		el := findElement(t, schema, name)
+		if len(el.ChoiceGroups) != 1 {
+			t.Fatalf("%s choice groups = %+v", name, el.ChoiceGroups)
+		}
+		cg := el.ChoiceGroups[0]
+		if cg.Name != "ColorChoice" || len(cg.Choices) != 2 {
+			t.Errorf("%s choice group = %+v", name, cg)
+		}
+		if c := cg.Choices[0]; c.Name != "SrgbClr" || c.Tag != "a:srgbClr" || c.Type != "CT_SRgbColor" {
+			t.Errorf("%s first choice = %+v", name, c)
+		}
+	}
+	if doc := findElement(t, schema, "CT_DmlColor").Doc; doc != "a DrawingML color" {
+		t.Errorf("doc = %q", doc)
+	}
+	ln := findElement(t, schema, "CT_LineProperties")
+	if c := ln.Children[0]; c.Type != "CT_SolidColorFillProperties" || !reflect.DeepEqual(c.Successors, []string{"a:extLst"}) {
+		t.Errorf("a:solidFill = %+v", c)
+	}
+}
+
+func TestXsd_Attributes(t *testing.T) {
+	t.Parallel()
+	schema := loadTestXsd(t, testWhitelist)
+	tests := []struct {
+		el, attr, typ string
+		required      bool
+		def           string
+	}{
+		{"CT_String", "w:val", "string", true, ""},
+		{"CT_OnOff", "w:val", "string", false, ""},
+		{"CT_DecimalNumber", "w:val", "int", true, ""},
+		{"CT_Color", "w:themeTint", "int", false, ""},
+		{"CT_Text", "xml:space", "string", false, ""},
+		{"CT_SRgbColor", "val", "string", true, ""},
+		{"CT_LineProperties", "w", "int64", false, "0"},
+		{"CT_Border", "w:sz", "int64", false, "4"},
+		{"CT_Border", "w:space", "int", false, ""},
+		{"CT_R", "w:rsidRPr", "string", false, ""},
+		{"CT_P", "w:rsidP", "string", false, ""},
+	}
+	for _, tt := range tests {
+		el := findElement(t, schema, tt.el)
+		var found *Attribute
+		for i := range el.Attributes {
+			if el.Attributes[i].AttrName == tt.attr {
+				found = &el.Attributes[i]
+			}
+		}
+		if found == nil {
+			t.Errorf("%s has no attribute %s: %+v", tt.el, tt.attr, el.Attributes)
+			continue
+		}
+		def := ""
+		if found.Default != nil {
+			def = *found.Default
+		}
+		if found.Type != tt.typ || found.Required != tt.required || def != tt.def {
+			t.Errorf("%s %s = %+v (default %q)", tt.el, tt.attr, *found, def)
+		}
+	}
+	for _, a := range findElement(t, schema, "CT_R").Attributes {
+		if a.AttrName == "w:rsidDel" {
+			t.Error("prohibited attribute should be skipped")
+		}
+	}
+}
+
+func TestXsd_Enumerations(t *testing.T) {
+	t.Parallel()
+	schema := loadTestXsd(t, testWhitelist)
+	tests := []struct {
+		el, attr string
+		values   []string
+	}{
+		{"CT_Jc", "w:val", []string{"start", "center", "end"}},
+		{"CT_Underline", "w:val", []string{"start", "center", "end"}},
+		{"CT_Underline", "w:color", []string{"auto"}},
+		{"CT_String", "w:val", nil},
+	}
+	for _, tt := range tests {
+		for _, a := range findElement(t, schema, tt.el).Attributes {
+			if a.AttrName == tt.attr && (a.Type != "string" || !reflect.DeepEqual(a.Values, tt.values)) {
+				t.Errorf("%s %s = %+v, want values %v", tt.el, tt.attr, a, tt.values)
+			}
+		}
+	}
+	code := generateCode(t, schema)
+	assertContains(t, code, `{name: "w:val", check: checkValuesAttr("start", "center", "end")}`)
+
+	set, err := LoadXsdDir("testdata/xsd", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set.SetEnumTypes(map[string]string{"ST_Jc": "enum.WdParagraphAlignment", "ST_Underline": "enum.WdUnderline"})
+	types, _ := ParseXsdWhitelist(strings.NewReader("CT_Jc w:jc\nCT_Underline w:u"))
+	mapped, err := set.Convert("oxml", types)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jc, u := findElement(t, mapped, "CT_Jc").Attributes[0], findElement(t, mapped, "CT_Underline").Attributes[0]
+	if jc.Type != "enum.WdParagraphAlignment" || jc.Values != nil {
+		t.Errorf("mapped CT_Jc w:val = %+v", jc)
+	}
+	if u.Type != "*enum.WdUnderline" || u.Default != nil {
+		t.Errorf("mapped CT_Underline w:val = %+v", u)
+	}
+}
+
+func TestXsd_GeneratesCode(t *testing.T) {
+	t.Parallel()
+	code := generateCode(t, loadTestXsd(t, testWhitelist))
+	assertContains(t, code, "func (e *CT_P) AddR() *CT_R")
+	assertContains(t, code, "func (e *CT_DmlColor) GetOrChangeToSchemeClr() *CT_SchemeColor")
+	assertContains(t, code, "func (e *CT_Border) Sz() int64")
+}
+
+func TestXsd_Errors(t *testing.T) {
+	t.Parallel()
+	set, err := LoadXsdDir("testdata/xsd", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, types := range [][]XsdType{
+		{{Type: "CT_Color"}},         // ambiguous between w: and a:
+		{{Type: "CT_Missing"}},       // unknown
+		{{Type: "x:CT_P"}},           // wrong prefix
+		{{Type: "CT_DecimalNumber"}}, // no declaration gives its tag
+	} {
+		if _, err := set.Convert("oxml", types); err == nil {
+			t.Errorf("Convert(%v) should fail", types)
+		}
+	}
+	if _, err := ParseXsdWhitelist(strings.NewReader("CT_P w:p CT_P extra")); err == nil {
+		t.Error("expected an error for a line with too many fields")
+	}
+}
@@ -0,0 +1,41 @@
+package document
+
+import (
+	"strconv"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
+	"github.com/user/go-docx/pkg/docx/oxml"
+)
+
+// AddChart adds an inline chart of chartType plotting data, width by height,
+// to the run. It creates the chart part, related from the run's story part,
+// and embeds a workbook holding data so the chart stays editable in Word.
+func (r *Run) AddChart(chartType enum.XlChartType, data oxml.ChartData, width, height docx.Length) (*oxml.CT_Inline, error) {
+	chartSpace, err := oxml.NewChartSpace(chartType, data)
+	if err != nil {
+		return nil, err
+	}
+	workbook, err := oxml.NewChartWorkbook(data)
+	if err != nil {
+		return nil, err
+	}
+	_, rId := r.doc.pkg.AddChartPart(r.story.Part, chartSpace.E, workbook)
+	return r.CT_R.AddChart(r.doc.nextShapeId(), rId, int64(width), int64(height)), nil
+}
+
+var docPrIdQuery = oxml.MustCompileQuery("//wp:docPr/@id")
+
+// nextShapeId returns a drawing object id not used in any story of the
+// document.
+func (d *Document) nextShapeId() int {
+	maxId := 0
+	for _, story := range d.Stories() {
+		for _, v := range docPrIdQuery.Strings(story.Part.Element()) {
+			if id, err := strconv.Atoi(v); err == nil {
+				maxId = max(maxId, id)
+			}
+		}
+	}
+	return maxId + 1
+}
@@ -0,0 +1,83 @@
+package document
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
+	"github.com/user/go-docx/pkg/docx/opc"
+	"github.com/user/go-docx/pkg/docx/oxml"
+)
+
+func TestRun_AddChart(t *testing.T) {
+	doc := newTestDocument(t)
+	data := oxml.ChartData{
+		Categories: []string{"Q1", "Q2", "Q3"},
+		Series:     []oxml.ChartSeries{{Name: "Sales", Values: []float64{1, 2.5, 4}}},
+	}
+	hdr := doc.Stories()[1].Root().(*oxml.CT_HdrFtr)
+	for _, r := range []*oxml.CT_R{doc.Element().Body().AddP().AddR(), hdr.PList()[0].AddR()} {
+		run, err := doc.Run(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := run.AddChart(enum.XlChartTypeColumnClustered, data, docx.Inches(6), docx.Inches(3.5)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	run, err := doc.Run(hdr.PList()[0].RList()[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := run.AddChart(enum.XlChartTypeXYScatter, data, docx.Inches(1), docx.Inches(1)); err == nil {
+		t.Error("AddChart accepted scatter data without x values")
+	}
+
+	saved, err := doc.Package().SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc, err = OpenBytes(saved); err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range doc.Validate() {
+		t.Errorf("Validate: %v", e)
+	}
+	var shapeIds []int
+	for _, story := range doc.Stories()[:2] {
+		root := oxml.WrapElement(story.Part.Element())
+		var runs []*oxml.CT_R
+		if body, ok := root.(*oxml.CT_Document); ok {
+			runs = body.Body().PList()[0].RList()
+		} else {
+			runs = root.(*oxml.CT_HdrFtr).PList()[0].RList()
+		}
+		r := runs[len(runs)-1]
+		rIds := r.ChartRIds()
+		if len(rIds) != 1 {
+			t.Fatalf("%s: ChartRIds() = %v", story.Part.PartName(), rIds)
+		}
+		rel := story.Part.Rels().GetByRID(rIds[0])
+		if rel == nil || rel.RelType != opc.RTChart {
+			t.Fatalf("%s: chart relationship = %+v", story.Part.PartName(), rel)
+		}
+		el, err := oxml.ParseXml(rel.TargetPart.Blob())
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := (&oxml.CT_ChartSpace{Element: oxml.Element{E: el}}).ChartData()
+		if err != nil || !reflect.DeepEqual(got, data) {
+			t.Errorf("%s: ChartData() = %+v, %v", story.Part.PartName(), got, err)
+		}
+		if wb := rel.TargetPart.Rels().GetByRID("rId1"); wb == nil || wb.TargetPart.ContentType() != opc.CTSmlSheet {
+			t.Errorf("%s: embedded workbook = %+v", story.Part.PartName(), wb)
+		}
+		inline := oxml.MustCompileQuery(".//wp:inline").SelectFirst(r.E)
+		id, _ := (&oxml.CT_Inline{Element: oxml.Element{E: inline}}).DocPr().Id()
+		shapeIds = append(shapeIds, id)
+	}
+	if shapeIds[0] == shapeIds[1] {
+		t.Errorf("charts share shape id %d", shapeIds[0])
+	}
+}
@@ -0,0 +1,141 @@
+// Package document joins the opc and oxml layers for operations that span the
+// parts of a WordprocessingML package, such as validating or walking all of a
+// document's stories.
+package document
+
+import (
+	"fmt"
+
+	"github.com/user/go-docx/pkg/docx/opc"
+	"github.com/user/go-docx/pkg/docx/oxml"
+)
+
+// storyRelTypes are the relationship types of the parts holding a story
+// of their own, other than the main document part.
+var storyRelTypes = []string{opc.RTHeader, opc.RTFooter, opc.RTFootnotes, opc.RTEndnotes, opc.RTComments}
+
+// NewPartFactory returns a part factory that loads the main document part
+// and the other story parts as *opc.XmlPart, so their trees can be read and
+// edited. Other parts are left to the package's defaults.
+func NewPartFactory() *opc.PartFactory {
+	f := opc.NewPartFactory()
+	f.SetSelector(func(contentType, relType string) opc.PartConstructor {
+		if relType != opc.RTOfficeDocument && !isStoryRelType(relType) {
+			return nil
+		}
+		return func(partName opc.PackURI, contentType, relType string, blob []byte, pkg *opc.OpcPackage) (opc.Part, error) {
+			return opc.NewXmlPart(partName, contentType, blob, pkg)
+		}
+	})
+	return f
+}
+
+func isStoryRelType(relType string) bool {
+	for _, rt := range storyRelTypes {
+		if relType == rt {
+			return true
+		}
+	}
+	return false
+}
+
+// Document is a WordprocessingML package with its story parts parsed.
+type Document struct {
+	pkg  *opc.OpcPackage
+	part *opc.XmlPart
+}
+
+// Open reads a document from path.
+func Open(path string, opts ...opc.OpenOption) (*Document, error) {
+	pkg, err := opc.OpenFile(path, NewPartFactory(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return New(pkg)
+}
+
+// OpenBytes reads a document from data.
+func OpenBytes(data []byte, opts ...opc.OpenOption) (*Document, error) {
+	pkg, err := opc.OpenBytes(data, NewPartFactory(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return New(pkg)
+}
+
+// New returns the document held by pkg, which must have been opened with
+// NewPartFactory.
+func New(pkg *opc.OpcPackage) (*Document, error) {
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		return nil, err
+	}
+	part, ok := main.(*opc.XmlPart)
+	if !ok || part.Element() == nil {
+		return nil, fmt.Errorf("document: main document part %s is not parsed", main.PartName())
+	}
+	return &Document{pkg: pkg, part: part}, nil
+}
+
+// Package returns the package holding the document.
+func (d *Document) Package() *opc.OpcPackage {
+	return d.pkg
+}
+
+// Part returns the main document part.
+func (d *Document) Part() *opc.XmlPart {
+	return d.part
+}
+
+// Element returns the root <w:document> element.
+func (d *Document) Element() *oxml.CT_Document {
+	return &oxml.CT_Document{Element: oxml.Element{E: d.part.Element()}}
+}
+
+// Story is a part holding document content: the main document, a header or
+// footer, the footnotes, the endnotes or the comments.
+type Story struct {
+	Part *opc.XmlPart
+}
+
+// Root returns the root element of the story, wrapped in its CT_* type.
+func (s Story) Root() oxml.Node {
+	return oxml.WrapElement(s.Part.Element())
+}
+
+// Stories returns the stories of the document in reading order: the main
+// document, the headers and footers in the order the sections reference
+// them, then the footnotes, endnotes and comments. Headers and footers no
+// section references follow the referenced ones.
+func (d *Document) Stories() []Story {
+	stories := []Story{{Part: d.part}}
+	seen := map[opc.Part]bool{d.part: true}
+	add := func(rel *opc.Relationship) {
+		if rel == nil || rel.IsExternal || seen[rel.TargetPart] {
+			return
+		}
+		if part, ok := rel.TargetPart.(*opc.XmlPart); ok && part.Element() != nil {
+			seen[part] = true
+			stories = append(stories, Story{Part: part})
+		}
+	}
+
+	rels := d.part.Rels()
+	for _, sectPr := range sectPrQuery.Select(d.part.Element()) {
+		for _, ref := range hdrFtrRefQuery.Select(sectPr) {
+			id, _ := (&oxml.Element{E: ref}).GetAttr("r:id")
+			add(rels.GetByRID(id))
+		}
+	}
+	for _, relType := range storyRelTypes {
+		for _, rel := range rels.AllByRelType(relType) {
+			add(rel)
+		}
+	}
+	return stories
+}
+
+var (
+	sectPrQuery    = oxml.MustCompileQuery("//w:sectPr")
+	hdrFtrRefQuery = oxml.MustCompileQuery("w:headerReference | w:footerReference")
+)
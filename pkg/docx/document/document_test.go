@@ -0,0 +1,100 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx/enum"
+	"github.com/user/go-docx/pkg/docx/opc"
+	"github.com/user/go-docx/pkg/docx/oxml"
+	"github.com/user/go-docx/pkg/docx/templates"
+)
+
+const footnotesXml = `<w:footnotes xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+	`<w:footnote w:id="1"><w:p><w:r><w:t>note</w:t></w:r></w:p></w:footnote></w:footnotes>`
+
+// newTestDocument returns the default document with a table in its body,
+// and a header, footer, footnotes and comments, round-tripped through a
+// save so that every story is read back by NewPartFactory.
+func newTestDocument(t *testing.T) *Document {
+	t.Helper()
+	data, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := OpenBytes(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := doc.Package()
+	addStory := func(name opc.PackURI, contentType, relType string, blob []byte) string {
+		part, err := opc.NewXmlPart(name, contentType, blob, pkg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkg.AddPart(part)
+		return doc.Part().Rels().GetOrAdd(relType, part).RID
+	}
+	read := func(name string) []byte {
+		blob, err := templates.FS.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return blob
+	}
+	// Added in the reverse of reading order, which Stories must restore.
+	addStory("/word/comments.xml", opc.CTWmlComments, opc.RTComments, read("default-comments.xml"))
+	addStory("/word/footnotes.xml", opc.CTWmlFootnotes, opc.RTFootnotes, []byte(footnotesXml))
+	ftrId := addStory("/word/footer1.xml", opc.CTWmlFooter, opc.RTFooter, read("default-footer.xml"))
+	hdrId := addStory("/word/header1.xml", opc.CTWmlHeader, opc.RTHeader, read("default-header.xml"))
+
+	body := doc.Element().Body()
+	body.E.InsertChildAt(body.SectPr().E.Index(), oxml.NewTbl(2, 2, 9360).E)
+	body.SectPr().AddHeaderRef(enum.WdHeaderFooterIndexPrimary, hdrId)
+	body.SectPr().AddFooterRef(enum.WdHeaderFooterIndexPrimary, ftrId)
+
+	saved, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc, err = OpenBytes(saved); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestDocument_Stories(t *testing.T) {
+	doc := newTestDocument(t)
+	var got []string
+	for _, s := range doc.Stories() {
+		got = append(got, string(s.Part.PartName())+" "+s.Root().Etree().Tag)
+	}
+	want := []string{
+		"/word/document.xml document",
+		"/word/header1.xml hdr",
+		"/word/footer1.xml ftr",
+		"/word/footnotes.xml footnotes",
+		"/word/comments.xml comments",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Stories() = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Stories()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestNew_NeedsParsedMainPart(t *testing.T) {
+	data, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := opc.OpenBytes(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(pkg); err == nil {
+		t.Error("New accepted a package opened without NewPartFactory")
+	}
+}
@@ -0,0 +1,121 @@
+package document
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/opc"
+	"github.com/user/go-docx/pkg/docx/oxml"
+)
+
+// defaultDpi is the resolution assumed for an image that does not record
+// its own.
+const defaultDpi = 72
+
+// imageFormats maps the formats recognised by image.DecodeConfig to their
+// content type and file extension.
+var imageFormats = map[string][2]string{
+	"png":  {opc.CTPng, "png"},
+	"jpeg": {opc.CTJpeg, "jpeg"},
+	"gif":  {opc.CTGif, "gif"},
+}
+
+// AddFloatingPicture adds the PNG, JPEG or GIF image read from img to the
+// run as a floating picture positioned by opts. It creates the image part,
+// related from the run's story part. A zero width or height is computed
+// from the other, keeping the image's aspect ratio; when both are zero the
+// picture takes the image's native size at its recorded resolution.
+func (r *Run) AddFloatingPicture(img io.Reader, width, height docx.Length, opts oxml.AnchorOptions) (*oxml.CT_Anchor, error) {
+	blob, err := io.ReadAll(img)
+	if err != nil {
+		return nil, fmt.Errorf("document: reading image: %w", err)
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(blob))
+	if err != nil {
+		return nil, fmt.Errorf("document: reading image: %w", err)
+	}
+	f, ok := imageFormats[format]
+	if !ok {
+		return nil, fmt.Errorf("document: unsupported image format %q", format)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, fmt.Errorf("document: image has no size")
+	}
+	width, height = pictureSize(cfg, imageDpi(blob, format), width, height)
+
+	part, rId := r.doc.pkg.AddImagePart(r.story.Part, blob, f[0], f[1])
+	filename := part.PartName().Filename()
+	return r.CT_R.AddFloatingPicture(r.doc.nextShapeId(), rId, filename, int64(width), int64(height), opts), nil
+}
+
+// pictureSize returns the size at which an image of cfg pixels at dpi is
+// drawn when asked for width by height, either of which may be zero.
+func pictureSize(cfg image.Config, dpi [2]int, width, height docx.Length) (docx.Length, docx.Length) {
+	// A very high resolution can round the native size down to nothing; it
+	// is kept at least 1 EMU so the aspect ratio can be applied.
+	nativeW := max(docx.Length(int64(cfg.Width)*int64(docx.Inches(1))/int64(dpi[0])), 1)
+	nativeH := max(docx.Length(int64(cfg.Height)*int64(docx.Inches(1))/int64(dpi[1])), 1)
+	switch {
+	case width == 0 && height == 0:
+		return nativeW, nativeH
+	case width == 0:
+		return docx.Length(int64(nativeW) * int64(height) / int64(nativeH)), height
+	case height == 0:
+		return width, docx.Length(int64(nativeH) * int64(width) / int64(nativeW))
+	}
+	return width, height
+}
+
+// imageDpi returns the horizontal and vertical resolution recorded in a PNG
+// pHYs chunk or a JPEG JFIF header, or defaultDpi when there is none.
+func imageDpi(blob []byte, format string) [2]int {
+	dpi := [2]int{defaultDpi, defaultDpi}
+	switch format {
+	case "png":
+		// Chunks follow the 8-byte signature: length, type, data, CRC.
+		for i := 8; i+8 <= len(blob); {
+			n := int(binary.BigEndian.Uint32(blob[i:]))
+			typ := string(blob[i+4 : i+8])
+			if typ == "IDAT" || i+12+n > len(blob) {
+				break
+			}
+			if typ == "pHYs" && n == 9 && blob[i+16] == 1 {
+				// Pixels per metre.
+				x := binary.BigEndian.Uint32(blob[i+8:])
+				y := binary.BigEndian.Uint32(blob[i+12:])
+				if x > 0 && y > 0 {
+					dpi = [2]int{int(float64(x)*0.0254 + 0.5), int(float64(y)*0.0254 + 0.5)}
+				}
+				break
+			}
+			i += 12 + n
+		}
+	case "jpeg":
+		// A JFIF APP0 segment directly follows the SOI marker.
+		if len(blob) >= 18 && blob[2] == 0xFF && blob[3] == 0xE0 && string(blob[6:11]) == "JFIF\x00" {
+			x := int(binary.BigEndian.Uint16(blob[14:]))
+			y := int(binary.BigEndian.Uint16(blob[16:]))
+			if x > 0 && y > 0 {
+				switch blob[13] {
+				case 1: // dots per inch
+					dpi = [2]int{x, y}
+				case 2: // dots per cm
+					dpi = [2]int{int(float64(x)*2.54 + 0.5), int(float64(y)*2.54 + 0.5)}
+				}
+			}
+		}
+	}
+	for i := range dpi {
+		if dpi[i] <= 0 {
+			dpi[i] = defaultDpi
+		}
+	}
+	return dpi
+}
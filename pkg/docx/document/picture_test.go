@@ -0,0 +1,138 @@
+package document
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
+	"github.com/user/go-docx/pkg/docx/opc"
+	"github.com/user/go-docx/pkg/docx/oxml"
+)
+
+func TestRun_AddFloatingPicture(t *testing.T) {
+	doc := newTestDocument(t)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 144, 72))); err != nil {
+		t.Fatal(err)
+	}
+	run, err := doc.Run(doc.Element().Body().AddP().AddR())
+	if err != nil {
+		t.Fatal(err)
+	}
+	center := enum.WdShapePositionCenter
+	opts := oxml.AnchorOptions{RelativeH: enum.WdRelativeHorizontalPositionPage, AlignH: &center, Wrap: enum.WdWrapTypeSquare}
+	anchor, err := run.AddFloatingPicture(bytes.NewReader(buf.Bytes()), 0, 0, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cx, _ := anchor.Extent().Cx(); cx != int64(docx.Inches(2)) {
+		t.Errorf("native width = %d, want 2in at 72 dpi", cx)
+	}
+	if _, err := run.AddFloatingPicture(bytes.NewReader(buf.Bytes()), 0, docx.Inches(3), opts); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := run.AddFloatingPicture(bytes.NewReader([]byte("not an image")), 0, 0, opts); err == nil {
+		t.Error("AddFloatingPicture accepted a blob that is not an image")
+	}
+
+	saved, err := doc.Package().SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc, err = OpenBytes(saved); err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range doc.Validate() {
+		t.Errorf("Validate: %v", e)
+	}
+	ps := doc.Element().Body().PList()
+	anchors := oxml.MustCompileQuery(".//wp:anchor").Select(ps[len(ps)-1].E)
+	if len(anchors) != 2 {
+		t.Fatalf("found %d anchors, want 2", len(anchors))
+	}
+	var shapeIds []int
+	for _, el := range anchors {
+		a := &oxml.CT_Anchor{Element: oxml.Element{E: el}}
+		if got := a.Options(); got.AlignH == nil || *got.AlignH != center || got.Wrap != enum.WdWrapTypeSquare {
+			t.Errorf("anchor options = %+v", got)
+		}
+		id, _ := a.DocPr().Id()
+		shapeIds = append(shapeIds, id)
+		blip := el.FindElement(".//a:blip")
+		rel := doc.Part().Rels().GetByRID(blip.SelectAttrValue("r:embed", ""))
+		if rel == nil || rel.RelType != opc.RTImage || rel.TargetPart.ContentType() != opc.CTPng {
+			t.Fatalf("image relationship = %+v", rel)
+		}
+		if !bytes.Equal(rel.TargetPart.Blob(), buf.Bytes()) {
+			t.Error("image part does not hold the image")
+		}
+	}
+	if shapeIds[0] == shapeIds[1] {
+		t.Errorf("pictures share shape id %d", shapeIds[0])
+	}
+	second := &oxml.CT_Anchor{Element: oxml.Element{E: anchors[1]}}
+	if cx, _ := second.Extent().Cx(); cx != int64(docx.Inches(6)) {
+		t.Errorf("width for a 3in height = %d, want 6in", cx)
+	}
+}
+
+func TestPictureSize_HighDpi(t *testing.T) {
+	// 40,000,000 px/m is about 1,016,000 dpi: a 1x1 image is under 1 EMU.
+	dpi := [2]int{1016000, 1016000}
+	cfg := image.Config{Width: 1, Height: 1}
+	if w, h := pictureSize(cfg, dpi, docx.Inches(1), 0); w != docx.Inches(1) || h != docx.Inches(1) {
+		t.Errorf("pictureSize(1in, 0) = %d, %d", w, h)
+	}
+	if w, h := pictureSize(cfg, dpi, 0, 0); w != 1 || h != 1 {
+		t.Errorf("native size = %d, %d, want 1 EMU", w, h)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatal(err)
+	}
+	// Insert a pHYs chunk of 40,000,000 px/m after IHDR.
+	blob := buf.Bytes()
+	phys := []byte{0, 0, 0, 9, 'p', 'H', 'Y', 's', 0x02, 0x62, 0x5A, 0x00, 0x02, 0x62, 0x5A, 0x00, 1}
+	phys = binary.BigEndian.AppendUint32(phys, crc32.ChecksumIEEE(phys[4:]))
+	blob = append(append(append([]byte{}, blob[:33]...), phys...), blob[33:]...)
+	if got := imageDpi(blob, "png"); got != dpi {
+		t.Fatalf("imageDpi = %v, want %v", got, dpi)
+	}
+	doc := newTestDocument(t)
+	run, err := doc.Run(doc.Element().Body().AddP().AddR())
+	if err != nil {
+		t.Fatal(err)
+	}
+	anchor, err := run.AddFloatingPicture(bytes.NewReader(blob), docx.Inches(1), 0, oxml.AnchorOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cy, _ := anchor.Extent().Cy(); cy != int64(docx.Inches(1)) {
+		t.Errorf("height = %d, want 1in", cy)
+	}
+}
+
+func TestImageDpi(t *testing.T) {
+	jfif := []byte{0xFF, 0xD8, 0xFF, 0xE0, 0, 16, 'J', 'F', 'I', 'F', 0, 1, 1, 1, 0, 150, 0x01, 0x2C}
+	if got := imageDpi(jfif, "jpeg"); got != [2]int{150, 300} {
+		t.Errorf("JFIF dpi = %v", got)
+	}
+	if got := imageDpi(jfif[:10], "jpeg"); got != [2]int{defaultDpi, defaultDpi} {
+		t.Errorf("truncated JFIF dpi = %v", got)
+	}
+	phys := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n',
+		0, 0, 0, 9, 'p', 'H', 'Y', 's', 0, 0, 0x0B, 0x13, 0, 0, 0x0B, 0x13, 1, 0, 0, 0, 0}
+	if got := imageDpi(phys, "png"); got != [2]int{72, 72} {
+		t.Errorf("pHYs dpi = %v", got)
+	}
+	phys[18], phys[19], phys[22], phys[23] = 0x17, 0x12, 0x17, 0x12
+	if got := imageDpi(phys, "png"); got != [2]int{150, 150} {
+		t.Errorf("pHYs dpi = %v", got)
+	}
+}
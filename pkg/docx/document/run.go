@@ -0,0 +1,30 @@
+package document
+
+import (
+	"fmt"
+
+	"github.com/user/go-docx/pkg/docx/oxml"
+)
+
+// Run is a run in one of the document's stories. It knows the story part
+// holding it, so that content related from that part, such as charts and
+// pictures, can be added to it.
+type Run struct {
+	*oxml.CT_R
+	doc   *Document
+	story Story
+}
+
+// Run returns r bound to the story holding it. It returns an error if r is
+// not part of one of the document's stories.
+func (d *Document) Run(r *oxml.CT_R) (*Run, error) {
+	stories := d.Stories()
+	for e := r.E; e != nil; e = e.Parent() {
+		for _, story := range stories {
+			if story.Part.Element() == e {
+				return &Run{CT_R: r, doc: d, story: story}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("document: run is not part of a story of the document")
+}
@@ -0,0 +1,31 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx/oxml"
+)
+
+func TestDocument_Run(t *testing.T) {
+	doc := newTestDocument(t)
+	hdr := doc.Stories()[1]
+	for _, story := range []Story{doc.Stories()[0], hdr} {
+		var r *oxml.CT_R
+		switch root := oxml.WrapElement(story.Part.Element()).(type) {
+		case *oxml.CT_Document:
+			r = root.Body().AddP().AddR()
+		case *oxml.CT_HdrFtr:
+			r = root.PList()[0].AddR()
+		}
+		run, err := doc.Run(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if run.CT_R != r || run.story.Part != story.Part {
+			t.Errorf("Run(%s run) bound to %s", story.Part.PartName(), run.story.Part.PartName())
+		}
+	}
+	if _, err := doc.Run(&oxml.CT_R{Element: oxml.Element{E: oxml.OxmlElement("w:r")}}); err == nil {
+		t.Error("Run accepted a run outside the document")
+	}
+}
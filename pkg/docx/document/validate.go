@@ -0,0 +1,35 @@
+package document
+
+import (
+	"github.com/user/go-docx/pkg/docx/opc"
+	"github.com/user/go-docx/pkg/docx/oxml"
+)
+
+// ValidationError is a schema violation found in one of the document's
+// stories.
+type ValidationError struct {
+	Part opc.PackURI // the story part, e.g. "/word/header1.xml"
+	oxml.ValidationError
+}
+
+func (e ValidationError) Error() string {
+	return string(e.Part) + ": " + e.ValidationError.Error()
+}
+
+// Validate checks each story of the document against the codegen schema,
+// as oxml.Validate does, and returns the violations story by story in the
+// order of Stories. Relationship ids in a story are checked against the
+// relationships of its own part.
+func (d *Document) Validate() []ValidationError {
+	var result []ValidationError
+	for _, story := range d.Stories() {
+		var ids []string
+		for _, rel := range story.Part.Rels().All() {
+			ids = append(ids, rel.RID)
+		}
+		for _, e := range oxml.Validate(story.Part.Element(), oxml.WithRelationshipIds(ids...)) {
+			result = append(result, ValidationError{Part: story.Part.PartName(), ValidationError: e})
+		}
+	}
+	return result
+}
@@ -0,0 +1,44 @@
+package document
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx/oxml"
+)
+
+func TestDocument_Validate(t *testing.T) {
+	doc := newTestDocument(t)
+	for _, e := range doc.Validate() {
+		t.Errorf("valid document reported: %v", e)
+	}
+
+	// A relationship id is checked against the rels of the story's own part:
+	// the main document's styles relationship means nothing in a header.
+	stylesId := ""
+	for _, rel := range doc.Part().Rels().All() {
+		if strings.HasSuffix(rel.RelType, "/styles") {
+			stylesId = rel.RID
+		}
+	}
+	hdr := doc.Stories()[1]
+	p := oxml.WrapElement(hdr.Part.Element()).(*oxml.CT_HdrFtr).PList()[0]
+	link := oxml.OxmlElement("w:hyperlink", "r")
+	link.CreateAttr("r:id", stylesId)
+	p.E.AddChild(link)
+	// An empty cell lacks the block content it needs.
+	tc := doc.Element().Body().TblList()[0].TrList()[0].TcList()[0]
+	tc.E.RemoveChild(tc.PList()[0].E)
+
+	var got []string
+	for _, e := range doc.Validate() {
+		got = append(got, e.Error())
+	}
+	want := []string{
+		`/word/document.xml: /w:document/w:body/w:tbl/w:tr[1]/w:tc[1]: missing required <w:p> or <w:tbl>`,
+		`/word/header1.xml: /w:hdr/w:p/w:hyperlink: attribute r:id: no relationship with id "` + stylesId + `"`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Validate() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
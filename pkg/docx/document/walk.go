@@ -0,0 +1,17 @@
+package document
+
+import "github.com/user/go-docx/pkg/docx/oxml"
+
+// Walk walks the stories of doc in reading order, as oxml.Walk does for a
+// single tree, until a callback stops the walk: the main document, the
+// headers and footers, the footnotes, endnotes and comments. It reports
+// whether the walk ran to the end. Changes callbacks make to a story are
+// saved with the document.
+func Walk(doc *Document, v oxml.Visitor) bool {
+	stories := doc.Stories()
+	roots := make([]oxml.Node, len(stories))
+	for i, s := range stories {
+		roots[i] = s.Root()
+	}
+	return oxml.WalkAll(roots, v)
+}
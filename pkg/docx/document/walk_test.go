@@ -0,0 +1,72 @@
+package document
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx/oxml"
+)
+
+// textRecorder collects the text of the document, marking the start of
+// each header or footer, note and comment.
+type textRecorder struct {
+	oxml.BaseVisitor
+	texts []string
+}
+
+func (r *textRecorder) VisitHeaderFooter(n *oxml.CT_HdrFtr) oxml.WalkAction {
+	r.texts = append(r.texts, "["+n.E.Tag+"]")
+	return oxml.WalkContinue
+}
+
+func (r *textRecorder) VisitNote(*oxml.Element) oxml.WalkAction {
+	r.texts = append(r.texts, "[note]")
+	return oxml.WalkContinue
+}
+
+func (r *textRecorder) VisitComment(*oxml.CT_Comment) oxml.WalkAction {
+	r.texts = append(r.texts, "[comment]")
+	return oxml.WalkContinue
+}
+
+func (r *textRecorder) VisitCell(*oxml.CT_Tc) oxml.WalkAction {
+	r.texts = append(r.texts, "[cell]")
+	return oxml.WalkContinue
+}
+
+func (r *textRecorder) VisitText(n *oxml.CT_Text) oxml.WalkAction {
+	r.texts = append(r.texts, n.E.Text())
+	if n.E.Text() == "redact" {
+		n.E.SetText("XXXX")
+	}
+	return oxml.WalkContinue
+}
+
+func TestWalk_Document(t *testing.T) {
+	doc := newTestDocument(t)
+	doc.Element().Body().AddP().AddR().AddTWithText("redact")
+	comments := doc.Stories()[4].Root().(*oxml.CT_Comments)
+	comments.AddCommentFull().PList()[0].AddR().AddTWithText("remark")
+
+	r := &textRecorder{}
+	if !Walk(doc, r) {
+		t.Fatal("Walk reported a stop")
+	}
+	if got, want := strings.Join(r.texts, " "), "[cell] [cell] [cell] [cell] redact [hdr] [ftr] [note] note [comment] remark"; got != want {
+		t.Errorf("texts = %q, want %q", got, want)
+	}
+
+	// Edits made while walking are saved.
+	saved, err := doc.Package().SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc, err = OpenBytes(saved); err != nil {
+		t.Fatal(err)
+	}
+	r = &textRecorder{}
+	Walk(doc, r)
+	if got := strings.Join(r.texts, " "); !strings.Contains(got, "XXXX") || strings.Contains(got, "redact") {
+		t.Errorf("texts after redacting = %q", got)
+	}
+}
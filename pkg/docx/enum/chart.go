@@ -0,0 +1,23 @@
+package enum
+
+// ---------------------------------------------------------------------------
+// XlChartType — no XML mapping
+// ---------------------------------------------------------------------------
+
+// XlChartType specifies the type of a chart. It is expressed in XML by the
+// plot element (<c:barChart>, <c:lineChart>, ...) and its settings.
+// MS API name: XlChartType
+type XlChartType int
+
+const (
+	XlChartTypeArea            XlChartType = 1
+	XlChartTypeLine            XlChartType = 4
+	XlChartTypePie             XlChartType = 5
+	XlChartTypeColumnClustered XlChartType = 51
+	XlChartTypeColumnStacked   XlChartType = 52
+	XlChartTypeBarClustered    XlChartType = 57
+	XlChartTypeBarStacked      XlChartType = 58
+	XlChartTypeLineMarkers     XlChartType = 65
+	XlChartTypeXYScatterLines  XlChartType = 74
+	XlChartTypeXYScatter       XlChartType = -4169
+)
@@ -400,6 +400,32 @@ func TestWdInlineShapeTypeValues(t *testing.T) {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// Run property enums
+// ---------------------------------------------------------------------------
+
+func TestRunPropertyEnumsRoundTrip(t *testing.T) {
+	t.Parallel()
+	for val, xml := range wdEmphasisMarkToXml {
+		if got, err := WdEmphasisMarkFromXml(xml); err != nil || got != val {
+			t.Errorf("WdEmphasisMark round-trip failed: xml=%q, got=%d, want=%d (%v)", xml, got, val, err)
+		}
+	}
+	for val, xml := range wdAnimationToXml {
+		if got, err := WdAnimationFromXml(xml); err != nil || got != val {
+			t.Errorf("WdAnimation round-trip failed: xml=%q, got=%d, want=%d (%v)", xml, got, val, err)
+		}
+	}
+	for val, xml := range wdThemeFontToXml {
+		if got, err := WdThemeFontFromXml(xml); err != nil || got != val {
+			t.Errorf("WdThemeFont round-trip failed: xml=%q, got=%d, want=%d (%v)", xml, got, val, err)
+		}
+	}
+	if WdEmphasisMarkUnderSolidCircle.ToXml() != "underDot" {
+		t.Errorf("UNDER_SOLID_CIRCLE.ToXml() = %q, want %q", WdEmphasisMarkUnderSolidCircle.ToXml(), "underDot")
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Generic FromXml error
 // ---------------------------------------------------------------------------
@@ -0,0 +1,106 @@
+package enum
+
+// ---------------------------------------------------------------------------
+// WdProtectionType
+// ---------------------------------------------------------------------------
+
+// WdProtectionType specifies the editing restriction enforced on a document.
+// MS API name: WdProtectionType
+type WdProtectionType int
+
+const (
+	WdProtectionTypeNoProtection        WdProtectionType = -1
+	WdProtectionTypeAllowOnlyRevisions  WdProtectionType = 0
+	WdProtectionTypeAllowOnlyComments   WdProtectionType = 1
+	WdProtectionTypeAllowOnlyFormFields WdProtectionType = 2
+	WdProtectionTypeAllowOnlyReading    WdProtectionType = 3
+)
+
+var wdProtectionTypeToXml = map[WdProtectionType]string{
+	WdProtectionTypeNoProtection:        "none",
+	WdProtectionTypeAllowOnlyRevisions:  "trackedChanges",
+	WdProtectionTypeAllowOnlyComments:   "comments",
+	WdProtectionTypeAllowOnlyFormFields: "forms",
+	WdProtectionTypeAllowOnlyReading:    "readOnly",
+}
+
+var wdProtectionTypeFromXml = invertMap(wdProtectionTypeToXml)
+
+// ToXml returns the XML attribute value for this protection type.
+func (v WdProtectionType) ToXml() string { return wdProtectionTypeToXml[v] }
+
+// WdProtectionTypeFromXml returns the protection type for the given XML value.
+func WdProtectionTypeFromXml(s string) (WdProtectionType, error) {
+	return FromXml(wdProtectionTypeFromXml, s)
+}
+
+// ---------------------------------------------------------------------------
+// WdEditorType
+// ---------------------------------------------------------------------------
+
+// WdEditorType specifies the group of users allowed to edit a range of a
+// protected document.
+// MS API name: WdEditorType
+type WdEditorType int
+
+const (
+	WdEditorTypeNone           WdEditorType = 0
+	WdEditorTypeEveryone       WdEditorType = -1
+	WdEditorTypeAdministrators WdEditorType = -2
+	WdEditorTypeContributors   WdEditorType = -3
+	WdEditorTypeOwners         WdEditorType = -4
+	WdEditorTypeEditors        WdEditorType = -5
+	WdEditorTypeCurrent        WdEditorType = -6
+)
+
+var wdEditorTypeToXml = map[WdEditorType]string{
+	WdEditorTypeNone:           "none",
+	WdEditorTypeEveryone:       "everyone",
+	WdEditorTypeAdministrators: "administrators",
+	WdEditorTypeContributors:   "contributors",
+	WdEditorTypeOwners:         "owners",
+	WdEditorTypeEditors:        "editors",
+	WdEditorTypeCurrent:        "current",
+}
+
+var wdEditorTypeFromXml = invertMap(wdEditorTypeToXml)
+
+// ToXml returns the XML attribute value for this editor group.
+func (v WdEditorType) ToXml() string { return wdEditorTypeToXml[v] }
+
+// WdEditorTypeFromXml returns the editor group for the given XML value.
+func WdEditorTypeFromXml(s string) (WdEditorType, error) {
+	return FromXml(wdEditorTypeFromXml, s)
+}
+
+// ---------------------------------------------------------------------------
+// WdPageFit
+// ---------------------------------------------------------------------------
+
+// WdPageFit specifies how a document is zoomed to fit the window.
+// MS API name: WdPageFit
+type WdPageFit int
+
+const (
+	WdPageFitNone     WdPageFit = 0
+	WdPageFitFullPage WdPageFit = 1
+	WdPageFitBestFit  WdPageFit = 2
+	WdPageFitTextFit  WdPageFit = 3
+)
+
+var wdPageFitToXml = map[WdPageFit]string{
+	WdPageFitNone:     "none",
+	WdPageFitFullPage: "fullPage",
+	WdPageFitBestFit:  "bestFit",
+	WdPageFitTextFit:  "textFit",
+}
+
+var wdPageFitFromXml = invertMap(wdPageFitToXml)
+
+// ToXml returns the XML attribute value for this page fit.
+func (v WdPageFit) ToXml() string { return wdPageFitToXml[v] }
+
+// WdPageFitFromXml returns the page fit for the given XML value.
+func WdPageFitFromXml(s string) (WdPageFit, error) {
+	return FromXml(wdPageFitFromXml, s)
+}
@@ -18,3 +18,143 @@ const (
 
 // WdInlineShape is an alias for WdInlineShapeType.
 type WdInlineShape = WdInlineShapeType
+
+// ---------------------------------------------------------------------------
+// WdRelativeHorizontalPosition
+// ---------------------------------------------------------------------------
+
+// WdRelativeHorizontalPosition specifies what the horizontal position of a
+// floating shape is measured from.
+// MS API name: WdRelativeHorizontalPosition
+type WdRelativeHorizontalPosition int
+
+const (
+	WdRelativeHorizontalPositionMargin          WdRelativeHorizontalPosition = 0
+	WdRelativeHorizontalPositionPage            WdRelativeHorizontalPosition = 1
+	WdRelativeHorizontalPositionColumn          WdRelativeHorizontalPosition = 2
+	WdRelativeHorizontalPositionCharacter       WdRelativeHorizontalPosition = 3
+	WdRelativeHorizontalPositionLeftMarginArea  WdRelativeHorizontalPosition = 4
+	WdRelativeHorizontalPositionRightMarginArea WdRelativeHorizontalPosition = 5
+	WdRelativeHorizontalPositionInnerMarginArea WdRelativeHorizontalPosition = 6
+	WdRelativeHorizontalPositionOuterMarginArea WdRelativeHorizontalPosition = 7
+)
+
+var wdRelativeHorizontalPositionToXml = map[WdRelativeHorizontalPosition]string{
+	WdRelativeHorizontalPositionMargin:          "margin",
+	WdRelativeHorizontalPositionPage:            "page",
+	WdRelativeHorizontalPositionColumn:          "column",
+	WdRelativeHorizontalPositionCharacter:       "character",
+	WdRelativeHorizontalPositionLeftMarginArea:  "leftMargin",
+	WdRelativeHorizontalPositionRightMarginArea: "rightMargin",
+	WdRelativeHorizontalPositionInnerMarginArea: "insideMargin",
+	WdRelativeHorizontalPositionOuterMarginArea: "outsideMargin",
+}
+
+var wdRelativeHorizontalPositionFromXml = invertMap(wdRelativeHorizontalPositionToXml)
+
+// ToXml returns the XML attribute value for this relative position.
+func (v WdRelativeHorizontalPosition) ToXml() string { return wdRelativeHorizontalPositionToXml[v] }
+
+// WdRelativeHorizontalPositionFromXml returns the relative position for the given XML value.
+func WdRelativeHorizontalPositionFromXml(s string) (WdRelativeHorizontalPosition, error) {
+	return FromXml(wdRelativeHorizontalPositionFromXml, s)
+}
+
+// ---------------------------------------------------------------------------
+// WdRelativeVerticalPosition
+// ---------------------------------------------------------------------------
+
+// WdRelativeVerticalPosition specifies what the vertical position of a
+// floating shape is measured from.
+// MS API name: WdRelativeVerticalPosition
+type WdRelativeVerticalPosition int
+
+const (
+	WdRelativeVerticalPositionMargin           WdRelativeVerticalPosition = 0
+	WdRelativeVerticalPositionPage             WdRelativeVerticalPosition = 1
+	WdRelativeVerticalPositionParagraph        WdRelativeVerticalPosition = 2
+	WdRelativeVerticalPositionLine             WdRelativeVerticalPosition = 3
+	WdRelativeVerticalPositionTopMarginArea    WdRelativeVerticalPosition = 4
+	WdRelativeVerticalPositionBottomMarginArea WdRelativeVerticalPosition = 5
+	WdRelativeVerticalPositionInnerMarginArea  WdRelativeVerticalPosition = 6
+	WdRelativeVerticalPositionOuterMarginArea  WdRelativeVerticalPosition = 7
+)
+
+var wdRelativeVerticalPositionToXml = map[WdRelativeVerticalPosition]string{
+	WdRelativeVerticalPositionMargin:           "margin",
+	WdRelativeVerticalPositionPage:             "page",
+	WdRelativeVerticalPositionParagraph:        "paragraph",
+	WdRelativeVerticalPositionLine:             "line",
+	WdRelativeVerticalPositionTopMarginArea:    "topMargin",
+	WdRelativeVerticalPositionBottomMarginArea: "bottomMargin",
+	WdRelativeVerticalPositionInnerMarginArea:  "insideMargin",
+	WdRelativeVerticalPositionOuterMarginArea:  "outsideMargin",
+}
+
+var wdRelativeVerticalPositionFromXml = invertMap(wdRelativeVerticalPositionToXml)
+
+// ToXml returns the XML attribute value for this relative position.
+func (v WdRelativeVerticalPosition) ToXml() string { return wdRelativeVerticalPositionToXml[v] }
+
+// WdRelativeVerticalPositionFromXml returns the relative position for the given XML value.
+func WdRelativeVerticalPositionFromXml(s string) (WdRelativeVerticalPosition, error) {
+	return FromXml(wdRelativeVerticalPositionFromXml, s)
+}
+
+// ---------------------------------------------------------------------------
+// WdShapePosition
+// ---------------------------------------------------------------------------
+
+// WdShapePosition specifies the alignment of a floating shape relative to
+// the area its position is measured from.
+// MS API name: WdShapePosition
+type WdShapePosition int
+
+const (
+	WdShapePositionTop     WdShapePosition = -999999
+	WdShapePositionLeft    WdShapePosition = -999998
+	WdShapePositionBottom  WdShapePosition = -999997
+	WdShapePositionRight   WdShapePosition = -999996
+	WdShapePositionCenter  WdShapePosition = -999995
+	WdShapePositionInside  WdShapePosition = -999994
+	WdShapePositionOutside WdShapePosition = -999993
+)
+
+var wdShapePositionToXml = map[WdShapePosition]string{
+	WdShapePositionTop:     "top",
+	WdShapePositionLeft:    "left",
+	WdShapePositionBottom:  "bottom",
+	WdShapePositionRight:   "right",
+	WdShapePositionCenter:  "center",
+	WdShapePositionInside:  "inside",
+	WdShapePositionOutside: "outside",
+}
+
+var wdShapePositionFromXml = invertMap(wdShapePositionToXml)
+
+// ToXml returns the XML value for this shape position.
+func (v WdShapePosition) ToXml() string { return wdShapePositionToXml[v] }
+
+// WdShapePositionFromXml returns the shape position for the given XML value.
+func WdShapePositionFromXml(s string) (WdShapePosition, error) {
+	return FromXml(wdShapePositionFromXml, s)
+}
+
+// ---------------------------------------------------------------------------
+// WdWrapType — no XML mapping
+// ---------------------------------------------------------------------------
+
+// WdWrapType specifies how text wraps around a floating shape. It is
+// expressed in XML by the choice of wrap element and, for Front and Behind,
+// by the anchor's behindDoc attribute.
+// MS API name: WdWrapType
+type WdWrapType int
+
+const (
+	WdWrapTypeSquare    WdWrapType = 0
+	WdWrapTypeTight     WdWrapType = 1
+	WdWrapTypeThrough   WdWrapType = 2
+	WdWrapTypeFront     WdWrapType = 3
+	WdWrapTypeTopBottom WdWrapType = 4
+	WdWrapTypeBehind    WdWrapType = 5
+)
@@ -342,3 +342,116 @@ func (v WdUnderline) String() string {
 	}
 	return fmt.Sprintf("WdUnderline(%d)", int(v))
 }
+
+// ---------------------------------------------------------------------------
+// WdEmphasisMark
+// ---------------------------------------------------------------------------
+
+// WdEmphasisMark specifies the emphasis mark drawn for East Asian characters.
+// MS API name: WdEmphasisMark
+type WdEmphasisMark int
+
+const (
+	WdEmphasisMarkNone             WdEmphasisMark = 0
+	WdEmphasisMarkOverSolidCircle  WdEmphasisMark = 1
+	WdEmphasisMarkOverComma        WdEmphasisMark = 2
+	WdEmphasisMarkOverWhiteCircle  WdEmphasisMark = 3
+	WdEmphasisMarkUnderSolidCircle WdEmphasisMark = 4
+)
+
+var wdEmphasisMarkToXml = map[WdEmphasisMark]string{
+	WdEmphasisMarkNone:             "none",
+	WdEmphasisMarkOverSolidCircle:  "dot",
+	WdEmphasisMarkOverComma:        "comma",
+	WdEmphasisMarkOverWhiteCircle:  "circle",
+	WdEmphasisMarkUnderSolidCircle: "underDot",
+}
+
+var wdEmphasisMarkFromXml = invertMap(wdEmphasisMarkToXml)
+
+// ToXml returns the XML attribute value for this emphasis mark.
+func (v WdEmphasisMark) ToXml() string { return wdEmphasisMarkToXml[v] }
+
+// WdEmphasisMarkFromXml returns the emphasis mark for the given XML value.
+func WdEmphasisMarkFromXml(s string) (WdEmphasisMark, error) {
+	return FromXml(wdEmphasisMarkFromXml, s)
+}
+
+// ---------------------------------------------------------------------------
+// WdAnimation
+// ---------------------------------------------------------------------------
+
+// WdAnimation specifies the animated text effect applied to a run.
+// MS API name: WdAnimation
+type WdAnimation int
+
+const (
+	WdAnimationNone               WdAnimation = 0
+	WdAnimationLasVegasLights     WdAnimation = 1
+	WdAnimationBlinkingBackground WdAnimation = 2
+	WdAnimationSparkleText        WdAnimation = 3
+	WdAnimationMarchingBlackAnts  WdAnimation = 4
+	WdAnimationMarchingRedAnts    WdAnimation = 5
+	WdAnimationShimmer            WdAnimation = 6
+)
+
+var wdAnimationToXml = map[WdAnimation]string{
+	WdAnimationNone:               "none",
+	WdAnimationLasVegasLights:     "lights",
+	WdAnimationBlinkingBackground: "blinkBackground",
+	WdAnimationSparkleText:        "sparkleText",
+	WdAnimationMarchingBlackAnts:  "antsBlack",
+	WdAnimationMarchingRedAnts:    "antsRed",
+	WdAnimationShimmer:            "shimmer",
+}
+
+var wdAnimationFromXml = invertMap(wdAnimationToXml)
+
+// ToXml returns the XML attribute value for this animation.
+func (v WdAnimation) ToXml() string { return wdAnimationToXml[v] }
+
+// WdAnimationFromXml returns the animation for the given XML value.
+func WdAnimationFromXml(s string) (WdAnimation, error) {
+	return FromXml(wdAnimationFromXml, s)
+}
+
+// ---------------------------------------------------------------------------
+// WdThemeFont
+// ---------------------------------------------------------------------------
+
+// WdThemeFont specifies a theme font a run's font refers to: the major
+// (headings) or minor (body) font of the theme for one script.
+// MS API name: none; the values of ST_Theme.
+type WdThemeFont int
+
+const (
+	WdThemeFontMajorAscii    WdThemeFont = 0
+	WdThemeFontMajorHAnsi    WdThemeFont = 1
+	WdThemeFontMajorEastAsia WdThemeFont = 2
+	WdThemeFontMajorBidi     WdThemeFont = 3
+	WdThemeFontMinorAscii    WdThemeFont = 4
+	WdThemeFontMinorHAnsi    WdThemeFont = 5
+	WdThemeFontMinorEastAsia WdThemeFont = 6
+	WdThemeFontMinorBidi     WdThemeFont = 7
+)
+
+var wdThemeFontToXml = map[WdThemeFont]string{
+	WdThemeFontMajorAscii:    "majorAscii",
+	WdThemeFontMajorHAnsi:    "majorHAnsi",
+	WdThemeFontMajorEastAsia: "majorEastAsia",
+	WdThemeFontMajorBidi:     "majorBidi",
+	WdThemeFontMinorAscii:    "minorAscii",
+	WdThemeFontMinorHAnsi:    "minorHAnsi",
+	WdThemeFontMinorEastAsia: "minorEastAsia",
+	WdThemeFontMinorBidi:     "minorBidi",
+}
+
+var wdThemeFontFromXml = invertMap(wdThemeFontToXml)
+
+// ToXml returns the XML attribute value for this theme font.
+func (v WdThemeFont) ToXml() string { return wdThemeFontToXml[v] }
+
+// WdThemeFontFromXml returns the theme font for the given XML value.
+func WdThemeFontFromXml(s string) (WdThemeFont, error) {
+	return FromXml(wdThemeFontFromXml, s)
+}
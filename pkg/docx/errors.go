@@ -46,3 +46,58 @@ type InvalidSpanError struct {
 func NewInvalidSpanError(msg string, args ...any) *InvalidSpanError {
 	return &InvalidSpanError{DocxError{msg: fmt.Sprintf(msg, args...)}}
 }
+
+// PackageLimitExceededError indicates that a package exceeds a configured
+// resource limit, such as its total uncompressed size or XML nesting depth.
+type PackageLimitExceededError struct {
+	DocxError
+}
+
+// NewPackageLimitExceededError creates a new PackageLimitExceededError.
+func NewPackageLimitExceededError(msg string, args ...any) *PackageLimitExceededError {
+	return &PackageLimitExceededError{DocxError{msg: fmt.Sprintf(msg, args...)}}
+}
+
+// MalformedPackageError indicates that a package is structurally unsafe or
+// invalid, e.g. a relationship target that escapes the package root.
+type MalformedPackageError struct {
+	DocxError
+}
+
+// NewMalformedPackageError creates a new MalformedPackageError.
+func NewMalformedPackageError(msg string, args ...any) *MalformedPackageError {
+	return &MalformedPackageError{DocxError{msg: fmt.Sprintf(msg, args...)}}
+}
+
+// InvalidPasswordError indicates that an encrypted package could not be
+// opened because no password was given or the password is incorrect.
+type InvalidPasswordError struct {
+	DocxError
+}
+
+// NewInvalidPasswordError creates a new InvalidPasswordError.
+func NewInvalidPasswordError(msg string, args ...any) *InvalidPasswordError {
+	return &InvalidPasswordError{DocxError{msg: fmt.Sprintf(msg, args...)}}
+}
+
+// NotEncryptedError indicates that a password was given for a package that
+// is not encrypted.
+type NotEncryptedError struct {
+	DocxError
+}
+
+// NewNotEncryptedError creates a new NotEncryptedError.
+func NewNotEncryptedError(msg string, args ...any) *NotEncryptedError {
+	return &NotEncryptedError{DocxError{msg: fmt.Sprintf(msg, args...)}}
+}
+
+// InvalidSignatureError indicates that a digital signature does not verify:
+// the signed content was altered or the signature value is wrong.
+type InvalidSignatureError struct {
+	DocxError
+}
+
+// NewInvalidSignatureError creates a new InvalidSignatureError.
+func NewInvalidSignatureError(msg string, args ...any) *InvalidSignatureError {
+	return &InvalidSignatureError{DocxError{msg: fmt.Sprintf(msg, args...)}}
+}
@@ -0,0 +1,147 @@
+package opc
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// --------------------------------------------------------------------------
+// Canonical XML 1.0 (http://www.w3.org/TR/2001/REC-xml-c14n-20010315)
+// --------------------------------------------------------------------------
+
+const (
+	algC14N            = "http://www.w3.org/TR/2001/REC-xml-c14n-20010315"
+	nsXml              = "http://www.w3.org/XML/1998/namespace"
+	defaultNsPrefixKey = ""
+)
+
+// canonicalize serializes the subtree rooted at el in inclusive canonical
+// form without comments. Namespace declarations in scope from ancestors of
+// el are rendered on el, as for a document subset.
+func canonicalize(el *etree.Element) []byte {
+	var sb strings.Builder
+	c14nElement(&sb, el, inScopeNamespaces(el.Parent()), map[string]string{})
+	return []byte(sb.String())
+}
+
+// inScopeNamespaces returns the namespace declarations in scope at el,
+// keyed by prefix ("" for the default namespace).
+func inScopeNamespaces(el *etree.Element) map[string]string {
+	var chain []*etree.Element
+	for e := el; e != nil; e = e.Parent() {
+		chain = append(chain, e)
+	}
+	ns := map[string]string{}
+	for i := len(chain) - 1; i >= 0; i-- {
+		addNsDecls(ns, chain[i])
+	}
+	return ns
+}
+
+// addNsDecls records the namespace declarations made on el into ns.
+func addNsDecls(ns map[string]string, el *etree.Element) {
+	for _, a := range el.Attr {
+		switch {
+		case a.Space == "" && a.Key == "xmlns":
+			ns[defaultNsPrefixKey] = a.Value
+		case a.Space == "xmlns":
+			ns[a.Key] = a.Value
+		}
+	}
+}
+
+// c14nElement writes el canonically. scope holds the namespaces in scope at
+// el's parent; rendered those already output by an ancestor.
+func c14nElement(sb *strings.Builder, el *etree.Element, parentScope, rendered map[string]string) {
+	scope := make(map[string]string, len(parentScope)+2)
+	for k, v := range parentScope {
+		scope[k] = v
+	}
+	addNsDecls(scope, el)
+
+	// Namespace declarations that differ from what an ancestor rendered.
+	var prefixes []string
+	for p, uri := range scope {
+		if prev, ok := rendered[p]; ok && prev == uri {
+			continue
+		}
+		if p == defaultNsPrefixKey && uri == "" && rendered[p] == "" {
+			continue
+		}
+		prefixes = append(prefixes, p)
+	}
+	sort.Strings(prefixes)
+	childRendered := rendered
+	if len(prefixes) > 0 {
+		childRendered = make(map[string]string, len(rendered)+len(prefixes))
+		for k, v := range rendered {
+			childRendered[k] = v
+		}
+	}
+
+	sb.WriteByte('<')
+	sb.WriteString(el.FullTag())
+	for _, p := range prefixes {
+		if p == defaultNsPrefixKey {
+			sb.WriteString(` xmlns="`)
+		} else {
+			sb.WriteString(` xmlns:` + p + `="`)
+		}
+		sb.WriteString(escapeC14NAttr(scope[p]))
+		sb.WriteByte('"')
+		childRendered[p] = scope[p]
+	}
+
+	type attr struct{ uri, local, name, value string }
+	var attrs []attr
+	for _, a := range el.Attr {
+		if a.Space == "xmlns" || (a.Space == "" && a.Key == "xmlns") {
+			continue
+		}
+		uri := ""
+		switch {
+		case a.Space == "xml":
+			uri = nsXml
+		case a.Space != "":
+			uri = scope[a.Space]
+		}
+		attrs = append(attrs, attr{uri, a.Key, a.FullKey(), a.Value})
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i].uri != attrs[j].uri {
+			return attrs[i].uri < attrs[j].uri
+		}
+		return attrs[i].local < attrs[j].local
+	})
+	for _, a := range attrs {
+		sb.WriteString(" " + a.name + `="` + escapeC14NAttr(a.value) + `"`)
+	}
+	sb.WriteByte('>')
+
+	for _, tok := range el.Child {
+		switch t := tok.(type) {
+		case *etree.Element:
+			c14nElement(sb, t, scope, childRendered)
+		case *etree.CharData:
+			sb.WriteString(escapeC14NText(t.Data))
+		case *etree.ProcInst:
+			sb.WriteString("<?" + t.Target)
+			if t.Inst != "" {
+				sb.WriteString(" " + t.Inst)
+			}
+			sb.WriteString("?>")
+		}
+	}
+	sb.WriteString("</" + el.FullTag() + ">")
+}
+
+var (
+	c14nTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	c14nAttrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;",
+		"\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+)
+
+func escapeC14NText(s string) string { return c14nTextEscaper.Replace(s) }
+func escapeC14NAttr(s string) string { return c14nAttrEscaper.Replace(s) }
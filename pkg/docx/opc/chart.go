@@ -0,0 +1,22 @@
+package opc
+
+import "github.com/beevik/etree"
+
+const (
+	chartPartTmpl    = "/word/charts/chart%d.xml"
+	workbookPartTmpl = "/word/embeddings/Microsoft_Excel_Worksheet%d.xlsx"
+)
+
+// AddChartPart adds a chart part holding chartSpace, related from source,
+// and embeds workbook as the chart's data. The workbook is related from the
+// chart part as its first relationship, rId1, the id the chart's
+// <c:externalData> is expected to use. It returns the new chart part and
+// the id of the relationship from source to it.
+func (p *OpcPackage) AddChartPart(source Part, chartSpace *etree.Element, workbook []byte) (*XmlPart, string) {
+	chart := NewXmlPartFromElement(p.NextPartname(chartPartTmpl), CTDmlChart, chartSpace, p)
+	p.AddPart(chart)
+	embedded := NewBasePart(p.NextPartname(workbookPartTmpl), CTSmlSheet, workbook, p)
+	p.AddPart(embedded)
+	chart.Rels().GetOrAdd(RTPackage, embedded)
+	return chart, source.Rels().GetOrAdd(RTChart, chart).RID
+}
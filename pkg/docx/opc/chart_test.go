@@ -0,0 +1,42 @@
+package opc
+
+import (
+	"testing"
+
+	"github.com/beevik/etree"
+)
+
+func TestOpcPackage_AddChartPart(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, _ := pkg.MainDocumentPart()
+	cs := etree.NewElement("c:chartSpace")
+	cs.CreateAttr("xmlns:c", "http://schemas.openxmlformats.org/drawingml/2006/chart")
+	chart, rId := pkg.AddChartPart(doc, cs, []byte("PK-workbook"))
+	if chart.PartName() != "/word/charts/chart1.xml" {
+		t.Errorf("chart partname = %q", chart.PartName())
+	}
+
+	data, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err = OpenBytes(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, _ = pkg.MainDocumentPart()
+	rel := doc.Rels().GetByRID(rId)
+	if rel == nil || rel.RelType != RTChart || rel.TargetPart.ContentType() != CTDmlChart {
+		t.Fatalf("chart relationship %s = %+v", rId, rel)
+	}
+	embed := rel.TargetPart.Rels().GetByRID("rId1")
+	if embed == nil || embed.RelType != RTPackage {
+		t.Fatalf("workbook relationship = %+v", embed)
+	}
+	if embed.TargetPart.ContentType() != CTSmlSheet || string(embed.TargetPart.Blob()) != "PK-workbook" {
+		t.Errorf("embedded workbook = %s %q", embed.TargetPart.ContentType(), embed.TargetPart.Blob())
+	}
+}
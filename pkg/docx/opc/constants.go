@@ -123,6 +123,10 @@ const (
 	RTPrinterSettings     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/printerSettings"
 	RTVmlDrawing          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/vmlDrawing"
 	RTPackage             = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/package"
+
+	RTDigitalSignatureOrigin = "http://schemas.openxmlformats.org/package/2006/relationships/digital-signature/origin"
+	RTDigitalSignature       = "http://schemas.openxmlformats.org/package/2006/relationships/digital-signature/signature"
+	RTDigitalSignatureCert   = "http://schemas.openxmlformats.org/package/2006/relationships/digital-signature/certificate"
 )
 
 // --------------------------------------------------------------------------
@@ -0,0 +1,513 @@
+package opc
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"unicode/utf16"
+
+	"github.com/user/go-docx/internal/cfb"
+	"github.com/user/go-docx/pkg/docx"
+)
+
+// --------------------------------------------------------------------------
+// ECMA-376 Agile Encryption ([MS-OFFCRYPTO] 2.3.4.10 – 2.3.4.15)
+// --------------------------------------------------------------------------
+//
+// An encrypted package is a compound file holding an EncryptionInfo stream
+// (version 4.4 followed by an XML descriptor) and an EncryptedPackage stream
+// (the 8-byte plaintext length followed by the ZIP package encrypted in
+// 4096-byte segments).
+
+// Stream names inside an encrypted compound file.
+const (
+	streamEncryptionInfo   = "EncryptionInfo"
+	streamEncryptedPackage = "EncryptedPackage"
+)
+
+// Block keys that diversify keys and IVs derived from the same secret.
+var (
+	blockKeyVerifierInput = []byte{0xfe, 0xa7, 0xd2, 0x76, 0x3b, 0x4b, 0x9e, 0x79}
+	blockKeyVerifierValue = []byte{0xd7, 0xaa, 0x0f, 0x6d, 0x30, 0x61, 0x34, 0x4e}
+	blockKeyEncryptedKey  = []byte{0x14, 0x6e, 0x0b, 0xe7, 0xab, 0xac, 0xd0, 0xd6}
+	blockKeyIntegrityKey  = []byte{0x5f, 0xb2, 0xad, 0x01, 0x0c, 0xb9, 0xe1, 0xf6}
+	blockKeyIntegrityVal  = []byte{0xa0, 0x67, 0x7f, 0x02, 0xb2, 0x2c, 0x84, 0x33}
+)
+
+const (
+	nsEncryption        = "http://schemas.microsoft.com/office/2006/encryption"
+	nsPasswordEncryptor = "http://schemas.microsoft.com/office/2006/keyEncryptor/password"
+	encryptionSegment   = 4096
+	defaultSpinCount    = 100000
+	maxSpinCount        = 10000000 // the ST_SpinCount maximum
+)
+
+// xmlEncryption is the <encryption> descriptor of the EncryptionInfo stream.
+type xmlEncryption struct {
+	KeyData       xmlKeyData        `xml:"keyData"`
+	DataIntegrity xmlDataIntegrity  `xml:"dataIntegrity"`
+	KeyEncryptors []xmlKeyEncryptor `xml:"keyEncryptors>keyEncryptor"`
+}
+
+type xmlKeyData struct {
+	SaltSize        int    `xml:"saltSize,attr"`
+	BlockSize       int    `xml:"blockSize,attr"`
+	KeyBits         int    `xml:"keyBits,attr"`
+	HashSize        int    `xml:"hashSize,attr"`
+	CipherAlgorithm string `xml:"cipherAlgorithm,attr"`
+	CipherChaining  string `xml:"cipherChaining,attr"`
+	HashAlgorithm   string `xml:"hashAlgorithm,attr"`
+	SaltValue       string `xml:"saltValue,attr"`
+}
+
+type xmlDataIntegrity struct {
+	EncryptedHmacKey   string `xml:"encryptedHmacKey,attr"`
+	EncryptedHmacValue string `xml:"encryptedHmacValue,attr"`
+}
+
+type xmlKeyEncryptor struct {
+	URI          string          `xml:"uri,attr"`
+	EncryptedKey xmlEncryptedKey `xml:"encryptedKey"`
+}
+
+type xmlEncryptedKey struct {
+	xmlKeyData
+	SpinCount                  int    `xml:"spinCount,attr"`
+	EncryptedVerifierHashInput string `xml:"encryptedVerifierHashInput,attr"`
+	EncryptedVerifierHashValue string `xml:"encryptedVerifierHashValue,attr"`
+	EncryptedKeyValue          string `xml:"encryptedKeyValue,attr"`
+}
+
+// decryptPackage returns the ZIP package held in the encrypted compound file
+// read by cr.
+func decryptPackage(cr *cfb.Reader, password string) ([]byte, error) {
+	info, err := cr.ReadStream(streamEncryptionInfo)
+	if err != nil {
+		return nil, fmt.Errorf("opc: reading encrypted package: %w", err)
+	}
+	encrypted, err := cr.ReadStream(streamEncryptedPackage)
+	if err != nil {
+		return nil, fmt.Errorf("opc: reading encrypted package: %w", err)
+	}
+	if len(info) < 8 {
+		return nil, docx.NewMalformedPackageError("opc: EncryptionInfo stream too short")
+	}
+	major, minor := binary.LittleEndian.Uint16(info), binary.LittleEndian.Uint16(info[2:])
+	if major != 4 || minor != 4 {
+		return nil, docx.NewDocxError("opc: unsupported encryption version %d.%d; only Agile (4.4) is supported", major, minor)
+	}
+	var desc xmlEncryption
+	if err := xml.Unmarshal(info[8:], &desc); err != nil {
+		return nil, docx.NewInvalidXmlError("opc: parsing EncryptionInfo: %v", err)
+	}
+
+	var pwKey *xmlEncryptedKey
+	for i := range desc.KeyEncryptors {
+		if desc.KeyEncryptors[i].URI == nsPasswordEncryptor {
+			pwKey = &desc.KeyEncryptors[i].EncryptedKey
+		}
+	}
+	if pwKey == nil {
+		return nil, docx.NewDocxError("opc: encrypted package has no password key encryptor")
+	}
+
+	// 1. Derive the password keys and check the verifier.
+	pwHash, err := newHash(pwKey.HashAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCipher(pwKey.xmlKeyData); err != nil {
+		return nil, err
+	}
+	pwSalt, err := decodeB64("saltValue", pwKey.SaltValue)
+	if err != nil {
+		return nil, err
+	}
+	if pwKey.SpinCount < 0 || pwKey.SpinCount > maxSpinCount {
+		return nil, docx.NewMalformedPackageError("opc: encrypted key spin count %d out of range", pwKey.SpinCount)
+	}
+	pwIV := fixSize(pwSalt, pwKey.BlockSize)
+	base := passwordHash(pwHash, pwSalt, password, pwKey.SpinCount)
+	keyLen := pwKey.KeyBits / 8
+
+	verifierInput, err := decryptB64(pwKey.EncryptedVerifierHashInput,
+		deriveKey(pwHash, base, blockKeyVerifierInput, keyLen), pwIV)
+	if err != nil {
+		return nil, err
+	}
+	verifierHash, err := decryptB64(pwKey.EncryptedVerifierHashValue,
+		deriveKey(pwHash, base, blockKeyVerifierValue, keyLen), pwIV)
+	if err != nil {
+		return nil, err
+	}
+	h := pwHash()
+	h.Write(verifierInput[:min(pwKey.SaltSize, len(verifierInput))])
+	sum := h.Sum(nil)
+	if len(verifierHash) < len(sum) || subtle.ConstantTimeCompare(sum, verifierHash[:len(sum)]) != 1 {
+		return nil, docx.NewInvalidPasswordError("opc: incorrect password")
+	}
+
+	// 2. Unwrap the intermediate key that encrypts the package.
+	secret, err := decryptB64(pwKey.EncryptedKeyValue,
+		deriveKey(pwHash, base, blockKeyEncryptedKey, keyLen), pwIV)
+	if err != nil {
+		return nil, err
+	}
+	kd := desc.KeyData
+	if err := checkCipher(kd); err != nil {
+		return nil, err
+	}
+	dataHash, err := newHash(kd.HashAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	secret = secret[:min(kd.KeyBits/8, len(secret))]
+	dataSalt, err := decodeB64("saltValue", kd.SaltValue)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Check data integrity, when the producer recorded it.
+	if desc.DataIntegrity.EncryptedHmacKey != "" {
+		hmacKey, err := decryptB64(desc.DataIntegrity.EncryptedHmacKey, secret,
+			blockIV(dataHash, dataSalt, blockKeyIntegrityKey, kd.BlockSize))
+		if err != nil {
+			return nil, err
+		}
+		want, err := decryptB64(desc.DataIntegrity.EncryptedHmacValue, secret,
+			blockIV(dataHash, dataSalt, blockKeyIntegrityVal, kd.BlockSize))
+		if err != nil {
+			return nil, err
+		}
+		mac := hmac.New(dataHash, hmacKey[:min(kd.HashSize, len(hmacKey))])
+		mac.Write(encrypted)
+		got := mac.Sum(nil)
+		if len(want) < len(got) || !hmac.Equal(got, want[:len(got)]) {
+			return nil, docx.NewMalformedPackageError("opc: encrypted package failed its integrity check")
+		}
+	}
+
+	// 4. Decrypt the package segment by segment.
+	if len(encrypted) < 8 {
+		return nil, docx.NewMalformedPackageError("opc: EncryptedPackage stream too short")
+	}
+	size := binary.LittleEndian.Uint64(encrypted)
+	body := encrypted[8:]
+	if size > uint64(len(body)) {
+		return nil, docx.NewMalformedPackageError("opc: EncryptedPackage declares %d bytes but holds %d", size, len(body))
+	}
+	plain := make([]byte, 0, len(body))
+	for i := 0; len(body) > 0; i++ {
+		n := min(encryptionSegment, len(body))
+		seg, err := aesCBC(false, secret, segmentIV(dataHash, dataSalt, i, kd.BlockSize), body[:n])
+		if err != nil {
+			return nil, err
+		}
+		plain = append(plain, seg...)
+		body = body[n:]
+	}
+	return plain[:size], nil
+}
+
+// encryptPackage wraps the ZIP package pkg in an Agile-encrypted compound
+// file protected by password, using AES-256 and SHA-512.
+func encryptPackage(pkg []byte, password string) ([]byte, error) {
+	const (
+		saltSize  = 16
+		blockSize = aes.BlockSize
+		keyBits   = 256
+		hashSize  = sha512.Size
+	)
+	dataSalt, pwSalt := randomBytes(saltSize), randomBytes(saltSize)
+	secret := randomBytes(keyBits / 8)
+	verifierInput := randomBytes(saltSize)
+	hmacKey := randomBytes(hashSize)
+
+	// 1. Encrypt the package segment by segment.
+	encrypted := make([]byte, 8, 8+len(pkg)+blockSize)
+	binary.LittleEndian.PutUint64(encrypted, uint64(len(pkg)))
+	for i, rest := 0, pkg; len(rest) > 0; i++ {
+		n := min(encryptionSegment, len(rest))
+		seg, err := aesCBC(true, secret, segmentIV(sha512.New, dataSalt, i, blockSize), padBlock(rest[:n], blockSize))
+		if err != nil {
+			return nil, err
+		}
+		encrypted = append(encrypted, seg...)
+		rest = rest[n:]
+	}
+
+	// 2. Protect the data integrity.
+	mac := hmac.New(sha512.New, hmacKey)
+	mac.Write(encrypted)
+	encHmacKey, err := aesCBC(true, secret, blockIV(sha512.New, dataSalt, blockKeyIntegrityKey, blockSize), hmacKey)
+	if err != nil {
+		return nil, err
+	}
+	encHmacValue, err := aesCBC(true, secret, blockIV(sha512.New, dataSalt, blockKeyIntegrityVal, blockSize), mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Wrap the intermediate key and the verifier with the password keys.
+	base := passwordHash(sha512.New, pwSalt, password, defaultSpinCount)
+	pwIV := fixSize(pwSalt, blockSize)
+	verifierSum := sha512.Sum512(verifierInput)
+	encVerifierInput, err := aesCBC(true, deriveKey(sha512.New, base, blockKeyVerifierInput, keyBits/8), pwIV, verifierInput)
+	if err != nil {
+		return nil, err
+	}
+	encVerifierValue, err := aesCBC(true, deriveKey(sha512.New, base, blockKeyVerifierValue, keyBits/8), pwIV, verifierSum[:])
+	if err != nil {
+		return nil, err
+	}
+	encKeyValue, err := aesCBC(true, deriveKey(sha512.New, base, blockKeyEncryptedKey, keyBits/8), pwIV, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	b64 := base64.StdEncoding.EncodeToString
+	common := fmt.Sprintf(`saltSize="%d" blockSize="%d" keyBits="%d" hashSize="%d" `+
+		`cipherAlgorithm="AES" cipherChaining="ChainingModeCBC" hashAlgorithm="SHA512"`,
+		saltSize, blockSize, keyBits, hashSize)
+	desc := xml.Header +
+		`<encryption xmlns="` + nsEncryption + `" xmlns:p="` + nsPasswordEncryptor + `">` +
+		`<keyData ` + common + ` saltValue="` + b64(dataSalt) + `"/>` +
+		`<dataIntegrity encryptedHmacKey="` + b64(encHmacKey) + `" encryptedHmacValue="` + b64(encHmacValue) + `"/>` +
+		`<keyEncryptors><keyEncryptor uri="` + nsPasswordEncryptor + `">` +
+		fmt.Sprintf(`<p:encryptedKey spinCount="%d" `, defaultSpinCount) + common +
+		` saltValue="` + b64(pwSalt) + `"` +
+		` encryptedVerifierHashInput="` + b64(encVerifierInput) + `"` +
+		` encryptedVerifierHashValue="` + b64(encVerifierValue) + `"` +
+		` encryptedKeyValue="` + b64(encKeyValue) + `"/>` +
+		`</keyEncryptor></keyEncryptors></encryption>`
+
+	info := make([]byte, 8, 8+len(desc))
+	binary.LittleEndian.PutUint16(info, 4)
+	binary.LittleEndian.PutUint16(info[2:], 4)
+	binary.LittleEndian.PutUint32(info[4:], 0x40)
+	info = append(info, desc...)
+
+	w := cfb.NewWriter()
+	w.AddStream(streamEncryptionInfo, info)
+	w.AddStream(streamEncryptedPackage, encrypted)
+	addDataSpaces(w)
+	return w.Bytes(), nil
+}
+
+// addDataSpaces writes the \x06DataSpaces storage that declares the
+// EncryptedPackage stream as transformed by the encryption transform
+// ([MS-OFFCRYPTO] 2.1.1; [MS-OSHARED] data spaces).
+func addDataSpaces(w *cfb.Writer) {
+	const ds = "\x06DataSpaces/"
+	le := binary.LittleEndian
+	u32 := func(b []byte, v uint32) []byte { return le.AppendUint32(b, v) }
+	version := func(b []byte) []byte {
+		for i := 0; i < 3; i++ { // reader, updater, writer: 1.0
+			b = le.AppendUint16(b, 1)
+			b = le.AppendUint16(b, 0)
+		}
+		return b
+	}
+
+	var v []byte
+	v = unicodeLPP4(v, "Microsoft.Container.DataSpaces")
+	w.AddStream(ds+"Version", version(v))
+
+	var entry []byte
+	entry = u32(entry, 1) // reference component count
+	entry = u32(entry, 0) // component type: stream
+	entry = unicodeLPP4(entry, streamEncryptedPackage)
+	entry = unicodeLPP4(entry, "StrongEncryptionDataSpace")
+	var m []byte
+	m = u32(m, 8) // header length
+	m = u32(m, 1) // entry count
+	m = u32(m, uint32(4+len(entry))) // entry length, counting this field
+	w.AddStream(ds+"DataSpaceMap", append(m, entry...))
+
+	var info []byte
+	info = u32(info, 8) // header length
+	info = u32(info, 1) // transform reference count
+	info = unicodeLPP4(info, "StrongEncryptionTransform")
+	w.AddStream(ds+"DataSpaceInfo/StrongEncryptionDataSpace", info)
+
+	var id []byte
+	id = unicodeLPP4(id, "{FF9A3F03-56EF-4613-BDD5-5A41C1D07246}")
+	var tr []byte
+	tr = u32(tr, uint32(8+len(id))) // bytes before the transform name
+	tr = u32(tr, 1)                 // transform type
+	tr = append(tr, id...)
+	tr = unicodeLPP4(tr, "Microsoft.Container.EncryptionTransform")
+	tr = version(tr)
+	tr = u32(tr, 0) // encryption name: empty UTF-8-LP-P4
+	tr = u32(tr, 0) // encryption block size
+	tr = u32(tr, 0) // cipher mode
+	tr = u32(tr, 4) // reserved
+	w.AddStream(ds+"TransformInfo/StrongEncryptionTransform/\x06Primary", tr)
+}
+
+// unicodeLPP4 appends s as a length-prefixed UTF-16LE string padded to a
+// multiple of 4 bytes.
+func unicodeLPP4(b []byte, s string) []byte {
+	units := utf16.Encode([]rune(s))
+	b = binary.LittleEndian.AppendUint32(b, uint32(2*len(units)))
+	for _, u := range units {
+		b = binary.LittleEndian.AppendUint16(b, u)
+	}
+	if len(units)%2 != 0 {
+		b = append(b, 0, 0)
+	}
+	return b
+}
+
+// passwordHash computes the iterated password hash H_n:
+// H_0 = H(salt + password), H_i = H(i + H_{i-1}).
+func passwordHash(newHash func() hash.Hash, salt []byte, password string, spinCount int) []byte {
+	h := newHash()
+	h.Write(salt)
+	h.Write(utf16LE(password))
+	sum := h.Sum(nil)
+	var iter [4]byte
+	for i := 0; i < spinCount; i++ {
+		binary.LittleEndian.PutUint32(iter[:], uint32(i))
+		h.Reset()
+		h.Write(iter[:])
+		h.Write(sum)
+		sum = h.Sum(sum[:0])
+	}
+	return sum
+}
+
+// deriveKey derives a key of keyLen bytes from the password hash and a
+// block key.
+func deriveKey(newHash func() hash.Hash, base, blockKey []byte, keyLen int) []byte {
+	h := newHash()
+	h.Write(base)
+	h.Write(blockKey)
+	return fixSize(h.Sum(nil), keyLen)
+}
+
+// blockIV derives an initialization vector from the key data salt and a
+// block key.
+func blockIV(newHash func() hash.Hash, salt, blockKey []byte, blockSize int) []byte {
+	h := newHash()
+	h.Write(salt)
+	h.Write(blockKey)
+	return fixSize(h.Sum(nil), blockSize)
+}
+
+// segmentIV derives the initialization vector of package segment i.
+func segmentIV(newHash func() hash.Hash, salt []byte, i, blockSize int) []byte {
+	var idx [4]byte
+	binary.LittleEndian.PutUint32(idx[:], uint32(i))
+	return blockIV(newHash, salt, idx[:], blockSize)
+}
+
+// fixSize truncates b to n bytes, or pads it with 0x36 bytes up to n.
+func fixSize(b []byte, n int) []byte {
+	if len(b) >= n {
+		return b[:n]
+	}
+	return append(append([]byte(nil), b...), bytes.Repeat([]byte{0x36}, n-len(b))...)
+}
+
+// padBlock zero-pads b to a multiple of blockSize.
+func padBlock(b []byte, blockSize int) []byte {
+	if rem := len(b) % blockSize; rem != 0 {
+		return append(append([]byte(nil), b...), make([]byte, blockSize-rem)...)
+	}
+	return b
+}
+
+// aesCBC encrypts or decrypts data, a whole number of blocks, with AES-CBC.
+func aesCBC(encrypt bool, key, iv, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("opc: %w", err)
+	}
+	if len(data)%block.BlockSize() != 0 {
+		return nil, docx.NewMalformedPackageError("opc: encrypted data is not a whole number of blocks")
+	}
+	out := make([]byte, len(data))
+	if encrypt {
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(out, data)
+	} else {
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(out, data)
+	}
+	return out, nil
+}
+
+// decryptB64 decodes a base64 attribute value and decrypts it.
+func decryptB64(value string, key, iv []byte) ([]byte, error) {
+	data, err := decodeB64("encrypted value", value)
+	if err != nil {
+		return nil, err
+	}
+	return aesCBC(false, key, iv, data)
+}
+
+func decodeB64(name, value string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, docx.NewInvalidXmlError("opc: invalid base64 in EncryptionInfo %s: %v", name, err)
+	}
+	return data, nil
+}
+
+// checkCipher rejects key parameters other than AES in CBC mode.
+func checkCipher(kd xmlKeyData) error {
+	if kd.CipherAlgorithm != "AES" || kd.CipherChaining != "ChainingModeCBC" {
+		return docx.NewDocxError("opc: unsupported cipher %s/%s", kd.CipherAlgorithm, kd.CipherChaining)
+	}
+	switch kd.KeyBits {
+	case 128, 192, 256:
+	default:
+		return docx.NewDocxError("opc: unsupported AES key size %d", kd.KeyBits)
+	}
+	if kd.BlockSize != aes.BlockSize {
+		return docx.NewDocxError("opc: unsupported cipher block size %d", kd.BlockSize)
+	}
+	return nil
+}
+
+// newHash returns the constructor for the named hash algorithm.
+func newHash(name string) (func() hash.Hash, error) {
+	switch name {
+	case "SHA1":
+		return sha1.New, nil
+	case "SHA256":
+		return sha256.New, nil
+	case "SHA384":
+		return sha512.New384, nil
+	case "SHA512":
+		return sha512.New, nil
+	}
+	return nil, docx.NewDocxError("opc: unsupported hash algorithm %q", name)
+}
+
+func utf16LE(s string) []byte {
+	units := utf16.Encode([]rune(s))
+	b := make([]byte, 2*len(units))
+	for i, u := range units {
+		binary.LittleEndian.PutUint16(b[2*i:], u)
+	}
+	return b
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic("opc: crypto/rand failed: " + err.Error())
+	}
+	return b
+}
@@ -0,0 +1,172 @@
+package opc
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/user/go-docx/internal/cfb"
+	"github.com/user/go-docx/pkg/docx"
+)
+
+func TestEncryption_RoundTrip(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	encrypted, err := pkg.SaveToBytes(WithEncryption("s3cret"))
+	if err != nil {
+		t.Fatalf("SaveToBytes: %v", err)
+	}
+	if !cfb.IsCompoundFile(bytes.NewReader(encrypted)) {
+		t.Fatal("expected a compound file")
+	}
+	cr, err := cfb.Open(bytes.NewReader(encrypted), int64(len(encrypted)))
+	if err != nil {
+		t.Fatalf("cfb.Open: %v", err)
+	}
+	if _, err := cr.ReadStream("\x06DataSpaces/TransformInfo/StrongEncryptionTransform/\x06Primary"); err != nil {
+		t.Errorf("expected data spaces storage: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "protected.docx")
+	if err := os.WriteFile(path, encrypted, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pkg2, err := OpenFile(path, nil, WithPassword("s3cret"))
+	if err != nil {
+		t.Fatalf("OpenFile with password: %v", err)
+	}
+	doc1, _ := pkg.PartByName("/word/document.xml")
+	doc2, ok := pkg2.PartByName("/word/document.xml")
+	if !ok || !bytes.Equal(doc1.Blob(), doc2.Blob()) {
+		t.Error("decrypted document part differs from the original")
+	}
+}
+
+func TestEncryption_Errors(t *testing.T) {
+	plain := loadDefaultDocx(t)
+	pkg, _ := OpenBytes(plain, nil)
+	encrypted, err := pkg.SaveToBytes(WithEncryption("right"))
+	if err != nil {
+		t.Fatalf("SaveToBytes: %v", err)
+	}
+
+	var ipe *docx.InvalidPasswordError
+	if _, err := OpenBytes(encrypted, nil, WithPassword("wrong")); !errors.As(err, &ipe) {
+		t.Errorf("wrong password: error = %v, want *docx.InvalidPasswordError", err)
+	}
+	if _, err := OpenBytes(encrypted, nil); !errors.As(err, &ipe) {
+		t.Errorf("no password: error = %v, want *docx.InvalidPasswordError", err)
+	}
+	var nee *docx.NotEncryptedError
+	if _, err := OpenBytes(plain, nil, WithPassword("right")); !errors.As(err, &nee) {
+		t.Errorf("plain package: error = %v, want *docx.NotEncryptedError", err)
+	}
+
+	// Tampering with the ciphertext trips the integrity check.
+	tampered := append([]byte(nil), encrypted...)
+	cr, _ := cfb.Open(bytes.NewReader(tampered), int64(len(tampered)))
+	pkgStream, _ := cr.ReadStream("EncryptedPackage")
+	i := bytes.Index(tampered, pkgStream[8:64])
+	if i < 0 {
+		t.Fatal("could not locate EncryptedPackage in the file")
+	}
+	tampered[i+20] ^= 0xFF
+	var mpe *docx.MalformedPackageError
+	if _, err := OpenBytes(tampered, nil, WithPassword("right")); !errors.As(err, &mpe) {
+		t.Errorf("tampered package: error = %v, want *docx.MalformedPackageError", err)
+	}
+}
+
+func TestEncryption_SpinCountOutOfRange(t *testing.T) {
+	plain := loadDefaultDocx(t)
+	pkg, _ := OpenBytes(plain, nil)
+	encrypted, err := pkg.SaveToBytes(WithEncryption("right"))
+	if err != nil {
+		t.Fatalf("SaveToBytes: %v", err)
+	}
+	cr, _ := cfb.Open(bytes.NewReader(encrypted), int64(len(encrypted)))
+	info, _ := cr.ReadStream("EncryptionInfo")
+	pkgStream, _ := cr.ReadStream("EncryptedPackage")
+
+	// A hostile spin count must be refused before any hashing is done.
+	for _, spinCount := range []string{"-1", "10000001", "2147483647"} {
+		patched := bytes.ReplaceAll(info, []byte(`spinCount="100000"`), []byte(`spinCount="`+spinCount+`"`))
+		if bytes.Equal(patched, info) {
+			t.Fatal("could not locate spinCount in EncryptionInfo")
+		}
+		w := cfb.NewWriter()
+		w.AddStream("EncryptionInfo", patched)
+		w.AddStream("EncryptedPackage", pkgStream)
+		var mpe *docx.MalformedPackageError
+		if _, err := OpenBytes(w.Bytes(), nil, WithPassword("right")); !errors.As(err, &mpe) {
+			t.Errorf("spinCount %s: error = %v, want *docx.MalformedPackageError", spinCount, err)
+		}
+	}
+}
+
+// The expected values below were computed independently of this package,
+// with Python's hashlib, following [MS-OFFCRYPTO] 2.3.4.11 and 2.3.4.13.
+func TestDeriveKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		newHash   func() hash.Hash
+		salt      []byte
+		password  string
+		spinCount int
+		blockKey  []byte
+		keyLen    int
+		wantHash  string
+		wantKey   string
+	}{
+		{
+			"SHA-512", sha512.New, []byte("0123456789abcdef"), "Password1", 1000, blockKeyVerifierInput, 32,
+			"adfdd8688aa62db2693cf772ac68c75774afa18d8f764c04bc96ef56bfc10cf1" +
+				"53dd53a15719c90b43b3e0d8887a39ebc03e6013ff4727bef861d2f7560c94af",
+			"87043b1c801e4025145622b350a310709a9d68f66211a8e1440949a0620d2b91",
+		},
+		{
+			// A 20-byte SHA-1 digest is padded with 0x36 up to a 32-byte key.
+			"SHA-1", sha1.New, []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, "pässwörd", 50000, blockKeyEncryptedKey, 32,
+			"291ac16f9dc8959e612f6ebef359210925a1fa2f",
+			"fe3f6e445773519fe8015a8ba3d47758265c0d45363636363636363636363636",
+		},
+	}
+	for _, tt := range tests {
+		base := passwordHash(tt.newHash, tt.salt, tt.password, tt.spinCount)
+		if got := hex.EncodeToString(base); got != tt.wantHash {
+			t.Errorf("%s: passwordHash = %s, want %s", tt.name, got, tt.wantHash)
+		}
+		if got := hex.EncodeToString(deriveKey(tt.newHash, base, tt.blockKey, tt.keyLen)); got != tt.wantKey {
+			t.Errorf("%s: deriveKey = %s, want %s", tt.name, got, tt.wantKey)
+		}
+	}
+}
+
+// The \x06DataSpaces/DataSpaceMap stream must match the one Word writes:
+// the length of its single map entry counts the length field itself.
+func TestEncryption_DataSpaceMap(t *testing.T) {
+	w := cfb.NewWriter()
+	addDataSpaces(w)
+	cr, err := cfb.Open(bytes.NewReader(w.Bytes()), int64(len(w.Bytes())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := cr.ReadStream("\x06DataSpaces/DataSpaceMap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := hex.DecodeString("08000000" + "01000000" + "68000000" + "01000000" + "00000000" +
+		"20000000" + hex.EncodeToString(utf16LE("EncryptedPackage")) +
+		"32000000" + hex.EncodeToString(utf16LE("StrongEncryptionDataSpace")) + "0000")
+	if !bytes.Equal(got, want) {
+		t.Errorf("DataSpaceMap =\n  %x\nwant\n  %x", got, want)
+	}
+}
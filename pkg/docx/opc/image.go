@@ -0,0 +1,12 @@
+package opc
+
+const imagePartTmpl = "/word/media/image%d."
+
+// AddImagePart adds an image part holding blob, with the given content type
+// and file extension (without the dot), related from source. It returns the
+// new image part and the id of the relationship from source to it.
+func (p *OpcPackage) AddImagePart(source Part, blob []byte, contentType, ext string) (*BasePart, string) {
+	image := NewBasePart(p.NextPartname(imagePartTmpl+ext), contentType, blob, p)
+	p.AddPart(image)
+	return image, source.Rels().GetOrAdd(RTImage, image).RID
+}
@@ -0,0 +1,75 @@
+package opc
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+
+	"github.com/user/go-docx/pkg/docx"
+)
+
+// checkLimits validates the ZIP central directory against the resource
+// limits in cfg, before any member is decompressed.
+func (p *PhysPkgReader) checkLimits(cfg *openConfig) error {
+	files := p.reader.File
+	if cfg.maxPartCount > 0 && len(files) > cfg.maxPartCount {
+		return docx.NewPackageLimitExceededError(
+			"opc: package has %d members, limit is %d", len(files), cfg.maxPartCount)
+	}
+	var total uint64
+	for _, f := range files {
+		size := f.UncompressedSize64
+		if cfg.maxPartSize > 0 && size > uint64(cfg.maxPartSize) {
+			return docx.NewPackageLimitExceededError(
+				"opc: member %q is %d bytes uncompressed, limit is %d", f.Name, size, cfg.maxPartSize)
+		}
+		if cfg.maxCompressionRatio > 0 && size > 0 {
+			compressed := f.CompressedSize64
+			if compressed == 0 {
+				compressed = 1
+			}
+			if ratio := float64(size) / float64(compressed); ratio > cfg.maxCompressionRatio {
+				return docx.NewPackageLimitExceededError(
+					"opc: member %q has compression ratio %.0f, limit is %.0f", f.Name, ratio, cfg.maxCompressionRatio)
+			}
+		}
+		total += size
+		if cfg.maxTotalSize > 0 && total > uint64(cfg.maxTotalSize) {
+			return docx.NewPackageLimitExceededError(
+				"opc: package exceeds %d bytes uncompressed", cfg.maxTotalSize)
+		}
+	}
+	return nil
+}
+
+// checkXmlDepth scans blob and fails if elements nest deeper than maxDepth.
+// A maxDepth of zero disables the check. Malformed XML is left for the
+// parser to report.
+func checkXmlDepth(name string, blob []byte, maxDepth int) error {
+	if maxDepth <= 0 {
+		return nil
+	}
+	dec := xml.NewDecoder(bytes.NewReader(blob))
+	depth := 0
+	for {
+		tok, err := dec.RawToken()
+		if err != nil {
+			return nil // io.EOF, or a syntax error for the parser to report
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+			if depth > maxDepth {
+				return docx.NewPackageLimitExceededError(
+					"opc: XML in %q nests deeper than %d elements", name, maxDepth)
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
+
+// isXmlContentType reports whether ct denotes XML content.
+func isXmlContentType(ct string) bool {
+	return strings.HasSuffix(ct, "+xml") || ct == "application/xml" || ct == "text/xml"
+}
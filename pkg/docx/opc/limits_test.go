@@ -0,0 +1,126 @@
+package opc
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx"
+)
+
+// rewriteZip copies the archive in data, replacing or adding the members in
+// replace. A nil blob removes the member.
+func rewriteZip(t *testing.T, data []byte, replace map[string][]byte) []byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range zr.File {
+		if _, ok := replace[f.Name]; ok {
+			continue
+		}
+		if err := zw.Copy(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, blob := range replace {
+		if blob == nil {
+			continue
+		}
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(blob)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func assertLimitErr(t *testing.T, err error) {
+	t.Helper()
+	var le *docx.PackageLimitExceededError
+	if !errors.As(err, &le) {
+		t.Errorf("expected *docx.PackageLimitExceededError, got %v", err)
+	}
+}
+
+func TestOpen_Limits(t *testing.T) {
+	data := loadDefaultDocx(t)
+	tests := []struct {
+		name string
+		opt  OpenOption
+	}{
+		{"part count", WithMaxPartCount(3)},
+		{"part size", WithMaxPartSize(1000)},
+		{"total size", WithMaxTotalSize(10000)},
+		{"xml depth", WithMaxXmlDepth(3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := OpenBytes(data, nil, tt.opt)
+			assertLimitErr(t, err)
+		})
+	}
+}
+
+func TestOpen_GenerousLimits(t *testing.T) {
+	data := loadDefaultDocx(t)
+	_, err := OpenBytes(data, nil,
+		WithMaxPartCount(100),
+		WithMaxPartSize(10<<20),
+		WithMaxTotalSize(50<<20),
+		WithMaxCompressionRatio(100),
+		WithMaxXmlDepth(64),
+	)
+	if err != nil {
+		t.Fatalf("OpenBytes with generous limits: %v", err)
+	}
+}
+
+func TestOpen_CompressionRatio(t *testing.T) {
+	data := rewriteZip(t, loadDefaultDocx(t), map[string][]byte{
+		"word/media/bomb.bin": bytes.Repeat([]byte{0}, 4<<20),
+	})
+	if _, err := OpenBytes(data, nil); err != nil {
+		t.Fatalf("OpenBytes without limits: %v", err)
+	}
+	_, err := OpenBytes(data, nil, WithMaxCompressionRatio(100))
+	assertLimitErr(t, err)
+}
+
+func TestOpen_LazyXmlDepth(t *testing.T) {
+	deep := `<?xml version="1.0"?><root xmlns="urn:x">` + strings.Repeat("<a>", 50) + strings.Repeat("</a>", 50) + `</root>`
+	data := rewriteZip(t, loadDefaultDocx(t), map[string][]byte{
+		"customXml/item1.xml": []byte(deep),
+	})
+	factory := NewPartFactory()
+	factory.Register("application/xml", func(pn PackURI, ct, rt string, blob []byte, pkg *OpcPackage) (Part, error) {
+		return NewBasePart(pn, ct, blob, pkg), nil
+	})
+	_, err := OpenBytes(data, factory, WithLazyLoading(), WithMaxXmlDepth(20))
+	assertLimitErr(t, err)
+}
+
+func TestOpen_RejectsEscapingRelTarget(t *testing.T) {
+	rels := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>
+<Relationship Id="rId2" Type="http://example.com/evil" Target="../../etc/passwd"/>
+</Relationships>`
+	data := rewriteZip(t, loadDefaultDocx(t), map[string][]byte{
+		"_rels/.rels": []byte(rels),
+	})
+	_, err := OpenBytes(data, nil)
+	var mpe *docx.MalformedPackageError
+	if !errors.As(err, &mpe) {
+		t.Errorf("expected *docx.MalformedPackageError, got %v", err)
+	}
+}
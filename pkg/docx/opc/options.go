@@ -0,0 +1,188 @@
+package opc
+
+// --------------------------------------------------------------------------
+// Open options
+// --------------------------------------------------------------------------
+
+// OpenOption configures how Open, OpenFile and OpenBytes read a package.
+type OpenOption func(*openConfig)
+
+// openConfig collects the settings applied by OpenOption values.
+type openConfig struct {
+	lazy        bool
+	recover     bool
+	fidelity    bool
+	password    string
+	hasPassword bool
+
+	// Resource limits; zero means unlimited.
+	maxTotalSize        int64
+	maxPartSize         int64
+	maxCompressionRatio float64
+	maxPartCount        int
+	maxXmlDepth         int
+}
+
+// newOpenConfig applies opts over the default configuration.
+func newOpenConfig(opts []OpenOption) *openConfig {
+	cfg := &openConfig{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+	return cfg
+}
+
+// WithLazyLoading defers reading the content of parts that the PartFactory
+// does not handle (and so become plain BaseParts — images, media, embedded
+// objects). Such parts keep a handle to their ZIP member and read it on each
+// call to Blob(); on Save, members that were never replaced via SetBlob are
+// copied from the source archive still compressed.
+//
+// The returned package keeps the source open: call OpcPackage.Close once it
+// is no longer needed (after the last Save).
+func WithLazyLoading() OpenOption {
+	return func(cfg *openConfig) {
+		cfg.lazy = true
+	}
+}
+
+// WithPassword opens a password-protected package: a compound file holding
+// a package encrypted with ECMA-376 Agile Encryption. Opening fails with a
+// *docx.InvalidPasswordError when the password is incorrect and with a
+// *docx.NotEncryptedError when the package is not encrypted.
+func WithPassword(password string) OpenOption {
+	return func(cfg *openConfig) {
+		cfg.password = password
+		cfg.hasPassword = true
+	}
+}
+
+// WithRecovery opens packages that would otherwise be rejected as broken:
+// content types missing from [Content_Types].xml are inferred from the
+// relationship type or extension, member names are matched
+// case-insensitively, relationships to missing parts are dropped, and ZIP
+// members no relationship reaches are kept as parts. Each workaround is
+// reported by OpcPackage.Repairs.
+//
+// Resource limits and the rejection of relationship targets outside the
+// package still apply; such targets are dropped rather than followed.
+func WithRecovery() OpenOption {
+	return func(cfg *openConfig) {
+		cfg.recover = true
+	}
+}
+
+// WithFidelity keeps the source bytes of the XML parts created with
+// NewXmlPart so that a round trip changes as little as possible. A part whose
+// tree is unchanged when the package is saved is written back byte-for-byte;
+// a modified part is serialized without reindenting, so its namespace
+// declarations, prefixes, attribute order, CDATA sections and whitespace,
+// including xml:space="preserve" content, are kept as read.
+func WithFidelity() OpenOption {
+	return func(cfg *openConfig) {
+		cfg.fidelity = true
+	}
+}
+
+// --------------------------------------------------------------------------
+// Resource limits
+// --------------------------------------------------------------------------
+//
+// Limits guard against hostile input such as ZIP bombs. None are applied by
+// default. The ZIP-level limits are checked against the central directory
+// before any part is read; archive/zip additionally refuses to return more
+// data than a member declares, so a member cannot exceed them while being
+// read. A violation is reported as a *docx.PackageLimitExceededError.
+
+// WithMaxTotalSize limits the combined uncompressed size of all members of
+// the package, in bytes.
+func WithMaxTotalSize(n int64) OpenOption {
+	return func(cfg *openConfig) {
+		cfg.maxTotalSize = n
+	}
+}
+
+// WithMaxPartSize limits the uncompressed size of any single member of the
+// package, in bytes.
+func WithMaxPartSize(n int64) OpenOption {
+	return func(cfg *openConfig) {
+		cfg.maxPartSize = n
+	}
+}
+
+// WithMaxCompressionRatio limits the ratio of uncompressed to compressed size
+// of any single member of the package. Legitimate documents rarely exceed
+// 100; ZIP bombs typically reach ratios in the thousands.
+func WithMaxCompressionRatio(ratio float64) OpenOption {
+	return func(cfg *openConfig) {
+		cfg.maxCompressionRatio = ratio
+	}
+}
+
+// WithMaxPartCount limits the number of members in the package, including
+// [Content_Types].xml and relationship parts.
+func WithMaxPartCount(n int) OpenOption {
+	return func(cfg *openConfig) {
+		cfg.maxPartCount = n
+	}
+}
+
+// WithMaxXmlDepth limits the element nesting depth of the XML members read
+// while opening the package: [Content_Types].xml, relationship parts and
+// parts with an XML content type.
+func WithMaxXmlDepth(n int) OpenOption {
+	return func(cfg *openConfig) {
+		cfg.maxXmlDepth = n
+	}
+}
+
+// --------------------------------------------------------------------------
+// Save options
+// --------------------------------------------------------------------------
+
+// SaveOption configures how Save, SaveToFile and SaveToBytes write a package.
+type SaveOption func(*saveConfig)
+
+// saveConfig collects the settings applied by SaveOption values.
+type saveConfig struct {
+	deterministic bool
+	password      string
+}
+
+// newSaveConfig applies opts over the default configuration.
+func newSaveConfig(opts []SaveOption) *saveConfig {
+	cfg := &saveConfig{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+	return cfg
+}
+
+// WithDeterministicOutput makes saving the same package produce the same
+// bytes every time. Members are written in a canonical order
+// ([Content_Types].xml, the package relationships, the main document part
+// and the rest of /word, then other parts, then /docProps, each part
+// followed by its relationships), every member carries the same fixed
+// timestamp and is compressed at a fixed level, and relationships are
+// serialized in rId order. Lazily loaded parts are recompressed rather than
+// copied raw, so the output does not depend on how the package was opened.
+func WithDeterministicOutput() SaveOption {
+	return func(cfg *saveConfig) {
+		cfg.deterministic = true
+	}
+}
+
+// WithEncryption saves the package encrypted with ECMA-376 Agile Encryption
+// (AES-256, SHA-512) under password, wrapped in a compound file as Word
+// does. An empty password leaves the package unencrypted. Each save draws
+// fresh salts and keys, so encrypted output is never byte-identical even
+// with WithDeterministicOutput.
+func WithEncryption(password string) SaveOption {
+	return func(cfg *saveConfig) {
+		cfg.password = password
+	}
+}
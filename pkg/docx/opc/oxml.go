@@ -12,6 +12,7 @@ import (
 // xmlTypes is the root <Types> element in [Content_Types].xml.
 type xmlTypes struct {
 	XMLName   xml.Name       `xml:"Types"`
+	Xmlns     string         `xml:"xmlns,attr"` // the tag name above drops XMLName.Space
 	Defaults  []xmlDefault   `xml:"Default"`
 	Overrides []xmlOverride  `xml:"Override"`
 }
@@ -48,6 +49,7 @@ func ParseContentTypes(blob []byte) (*ContentTypeMap, error) {
 func SerializeContentTypes(parts []PartInfo) ([]byte, error) {
 	types := xmlTypes{
 		XMLName: xml.Name{Space: NsOpcContentTypes, Local: "Types"},
+		Xmlns:   NsOpcContentTypes,
 	}
 
 	// Always include rels and xml defaults
@@ -102,6 +104,7 @@ type PartInfo struct {
 // xmlRelationships is the root <Relationships> element in a .rels file.
 type xmlRelationships struct {
 	XMLName       xml.Name          `xml:"Relationships"`
+	Xmlns         string            `xml:"xmlns,attr"` // the tag name above drops XMLName.Space
 	Relationships []xmlRelationship `xml:"Relationship"`
 }
 
@@ -144,11 +147,17 @@ func ParseRelationships(blob []byte, baseURI string) ([]SerializedRelationship,
 
 // SerializeRelationships builds .rels XML bytes from a Relationships collection.
 func SerializeRelationships(rels *Relationships) ([]byte, error) {
+	return serializeRelationships(rels.All())
+}
+
+// serializeRelationships builds .rels XML bytes from rels, in the given order.
+func serializeRelationships(rels []*Relationship) ([]byte, error) {
 	xrels := xmlRelationships{
 		XMLName: xml.Name{Space: NsOpcRelationships, Local: "Relationships"},
+		Xmlns:   NsOpcRelationships,
 	}
 
-	for _, rel := range rels.All() {
+	for _, rel := range rels {
 		xr := xmlRelationship{
 			ID:     rel.RID,
 			Type:   rel.RelType,
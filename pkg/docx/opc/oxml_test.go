@@ -1,6 +1,8 @@
 package opc
 
 import (
+	"bytes"
+	"encoding/xml"
 	"testing"
 )
 
@@ -71,7 +73,10 @@ func TestParseRelationships(t *testing.T) {
 	if srels[0].IsExternal() {
 		t.Error("expected internal relationship")
 	}
-	pn := srels[0].TargetPartname()
+	pn, err := srels[0].TargetPartname()
+	if err != nil {
+		t.Fatalf("TargetPartname: %v", err)
+	}
 	if pn != "/word/document.xml" {
 		t.Errorf("TargetPartname = %q, want /word/document.xml", pn)
 	}
@@ -173,6 +178,43 @@ func TestSerializeRelationships_RoundTrip(t *testing.T) {
 	}
 }
 
+// rootName returns the name of the root element of blob.
+func rootName(t *testing.T, blob []byte) xml.Name {
+	t.Helper()
+	dec := xml.NewDecoder(bytes.NewReader(blob))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			t.Fatalf("no root element in %s: %v", blob, err)
+		}
+		if se, ok := tok.(xml.StartElement); ok {
+			return se.Name
+		}
+	}
+}
+
+func TestSerialize_Namespaces(t *testing.T) {
+	blob, err := SerializeContentTypes([]PartInfo{{PartName: "/word/document.xml", ContentType: CTWmlDocumentMain}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := rootName(t, blob); got != (xml.Name{Space: NsOpcContentTypes, Local: "Types"}) {
+		t.Errorf("[Content_Types].xml root = %v", got)
+	}
+
+	rels := NewRelationships("/")
+	rels.Load("rId1", RTOfficeDocument, "word/document.xml", nil, false)
+	for _, rs := range []*Relationships{rels, NewRelationships("/word")} {
+		blob, err := SerializeRelationships(rs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := rootName(t, blob); got != (xml.Name{Space: NsOpcRelationships, Local: "Relationships"}) {
+			t.Errorf(".rels root = %v", got)
+		}
+	}
+}
+
 func TestContentTypeMap_CaseInsensitive(t *testing.T) {
 	ct := NewContentTypeMap()
 	ct.AddDefault("XML", CTXml)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 )
 
 // OpcPackage is the root object representing an OPC package.
@@ -12,6 +13,9 @@ type OpcPackage struct {
 	rels        *Relationships
 	partFactory *PartFactory
 	parts       map[PackURI]Part
+	source      *PhysPkgReader // kept open for lazily loaded parts
+	repairs     []Repair       // workarounds made when opened WithRecovery
+	fidelity    bool           // opened WithFidelity, see NewXmlPart
 }
 
 // NewOpcPackage creates an empty OpcPackage.
@@ -31,42 +35,68 @@ func NewOpcPackage(factory *PartFactory) *OpcPackage {
 // --------------------------------------------------------------------------
 
 // Open reads an OPC package from an io.ReaderAt.
-func Open(r io.ReaderAt, size int64, factory *PartFactory) (*OpcPackage, error) {
-	physReader, err := NewPhysPkgReader(r, size)
+func Open(r io.ReaderAt, size int64, factory *PartFactory, opts ...OpenOption) (*OpcPackage, error) {
+	cfg := newOpenConfig(opts)
+	var physReader *PhysPkgReader
+	var err error
+	if cfg.hasPassword {
+		physReader, err = newDecryptingPhysPkgReader(r, size, cfg.password)
+	} else {
+		physReader, err = NewPhysPkgReader(r, size)
+	}
 	if err != nil {
 		return nil, err
 	}
-	defer physReader.Close()
-	return openFromPhysReader(physReader, factory)
+	return openFromPhysReader(physReader, factory, cfg)
 }
 
 // OpenFile opens an OPC package from a file path.
-func OpenFile(path string, factory *PartFactory) (*OpcPackage, error) {
+func OpenFile(path string, factory *PartFactory, opts ...OpenOption) (*OpcPackage, error) {
+	cfg := newOpenConfig(opts)
+	if cfg.hasPassword {
+		// The decrypted package is held in memory; the file is not kept open.
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("opc: reading file %q: %w", path, err)
+		}
+		return Open(bytes.NewReader(data), int64(len(data)), factory, opts...)
+	}
 	physReader, err := NewPhysPkgReaderFromFile(path)
 	if err != nil {
 		return nil, err
 	}
-	defer physReader.Close()
-	return openFromPhysReader(physReader, factory)
+	return openFromPhysReader(physReader, factory, cfg)
 }
 
 // OpenBytes opens an OPC package from in-memory bytes.
-func OpenBytes(data []byte, factory *PartFactory) (*OpcPackage, error) {
-	physReader, err := NewPhysPkgReaderFromBytes(data)
-	if err != nil {
+func OpenBytes(data []byte, factory *PartFactory, opts ...OpenOption) (*OpcPackage, error) {
+	return Open(bytes.NewReader(data), int64(len(data)), factory, opts...)
+}
+
+// openFromPhysReader builds the package and takes ownership of physReader:
+// it is closed before returning unless lazily loaded parts still need it.
+func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory, cfg *openConfig) (*OpcPackage, error) {
+	if err := physReader.checkLimits(cfg); err != nil {
+		physReader.Close()
 		return nil, err
 	}
-	defer physReader.Close()
-	return openFromPhysReader(physReader, factory)
+	pkg, err := unmarshalPackage(physReader, factory, cfg)
+	if err != nil || !cfg.lazy {
+		physReader.Close()
+		return pkg, err
+	}
+	pkg.source = physReader
+	return pkg, nil
 }
 
-func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPackage, error) {
+func unmarshalPackage(physReader *PhysPkgReader, factory *PartFactory, cfg *openConfig) (*OpcPackage, error) {
 	if factory == nil {
 		factory = NewPartFactory()
 	}
 	pkg := NewOpcPackage(factory)
+	pkg.fidelity = cfg.fidelity
 
-	reader := &PackageReader{}
+	reader := &PackageReader{lazy: cfg.lazy, maxXmlDepth: cfg.maxXmlDepth, recover: cfg.recover}
 	result, err := reader.Read(physReader)
 	if err != nil {
 		return nil, err
@@ -75,7 +105,7 @@ func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPa
 	// Unmarshal: create parts
 	parts := make(map[PackURI]Part, len(result.SParts))
 	for _, sp := range result.SParts {
-		part, err := factory.New(sp.Partname, sp.ContentType, sp.RelType, sp.Blob, pkg)
+		part, err := newPartFromSerialized(factory, sp, pkg, cfg)
 		if err != nil {
 			return nil, fmt.Errorf("opc: creating part %q: %w", sp.Partname, err)
 		}
@@ -87,7 +117,7 @@ func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPa
 		var target interface{} = srel.TargetRef
 		var targetPart Part
 		if !srel.IsExternal() {
-			pn := srel.TargetPartname()
+			pn, _ := srel.TargetPartname()
 			p, ok := parts[pn]
 			if !ok {
 				continue // skip unresolvable rels
@@ -109,10 +139,14 @@ func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPa
 		for _, srel := range sp.SRels {
 			var targetPart Part
 			if !srel.IsExternal() {
-				pn := srel.TargetPartname()
-				if p, ok := parts[pn]; ok {
-					targetPart = p
+				// Targets were validated while walking the package;
+				// only rels dropped in recovery mode are unresolvable.
+				pn, _ := srel.TargetPartname()
+				p, ok := parts[pn]
+				if !ok {
+					continue
 				}
+				targetPart = p
 			}
 			rels.Load(srel.RID, srel.RelType, srel.TargetRef, targetPart, srel.IsExternal())
 		}
@@ -120,6 +154,7 @@ func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPa
 	}
 
 	pkg.parts = parts
+	pkg.repairs = result.Repairs
 
 	// Call AfterUnmarshal on all parts
 	for _, part := range parts {
@@ -129,35 +164,85 @@ func openFromPhysReader(physReader *PhysPkgReader, factory *PartFactory) (*OpcPa
 	return pkg, nil
 }
 
+// newPartFromSerialized creates a part via factory. A lazily read part that the
+// factory has no constructor for becomes a BasePart backed by its ZIP member;
+// anything else needs its content now, so the member is read eagerly.
+func newPartFromSerialized(factory *PartFactory, sp SerializedPart, pkg *OpcPackage, cfg *openConfig) (Part, error) {
+	if sp.member == nil {
+		return factory.New(sp.Partname, sp.ContentType, sp.RelType, sp.Blob, pkg)
+	}
+	if !factory.handles(sp.ContentType, sp.RelType) {
+		part := NewBasePart(sp.Partname, sp.ContentType, nil, pkg)
+		part.setSource(sp.member)
+		return part, nil
+	}
+	blob, err := sp.member.read()
+	if err != nil {
+		return nil, err
+	}
+	if isXmlContentType(sp.ContentType) {
+		if err := checkXmlDepth(sp.Partname.String(), blob, cfg.maxXmlDepth); err != nil {
+			return nil, err
+		}
+	}
+	return factory.New(sp.Partname, sp.ContentType, sp.RelType, blob, pkg)
+}
+
+// Close releases the source archive of a package opened with
+// WithLazyLoading. Lazily loaded parts can no longer be read or saved
+// afterwards. It is a no-op for packages read eagerly or created from scratch.
+func (p *OpcPackage) Close() error {
+	if p.source == nil {
+		return nil
+	}
+	err := p.source.Close()
+	p.source = nil
+	return err
+}
+
 // --------------------------------------------------------------------------
 // Save
 // --------------------------------------------------------------------------
 
 // Save writes the package to an io.Writer.
-func (p *OpcPackage) Save(w io.Writer) error {
+func (p *OpcPackage) Save(w io.Writer, opts ...SaveOption) error {
+	cfg := newSaveConfig(opts)
+	parts := p.Parts()
 	// Call BeforeMarshal on all parts
-	for _, part := range p.parts {
+	for _, part := range parts {
 		part.BeforeMarshal()
 	}
 
-	pw := &PackageWriter{}
-	return pw.Write(w, p.rels, p.Parts())
+	pw := &PackageWriter{deterministic: cfg.deterministic}
+	if cfg.password == "" {
+		return pw.Write(w, p.rels, parts)
+	}
+	var buf bytes.Buffer
+	if err := pw.Write(&buf, p.rels, parts); err != nil {
+		return err
+	}
+	encrypted, err := encryptPackage(buf.Bytes(), cfg.password)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(encrypted)
+	return err
 }
 
 // SaveToFile writes the package to a file.
-func (p *OpcPackage) SaveToFile(path string) error {
+func (p *OpcPackage) SaveToFile(path string, opts ...SaveOption) error {
 	f, err := os.Create(path)
 	if err != nil {
 		return fmt.Errorf("opc: creating file %q: %w", path, err)
 	}
 	defer f.Close()
-	return p.Save(f)
+	return p.Save(f, opts...)
 }
 
 // SaveToBytes returns the package as a byte slice.
-func (p *OpcPackage) SaveToBytes() ([]byte, error) {
+func (p *OpcPackage) SaveToBytes(opts ...SaveOption) ([]byte, error) {
 	var buf bytes.Buffer
-	if err := p.Save(&buf); err != nil {
+	if err := p.Save(&buf, opts...); err != nil {
 		return nil, err
 	}
 	return buf.Bytes(), nil
@@ -178,9 +263,19 @@ func (p *OpcPackage) Parts() []Part {
 	for _, part := range p.parts {
 		result = append(result, part)
 	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].PartName() < result[j].PartName()
+	})
 	return result
 }
 
+// Repairs returns the problems worked around while opening the package with
+// WithRecovery, in the order they were found. It is empty for packages that
+// were read without repairs.
+func (p *OpcPackage) Repairs() []Repair {
+	return p.repairs
+}
+
 // PartByName returns a part by its PackURI.
 func (p *OpcPackage) PartByName(pn PackURI) (Part, bool) {
 	part, ok := p.parts[pn]
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"path"
 	"strings"
+
+	"github.com/user/go-docx/pkg/docx"
 )
 
 // PackURI represents a URI for a part within an OPC package (e.g. "/word/document.xml").
@@ -26,16 +28,50 @@ func NewPackURI(uri string) PackURI {
 
 // FromRelRef resolves a relative reference against a base URI to produce an absolute PackURI.
 // For example, FromRelRef("/word", "media/image1.png") returns "/word/media/image1.png".
-func FromRelRef(baseURI, relativeRef string) PackURI {
-	joined := path.Join(baseURI, relativeRef)
-	abs := path.Clean(joined)
-	if abs == "." {
-		abs = "/"
+//
+// References that cannot name a part of the package are rejected with a
+// *docx.MalformedPackageError: absolute URIs carrying a scheme ("http:",
+// "file:", "C:"), network-path references ("//host/x"), references containing
+// NUL or backslash characters, and references whose ".." segments climb above
+// the package root.
+func FromRelRef(baseURI, relativeRef string) (PackURI, error) {
+	if err := checkRelRef(relativeRef); err != nil {
+		return "", err
+	}
+	joined := relativeRef
+	if !strings.HasPrefix(relativeRef, "/") {
+		joined = baseURI + "/" + relativeRef
+	}
+	var segs []string
+	for _, seg := range strings.Split(joined, "/") {
+		switch seg {
+		case "", ".":
+		case "..":
+			if len(segs) == 0 {
+				return "", docx.NewMalformedPackageError(
+					"opc: reference %q from %q escapes the package root", relativeRef, baseURI)
+			}
+			segs = segs[:len(segs)-1]
+		default:
+			segs = append(segs, seg)
+		}
+	}
+	return PackURI("/" + strings.Join(segs, "/")), nil
+}
+
+// checkRelRef rejects reference forms that never denote a part in the package.
+func checkRelRef(ref string) error {
+	if strings.ContainsAny(ref, "\\\x00") {
+		return docx.NewMalformedPackageError("opc: reference %q contains an invalid character", ref)
 	}
-	if !strings.HasPrefix(abs, "/") {
-		abs = "/" + abs
+	if strings.HasPrefix(ref, "//") {
+		return docx.NewMalformedPackageError("opc: network-path reference %q not allowed", ref)
 	}
-	return PackURI(abs)
+	// A scheme is a colon before the first "/", as in RFC 3986.
+	if i := strings.IndexByte(ref, ':'); i >= 0 && !strings.Contains(ref[:i], "/") {
+		return docx.NewMalformedPackageError("opc: absolute URI %q not allowed as a part reference", ref)
+	}
+	return nil
 }
 
 // BaseURI returns the directory portion, e.g. "/word" for "/word/document.xml".
@@ -1,7 +1,10 @@
 package opc
 
 import (
+	"errors"
 	"testing"
+
+	"github.com/user/go-docx/pkg/docx"
 )
 
 func TestNewPackURI(t *testing.T) {
@@ -113,15 +116,45 @@ func TestFromRelRef(t *testing.T) {
 		{"/word", "media/image1.png", "/word/media/image1.png"},
 		{"/word/slides", "../slideLayouts/slideLayout1.xml", "/word/slideLayouts/slideLayout1.xml"},
 		{"/", "word/document.xml", "/word/document.xml"},
+		{"/word", "/customXml/item1.xml", "/customXml/item1.xml"},
+		{"/word", "./media/../styles.xml", "/word/styles.xml"},
 	}
 	for _, tt := range tests {
-		got := FromRelRef(tt.base, tt.ref)
+		got, err := FromRelRef(tt.base, tt.ref)
+		if err != nil {
+			t.Errorf("FromRelRef(%q, %q) returned error: %v", tt.base, tt.ref, err)
+			continue
+		}
 		if got != tt.expected {
 			t.Errorf("FromRelRef(%q, %q) = %q, want %q", tt.base, tt.ref, got, tt.expected)
 		}
 	}
 }
 
+func TestFromRelRef_Rejects(t *testing.T) {
+	tests := []struct {
+		base string
+		ref  string
+	}{
+		{"/word", "../../etc/passwd"},
+		{"/", "../document.xml"},
+		{"/word", "media/../../../x.xml"},
+		{"/word", "http://example.com/x.xml"},
+		{"/word", "file:///etc/passwd"},
+		{"/word", "C:/Windows/win.ini"},
+		{"/word", "//server/share/x.xml"},
+		{"/word", "..\\..\\x.xml"},
+		{"/word", "media/x\x00.png"},
+	}
+	for _, tt := range tests {
+		_, err := FromRelRef(tt.base, tt.ref)
+		var mpe *docx.MalformedPackageError
+		if !errors.As(err, &mpe) {
+			t.Errorf("FromRelRef(%q, %q) error = %v, want *docx.MalformedPackageError", tt.base, tt.ref, err)
+		}
+	}
+}
+
 func TestPackURI_RelativeRef(t *testing.T) {
 	tests := []struct {
 		uri      PackURI
@@ -1,6 +1,9 @@
 package opc
 
 import (
+	"bytes"
+	"crypto/sha256"
+
 	"github.com/beevik/etree"
 )
 
@@ -28,6 +31,7 @@ type BasePart struct {
 	partName    PackURI
 	contentType string
 	blob        []byte
+	src         *zipMember // deferred content, see WithLazyLoading
 	rels        *Relationships
 	pkg         *OpcPackage
 }
@@ -45,7 +49,6 @@ func NewBasePart(partName PackURI, contentType string, blob []byte, pkg *OpcPack
 
 func (p *BasePart) PartName() PackURI         { return p.partName }
 func (p *BasePart) ContentType() string        { return p.contentType }
-func (p *BasePart) Blob() []byte               { return p.blob }
 func (p *BasePart) Rels() *Relationships       { return p.rels }
 func (p *BasePart) SetRels(rels *Relationships) { p.rels = rels }
 func (p *BasePart) Package() *OpcPackage       { return p.pkg }
@@ -57,9 +60,49 @@ func (p *BasePart) SetPartName(pn PackURI) {
 	p.partName = pn
 }
 
-// SetBlob replaces the blob.
+// Blob returns the part content. For a part opened with WithLazyLoading the
+// content is read from the source archive on every call and not retained, so
+// large media does not stay resident; nil is returned if that read fails.
+// Use ReadBlob to learn why.
+func (p *BasePart) Blob() []byte {
+	if p.src != nil {
+		blob, err := p.src.read()
+		if err != nil {
+			return nil
+		}
+		return blob
+	}
+	return p.blob
+}
+
+// ReadBlob returns the content of part like part.Blob(), together with the
+// error of reading it from the source archive when part was opened with
+// WithLazyLoading.
+func ReadBlob(part Part) ([]byte, error) {
+	if rs, ok := part.(rawSourced); ok {
+		if m := rs.rawSource(); m != nil {
+			return m.read()
+		}
+	}
+	return part.Blob(), nil
+}
+
+// SetBlob replaces the blob. A lazily loaded part is detached from its
+// source archive and written from blob from then on.
 func (p *BasePart) SetBlob(blob []byte) {
 	p.blob = blob
+	p.src = nil
+}
+
+// setSource attaches deferred content read from a source archive.
+func (p *BasePart) setSource(src *zipMember) {
+	p.src = src
+}
+
+// rawSource returns the source archive member of a part whose content has not
+// been replaced since it was lazily loaded, or nil.
+func (p *BasePart) rawSource() *zipMember {
+	return p.src
 }
 
 // --------------------------------------------------------------------------
@@ -70,20 +113,40 @@ func (p *BasePart) SetBlob(blob []byte) {
 type XmlPart struct {
 	BasePart
 	element *etree.Element
+
+	// Set for parts read from a package opened WithFidelity.
+	source []byte            // the blob the part was parsed from
+	prolog []etree.Token     // tokens preceding the root element
+	digest [sha256.Size]byte // of the tree as parsed, see serializeTree
 }
 
-// NewXmlPart creates an XmlPart by parsing the blob as XML.
+// NewXmlPart creates an XmlPart by parsing the blob as XML. When pkg was
+// opened WithFidelity the blob is retained and written back as is while the
+// tree stays unchanged.
 func NewXmlPart(partName PackURI, contentType string, blob []byte, pkg *OpcPackage) (*XmlPart, error) {
+	fidelity := pkg != nil && pkg.fidelity
 	doc := etree.NewDocument()
 	doc.ReadSettings.Permissive = true
+	doc.ReadSettings.PreserveCData = fidelity
 	if err := doc.ReadFromBytes(blob); err != nil {
 		return nil, err
 	}
 	root := doc.Root()
-	return &XmlPart{
+	p := &XmlPart{
 		BasePart: *NewBasePart(partName, contentType, nil, pkg),
 		element:  root,
-	}, nil
+	}
+	if fidelity && root != nil {
+		p.source = blob
+		for _, tok := range doc.Child {
+			if tok == root {
+				break
+			}
+			p.prolog = append(p.prolog, tok)
+		}
+		p.digest = sha256.Sum256(serializeTree(root))
+	}
+	return p, nil
 }
 
 // NewXmlPartFromElement creates an XmlPart from an existing element.
@@ -104,11 +167,25 @@ func (p *XmlPart) SetElement(el *etree.Element) {
 	p.element = el
 }
 
-// Blob serializes the XML element to bytes.
+// Blob serializes the XML element to bytes. A part read WithFidelity returns
+// its source blob while the tree is unchanged, and is otherwise serialized
+// after its original prolog without reindenting.
 func (p *XmlPart) Blob() []byte {
 	if p.element == nil {
 		return nil
 	}
+	if p.source != nil {
+		tree := serializeTree(p.element)
+		if sha256.Sum256(tree) == p.digest {
+			return p.source
+		}
+		var buf bytes.Buffer
+		for _, tok := range p.prolog {
+			tok.WriteTo(&buf, &etree.WriteSettings{})
+		}
+		buf.Write(tree)
+		return buf.Bytes()
+	}
 	doc := etree.NewDocument()
 	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8" standalone="yes"`)
 	doc.SetRoot(p.element.Copy())
@@ -117,6 +194,13 @@ func (p *XmlPart) Blob() []byte {
 	return b
 }
 
+// serializeTree writes el as is, without indentation.
+func serializeTree(el *etree.Element) []byte {
+	var buf bytes.Buffer
+	el.WriteTo(&buf, &etree.WriteSettings{})
+	return buf.Bytes()
+}
+
 // --------------------------------------------------------------------------
 // PartConstructor — factory function type
 // --------------------------------------------------------------------------
@@ -151,6 +235,17 @@ func (f *PartFactory) SetSelector(sel func(contentType, relType string) PartCons
 	f.selector = sel
 }
 
+// handles reports whether a registered constructor or the selector would
+// produce a part for the given types, i.e. whether New would do more than
+// fall back to BasePart.
+func (f *PartFactory) handles(contentType, relType string) bool {
+	if f.selector != nil && f.selector(contentType, relType) != nil {
+		return true
+	}
+	_, ok := f.constructors[contentType]
+	return ok
+}
+
 // New creates a Part using the registered constructors.
 // Falls back to BasePart if no constructor matches.
 func (f *PartFactory) New(partName PackURI, contentType, relType string, blob []byte, pkg *OpcPackage) (Part, error) {
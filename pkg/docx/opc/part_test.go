@@ -0,0 +1,228 @@
+package opc
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/beevik/etree"
+)
+
+// xmlPartFactory returns a factory that reads every XML part as an XmlPart.
+func xmlPartFactory() *PartFactory {
+	factory := NewPartFactory()
+	factory.SetSelector(func(ct, rt string) PartConstructor {
+		if !isXmlContentType(ct) {
+			return nil
+		}
+		return func(pn PackURI, ct, rt string, blob []byte, pkg *OpcPackage) (Part, error) {
+			return NewXmlPart(pn, ct, blob, pkg)
+		}
+	})
+	return factory
+}
+
+// zipMembers returns the content of each member of a ZIP archive by name.
+func zipMembers(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("reading zip: %v", err)
+	}
+	members := make(map[string][]byte, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("opening %s: %v", f.Name, err)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("reading %s: %v", f.Name, err)
+		}
+		members[f.Name] = b
+	}
+	return members
+}
+
+// canonicalXml parses blob and returns its canonical form, so documents that
+// differ only in serialization details compare equal.
+func canonicalXml(t *testing.T, blob []byte) string {
+	t.Helper()
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(blob); err != nil {
+		t.Fatalf("parsing %q: %v", blob, err)
+	}
+	return string(canonicalize(doc.Root()))
+}
+
+// canonicalEntries is canonicalXml for [Content_Types].xml and .rels parts,
+// whose entries may come in any order. Each entry carries the namespaces in
+// scope, so a lost namespace declaration still shows up.
+func canonicalEntries(t *testing.T, blob []byte) string {
+	t.Helper()
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(blob); err != nil {
+		t.Fatalf("parsing %q: %v", blob, err)
+	}
+	root := doc.Root()
+	entries := make([]string, 0, len(root.ChildElements()))
+	for _, el := range root.ChildElements() {
+		entries = append(entries, string(canonicalize(el)))
+	}
+	sort.Strings(entries)
+	return root.Tag + "\n" + strings.Join(entries, "\n")
+}
+
+// fidelityCorpus holds main document parts exercising what a round trip
+// must not disturb.
+var fidelityCorpus = map[string]string{
+	"prolog": "<?xml version='1.0' encoding='UTF-8'?>\r\n" +
+		"<!-- generated -->\r\n" +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body/></w:document>`,
+	"prefixes": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<ns0:document xmlns:ns0="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns="urn:example:default">` +
+		`<ns0:body><ns0:p><ns0:r><ns0:t>x</ns0:t></ns0:r></ns0:p><ext/></ns0:body></ns0:document>`,
+	"unknown": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+		`<w:document xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006" ` +
+		`xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" ` +
+		`xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml" mc:Ignorable="w14">` + "\n" +
+		`  <w:body>` + "\n" +
+		`    <w:p w14:paraId="1A2B3C4D" w:rsidR="00AB12CD" w14:textId="77777777"><w14:unknown a="1"/></w:p>` + "\n" +
+		`    <mc:AlternateContent><mc:Choice Requires="w14"><w14:x/></mc:Choice><mc:Fallback/></mc:AlternateContent>` + "\n" +
+		`  </w:body>` + "\n" +
+		`</w:document>`,
+	"whitespace": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body><w:p><w:r>` +
+		`<w:t xml:space="preserve">  two  spaces  </w:t><w:t xml:space="preserve">` + "\n\t" + `</w:t>` +
+		`<w:instrText><![CDATA[ IF a < b ]]></w:instrText></w:r></w:p></w:body></w:document>`,
+}
+
+// fidelityPackage returns a saved package whose main document part is xml.
+func fidelityPackage(t *testing.T, xml string) []byte {
+	t.Helper()
+	pkg := NewOpcPackage(nil)
+	doc := NewBasePart("/word/document.xml", CTWmlDocumentMain, []byte(xml), pkg)
+	pkg.AddPart(doc)
+	pkg.RelateTo(doc, RTOfficeDocument)
+	data, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatalf("SaveToBytes: %v", err)
+	}
+	return data
+}
+
+func TestXmlPart_Fidelity_DefaultDocxUnchanged(t *testing.T) {
+	data := loadDefaultDocx(t)
+	pkg, err := OpenBytes(data, xmlPartFactory(), WithFidelity())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	out, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatalf("SaveToBytes: %v", err)
+	}
+
+	src, dst := zipMembers(t, data), zipMembers(t, out)
+	for name, blob := range src {
+		saved, ok := dst[name]
+		switch {
+		case !ok:
+			t.Errorf("%s missing after round trip", name)
+		case name == "[Content_Types].xml" || strings.HasSuffix(name, ".rels"):
+			if canonicalEntries(t, saved) != canonicalEntries(t, blob) {
+				t.Errorf("%s differs semantically:\n got %s\nwant %s", name, saved, blob)
+			}
+		case !bytes.Equal(saved, blob):
+			t.Errorf("%s not written back byte-for-byte", name)
+		}
+	}
+}
+
+func TestXmlPart_Fidelity_Corpus(t *testing.T) {
+	for name, xml := range fidelityCorpus {
+		t.Run(name, func(t *testing.T) {
+			pkg, err := OpenBytes(fidelityPackage(t, xml), xmlPartFactory(), WithFidelity())
+			if err != nil {
+				t.Fatalf("OpenBytes: %v", err)
+			}
+			part, err := pkg.MainDocumentPart()
+			if err != nil {
+				t.Fatalf("MainDocumentPart: %v", err)
+			}
+			if got := string(part.Blob()); got != xml {
+				t.Errorf("unmodified part changed:\n got %q\nwant %q", got, xml)
+			}
+
+			// Modify the part and the same tree parsed independently.
+			el := part.(*XmlPart).Element()
+			el.CreateElement("modified")
+			want := etree.NewDocument()
+			if err := want.ReadFromString(xml); err != nil {
+				t.Fatal(err)
+			}
+			want.Root().CreateElement("modified")
+
+			got := part.Blob()
+			if canonicalXml(t, got) != string(canonicalize(want.Root())) {
+				t.Errorf("modified part differs semantically:\n got %s", got)
+			}
+			// The parser normalizes line ends, as XML requires.
+			prolog := strings.ReplaceAll(xml[:strings.Index(xml, "<"+el.FullTag())], "\r\n", "\n")
+			if !bytes.HasPrefix(got, []byte(prolog)) {
+				t.Errorf("prolog not kept:\n got %q\nwant prefix %q", got, prolog)
+			}
+		})
+	}
+}
+
+func TestXmlPart_Fidelity_ModifiedPartKeepsLayout(t *testing.T) {
+	pkg, err := OpenBytes(fidelityPackage(t, fidelityCorpus["unknown"]), xmlPartFactory(), WithFidelity())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	part, _ := pkg.MainDocumentPart()
+	el := part.(*XmlPart).Element()
+	el.FindElement("./w:body/w:p").CreateAttr("w:rsidRDefault", "00AB12CD")
+
+	got := string(part.Blob())
+	for _, want := range []string{
+		`<w:p w14:paraId="1A2B3C4D" w:rsidR="00AB12CD" w14:textId="77777777" w:rsidRDefault="00AB12CD"><w14:unknown a="1"/></w:p>`,
+		`mc:Ignorable="w14">` + "\n  <w:body>\n    <w:p",
+		`<mc:AlternateContent><mc:Choice Requires="w14"><w14:x/></mc:Choice><mc:Fallback/></mc:AlternateContent>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in\n%s", want, got)
+		}
+	}
+
+	pkg, err = OpenBytes(fidelityPackage(t, fidelityCorpus["whitespace"]), xmlPartFactory(), WithFidelity())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	part, _ = pkg.MainDocumentPart()
+	part.(*XmlPart).Element().FindElement("./w:body/w:p").CreateElement("w:r")
+	got = string(part.Blob())
+	for _, want := range []string{
+		`<w:t xml:space="preserve">  two  spaces  </w:t><w:t xml:space="preserve">` + "\n\t</w:t>",
+		`<w:instrText><![CDATA[ IF a < b ]]></w:instrText>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in\n%s", want, got)
+		}
+	}
+}
+
+func TestXmlPart_WithoutFidelity_Reindents(t *testing.T) {
+	pkg, err := OpenBytes(fidelityPackage(t, fidelityCorpus["unknown"]), xmlPartFactory())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	part, _ := pkg.MainDocumentPart()
+	if got := string(part.Blob()); got == fidelityCorpus["unknown"] {
+		t.Error("expected the part to be reserialized without WithFidelity")
+	}
+}
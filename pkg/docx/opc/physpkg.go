@@ -3,10 +3,15 @@ package opc
 import (
 	"archive/zip"
 	"bytes"
+	"compress/flate"
 	"fmt"
 	"io"
 	"os"
 	"strings"
+	"time"
+
+	"github.com/user/go-docx/internal/cfb"
+	"github.com/user/go-docx/pkg/docx"
 )
 
 // --------------------------------------------------------------------------
@@ -18,10 +23,16 @@ type PhysPkgReader struct {
 	reader *zip.Reader
 	closer io.Closer // non-nil when opened from a file
 	files  map[string]*zip.File
+	// folded indexes files by lowercased name once case-insensitive lookup
+	// is enabled; nil otherwise.
+	folded map[string]*zip.File
 }
 
 // NewPhysPkgReader creates a PhysPkgReader from an io.ReaderAt.
 func NewPhysPkgReader(r io.ReaderAt, size int64) (*PhysPkgReader, error) {
+	if cfb.IsCompoundFile(r) {
+		return nil, errEncrypted()
+	}
 	zr, err := zip.NewReader(r, size)
 	if err != nil {
 		return nil, fmt.Errorf("opc: opening zip: %w", err)
@@ -40,6 +51,10 @@ func NewPhysPkgReaderFromFile(path string) (*PhysPkgReader, error) {
 		f.Close()
 		return nil, fmt.Errorf("opc: stat file %q: %w", path, err)
 	}
+	if cfb.IsCompoundFile(f) {
+		f.Close()
+		return nil, errEncrypted()
+	}
 	zr, err := zip.NewReader(f, info.Size())
 	if err != nil {
 		f.Close()
@@ -54,6 +69,28 @@ func NewPhysPkgReaderFromBytes(data []byte) (*PhysPkgReader, error) {
 	return NewPhysPkgReader(r, int64(len(data)))
 }
 
+// newDecryptingPhysPkgReader creates a PhysPkgReader for the encrypted
+// package in r, decrypting it in memory with password.
+func newDecryptingPhysPkgReader(r io.ReaderAt, size int64, password string) (*PhysPkgReader, error) {
+	if !cfb.IsCompoundFile(r) {
+		return nil, docx.NewNotEncryptedError("opc: package is not encrypted")
+	}
+	cr, err := cfb.Open(r, size)
+	if err != nil {
+		return nil, docx.NewMalformedPackageError("opc: reading compound file: %v", err)
+	}
+	plain, err := decryptPackage(cr, password)
+	if err != nil {
+		return nil, err
+	}
+	return NewPhysPkgReaderFromBytes(plain)
+}
+
+// errEncrypted reports an encrypted package opened without a password.
+func errEncrypted() error {
+	return docx.NewInvalidPasswordError("opc: package is encrypted; open it with WithPassword")
+}
+
 func newPhysPkgReaderFromZip(zr *zip.Reader, closer io.Closer) *PhysPkgReader {
 	files := make(map[string]*zip.File, len(zr.File))
 	for _, f := range zr.File {
@@ -68,17 +105,38 @@ func newPhysPkgReaderFromZip(zr *zip.Reader, closer io.Closer) *PhysPkgReader {
 
 // BlobFor returns the contents of the part at the given PackURI.
 func (p *PhysPkgReader) BlobFor(uri PackURI) ([]byte, error) {
+	m, err := p.memberFor(uri)
+	if err != nil {
+		return nil, err
+	}
+	return m.read()
+}
+
+// memberFor returns a handle to the ZIP member backing the given PackURI
+// without reading its content.
+func (p *PhysPkgReader) memberFor(uri PackURI) (*zipMember, error) {
 	membername := uri.Membername()
 	f, ok := p.files[membername]
+	if !ok && p.folded != nil {
+		f, ok = p.folded[strings.ToLower(membername)]
+	}
 	if !ok {
 		return nil, fmt.Errorf("opc: member %q not found in package", membername)
 	}
-	rc, err := f.Open()
-	if err != nil {
-		return nil, fmt.Errorf("opc: opening member %q: %w", membername, err)
+	return &zipMember{file: f}, nil
+}
+
+// foldCase makes member lookups fall back to a case-insensitive match when
+// no member has the exact name. The returned member then carries the actual
+// name of the ZIP entry.
+func (p *PhysPkgReader) foldCase() {
+	p.folded = make(map[string]*zip.File, len(p.files))
+	for _, f := range p.reader.File {
+		lower := strings.ToLower(f.Name)
+		if _, dup := p.folded[lower]; !dup {
+			p.folded[lower] = f
+		}
 	}
-	defer rc.Close()
-	return io.ReadAll(rc)
 }
 
 // ContentTypesXml returns the [Content_Types].xml blob.
@@ -126,6 +184,27 @@ func (p *PhysPkgReader) Close() error {
 	return nil
 }
 
+// --------------------------------------------------------------------------
+// zipMember — deferred access to one member of the source archive
+// --------------------------------------------------------------------------
+
+// zipMember is a handle to a member of a source ZIP archive. It lets parts
+// opened with WithLazyLoading read their content on demand, and lets the
+// writer copy the member to a new archive without recompressing it.
+type zipMember struct {
+	file *zip.File
+}
+
+// read decompresses and returns the member content.
+func (m *zipMember) read() ([]byte, error) {
+	rc, err := m.file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("opc: opening member %q: %w", m.file.Name, err)
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
 // --------------------------------------------------------------------------
 // PhysPkgWriter — writes a ZIP-based OPC package
 // --------------------------------------------------------------------------
@@ -133,17 +212,44 @@ func (p *PhysPkgReader) Close() error {
 // PhysPkgWriter provides low-level write access to a ZIP-based OPC package.
 type PhysPkgWriter struct {
 	writer *zip.Writer
+	// modified, when non-zero, is stamped on every member written in
+	// place of the current time.
+	modified time.Time
 }
 
+// deterministicModTime is the timestamp of every member in deterministic
+// output: the earliest date a ZIP (MS-DOS) timestamp can represent.
+var deterministicModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// deterministicLevel is the fixed flate level used for deterministic output.
+const deterministicLevel = flate.BestCompression
+
 // NewPhysPkgWriter creates a PhysPkgWriter backed by the given writer.
 func NewPhysPkgWriter(w io.Writer) *PhysPkgWriter {
 	return &PhysPkgWriter{writer: zip.NewWriter(w)}
 }
 
+// setDeterministic makes every member written afterwards carry a fixed
+// timestamp and be deflated at a fixed compression level.
+func (p *PhysPkgWriter) setDeterministic() {
+	p.modified = deterministicModTime
+	p.writer.RegisterCompressor(zip.Deflate, func(w io.Writer) (io.WriteCloser, error) {
+		return flate.NewWriter(w, deterministicLevel)
+	})
+}
+
 // Write adds a member to the ZIP package.
 func (p *PhysPkgWriter) Write(uri PackURI, blob []byte) error {
 	membername := uri.Membername()
-	w, err := p.writer.Create(membername)
+	modified := p.modified
+	if modified.IsZero() {
+		modified = time.Now()
+	}
+	w, err := p.writer.CreateHeader(&zip.FileHeader{
+		Name:     membername,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
 	if err != nil {
 		return fmt.Errorf("opc: creating zip member %q: %w", membername, err)
 	}
@@ -153,6 +259,26 @@ func (p *PhysPkgWriter) Write(uri PackURI, blob []byte) error {
 	return nil
 }
 
+// writeRaw copies a member of a source archive to the ZIP package under the
+// name of uri, without decompressing and recompressing its content.
+func (p *PhysPkgWriter) writeRaw(uri PackURI, m *zipMember) error {
+	membername := uri.Membername()
+	fh := m.file.FileHeader
+	fh.Name = membername
+	w, err := p.writer.CreateRaw(&fh)
+	if err != nil {
+		return fmt.Errorf("opc: creating zip member %q: %w", membername, err)
+	}
+	r, err := m.file.OpenRaw()
+	if err != nil {
+		return fmt.Errorf("opc: opening raw member %q: %w", m.file.Name, err)
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		return fmt.Errorf("opc: copying zip member %q: %w", membername, err)
+	}
+	return nil
+}
+
 // Close finalizes the ZIP archive.
 func (p *PhysPkgWriter) Close() error {
 	return p.writer.Close()
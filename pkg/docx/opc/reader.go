@@ -2,6 +2,8 @@ package opc
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // --------------------------------------------------------------------------
@@ -24,7 +26,8 @@ func (sr SerializedRelationship) IsExternal() bool {
 }
 
 // TargetPartname resolves the target as a PackURI for internal relationships.
-func (sr SerializedRelationship) TargetPartname() PackURI {
+// It fails for targets that do not denote a part of the package; see FromRelRef.
+func (sr SerializedRelationship) TargetPartname() (PackURI, error) {
 	return FromRelRef(sr.BaseURI, sr.TargetRef)
 }
 
@@ -35,6 +38,9 @@ type SerializedPart struct {
 	RelType     string
 	Blob        []byte
 	SRels       []SerializedRelationship
+
+	// member is set instead of Blob when the package is read lazily.
+	member *zipMember
 }
 
 // --------------------------------------------------------------------------
@@ -43,28 +49,40 @@ type SerializedPart struct {
 
 // PackageReader reads an OPC package from a PhysPkgReader and produces
 // serialized parts and relationships.
-type PackageReader struct{}
+type PackageReader struct {
+	// lazy leaves SerializedPart.Blob nil and records the ZIP member instead.
+	lazy bool
+	// maxXmlDepth bounds the nesting depth of XML members read; zero means
+	// unlimited.
+	maxXmlDepth int
+	// recover works around broken packages instead of failing, recording
+	// each workaround in repairs.
+	recover bool
+	repairs []Repair
+}
 
 // ReadResult holds the results of reading a package.
 type ReadResult struct {
 	PkgSRels []SerializedRelationship
 	SParts   []SerializedPart
+	// Repairs lists the problems worked around in recovery mode.
+	Repairs []Repair
 }
 
 // Read reads the package and returns all serialized parts and relationships.
 func (pr *PackageReader) Read(physReader *PhysPkgReader) (*ReadResult, error) {
-	// 1. Parse [Content_Types].xml
-	ctBlob, err := physReader.ContentTypesXml()
-	if err != nil {
-		return nil, fmt.Errorf("opc: reading content types: %w", err)
+	if pr.recover {
+		physReader.foldCase()
 	}
-	contentTypes, err := ParseContentTypes(ctBlob)
+
+	// 1. Parse [Content_Types].xml
+	contentTypes, err := pr.readContentTypes(physReader)
 	if err != nil {
 		return nil, err
 	}
 
 	// 2. Read package-level relationships
-	pkgSRels, err := readSRels(physReader, PackageURI)
+	pkgSRels, err := pr.readSRels(physReader, PackageURI)
 	if err != nil {
 		return nil, fmt.Errorf("opc: reading package rels: %w", err)
 	}
@@ -73,67 +91,202 @@ func (pr *PackageReader) Read(physReader *PhysPkgReader) (*ReadResult, error) {
 	var sparts []SerializedPart
 	visited := make(map[PackURI]bool)
 
-	if err := walkParts(physReader, contentTypes, pkgSRels, &sparts, visited); err != nil {
+	if err := pr.walkParts(physReader, contentTypes, PackageURI, pkgSRels, &sparts, visited); err != nil {
 		return nil, err
 	}
 
+	// 4. In recovery mode, keep members no relationship reaches
+	if pr.recover {
+		if err := pr.collectOrphans(physReader, contentTypes, &sparts, visited); err != nil {
+			return nil, err
+		}
+	}
+
 	return &ReadResult{
 		PkgSRels: pkgSRels,
 		SParts:   sparts,
+		Repairs:  pr.repairs,
 	}, nil
 }
 
+// readContentTypes reads and parses [Content_Types].xml. In recovery mode a
+// missing or unparseable file yields an empty map.
+func (pr *PackageReader) readContentTypes(physReader *PhysPkgReader) (*ContentTypeMap, error) {
+	ctBlob, err := physReader.ContentTypesXml()
+	if err != nil {
+		if pr.recover {
+			pr.repair(RepairContentTypes, ContentTypesURI, "missing; content types inferred")
+			return NewContentTypeMap(), nil
+		}
+		return nil, fmt.Errorf("opc: reading content types: %w", err)
+	}
+	if err := checkXmlDepth(ContentTypesURI.String(), ctBlob, pr.maxXmlDepth); err != nil {
+		return nil, err
+	}
+	contentTypes, err := ParseContentTypes(ctBlob)
+	if err != nil {
+		if pr.recover {
+			pr.repair(RepairContentTypes, ContentTypesURI, "unreadable; content types inferred")
+			return NewContentTypeMap(), nil
+		}
+		return nil, err
+	}
+	return contentTypes, nil
+}
+
 // walkParts recursively discovers parts by following relationships.
-func walkParts(
+func (pr *PackageReader) walkParts(
 	physReader *PhysPkgReader,
 	contentTypes *ContentTypeMap,
+	source PackURI,
 	srels []SerializedRelationship,
 	sparts *[]SerializedPart,
 	visited map[PackURI]bool,
 ) error {
-	for _, srel := range srels {
+	for i := range srels {
+		srel := &srels[i]
 		if srel.IsExternal() {
 			continue
 		}
-		partname := srel.TargetPartname()
+		partname, err := srel.TargetPartname()
+		if err != nil {
+			if pr.recover {
+				pr.repairDangling(source, srel, err.Error())
+				continue
+			}
+			return err
+		}
 		if visited[partname] {
 			continue
 		}
-		visited[partname] = true
 
-		blob, err := physReader.BlobFor(partname)
+		member, err := physReader.memberFor(partname)
 		if err != nil {
+			if pr.recover {
+				pr.repairDangling(source, srel, fmt.Sprintf("target %q not found", partname))
+				continue
+			}
 			return fmt.Errorf("opc: reading part %q: %w", partname, err)
 		}
+		if actual := NewPackURI(member.file.Name); actual != partname {
+			pr.repair(RepairMemberCase, actual, fmt.Sprintf("referenced as %q", partname))
+			srel.TargetRef = actual.RelativeRef(srel.BaseURI)
+			partname = actual
+			if visited[partname] {
+				continue
+			}
+		}
+		visited[partname] = true
 
-		ct, err := contentTypes.ContentType(partname)
+		sp, err := pr.readPart(physReader, contentTypes, partname, srel.RelType, member)
 		if err != nil {
 			return err
 		}
+		*sparts = append(*sparts, sp)
 
-		partSRels, err := readSRels(physReader, partname)
-		if err != nil {
-			return fmt.Errorf("opc: reading rels for %q: %w", partname, err)
+		// Recurse into this part's relationships
+		if err := pr.walkParts(physReader, contentTypes, sp.Partname, sp.SRels, sparts, visited); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		*sparts = append(*sparts, SerializedPart{
-			Partname:    partname,
-			ContentType: ct,
-			RelType:     srel.RelType,
-			Blob:        blob,
-			SRels:       partSRels,
-		})
+// collectOrphans adds the members of the package that were not reached by
+// walking relationships, along with anything their own relationships reach.
+func (pr *PackageReader) collectOrphans(
+	physReader *PhysPkgReader,
+	contentTypes *ContentTypeMap,
+	sparts *[]SerializedPart,
+	visited map[PackURI]bool,
+) error {
+	uris := physReader.URIs()
+	sort.Slice(uris, func(i, j int) bool { return uris[i] < uris[j] })
+	for _, partname := range uris {
+		if visited[partname] || strings.HasSuffix(string(partname), "/") {
+			continue
+		}
+		visited[partname] = true
+		pr.repair(RepairOrphanMember, partname, "not reachable through any relationship")
 
-		// Recurse into this part's relationships
-		if err := walkParts(physReader, contentTypes, partSRels, sparts, visited); err != nil {
+		member, err := physReader.memberFor(partname)
+		if err != nil {
+			return err
+		}
+		sp, err := pr.readPart(physReader, contentTypes, partname, "", member)
+		if err != nil {
+			return err
+		}
+		*sparts = append(*sparts, sp)
+		if err := pr.walkParts(physReader, contentTypes, sp.Partname, sp.SRels, sparts, visited); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// readPart reads the part stored in member, resolving its content type and
+// its own relationships.
+func (pr *PackageReader) readPart(
+	physReader *PhysPkgReader,
+	contentTypes *ContentTypeMap,
+	partname PackURI,
+	relType string,
+	member *zipMember,
+) (SerializedPart, error) {
+	var blob []byte
+	if !pr.lazy {
+		var err error
+		if blob, err = member.read(); err != nil {
+			return SerializedPart{}, fmt.Errorf("opc: reading part %q: %w", partname, err)
+		}
+		member = nil
+	}
+
+	ct, err := contentTypes.ContentType(partname)
+	if err != nil {
+		if !pr.recover {
+			return SerializedPart{}, err
+		}
+		ct = inferContentType(partname, relType)
+		pr.repair(RepairContentType, partname, fmt.Sprintf("inferred %q", ct))
+	}
+
+	if blob != nil && isXmlContentType(ct) {
+		if err := checkXmlDepth(partname.String(), blob, pr.maxXmlDepth); err != nil {
+			return SerializedPart{}, err
+		}
+	}
+
+	partSRels, err := pr.readSRels(physReader, partname)
+	if err != nil {
+		return SerializedPart{}, fmt.Errorf("opc: reading rels for %q: %w", partname, err)
+	}
+
+	return SerializedPart{
+		Partname:    partname,
+		ContentType: ct,
+		RelType:     relType,
+		Blob:        blob,
+		SRels:       partSRels,
+		member:      member,
+	}, nil
+}
+
+// repair records a workaround made in recovery mode.
+func (pr *PackageReader) repair(kind RepairKind, partname PackURI, detail string) {
+	pr.repairs = append(pr.repairs, Repair{Kind: kind, Partname: partname, Detail: detail})
+}
+
+// repairDangling records that srel, a relationship of source, is dropped.
+// The relationship itself is left out when relationships are wired up, since
+// its target never becomes a part.
+func (pr *PackageReader) repairDangling(source PackURI, srel *SerializedRelationship, detail string) {
+	pr.repair(RepairDanglingRel, source, fmt.Sprintf("%s %s: %s", srel.RID, srel.TargetRef, detail))
+}
+
 // readSRels reads and parses the .rels file for the given source URI.
-func readSRels(physReader *PhysPkgReader, sourceURI PackURI) ([]SerializedRelationship, error) {
+func (pr *PackageReader) readSRels(physReader *PhysPkgReader, sourceURI PackURI) ([]SerializedRelationship, error) {
 	blob, err := physReader.RelsXmlFor(sourceURI)
 	if err != nil {
 		return nil, err
@@ -141,5 +294,8 @@ func readSRels(physReader *PhysPkgReader, sourceURI PackURI) ([]SerializedRelati
 	if blob == nil {
 		return nil, nil
 	}
+	if err := checkXmlDepth(sourceURI.RelsURI().String(), blob, pr.maxXmlDepth); err != nil {
+		return nil, err
+	}
 	return ParseRelationships(blob, sourceURI.BaseURI())
 }
@@ -1,7 +1,10 @@
 package opc
 
 import (
+	"archive/zip"
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/user/go-docx/pkg/docx/templates"
@@ -192,3 +195,143 @@ func TestOpcPackage_IterParts(t *testing.T) {
 		t.Errorf("expected 2 parts, got %d", len(parts))
 	}
 }
+
+func TestOpcPackage_LazyLoading(t *testing.T) {
+	data, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		t.Fatalf("reading default.docx: %v", err)
+	}
+
+	eager, err := OpenBytes(data, nil)
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	lazy, err := OpenBytes(data, nil, WithLazyLoading())
+	if err != nil {
+		t.Fatalf("OpenBytes (lazy): %v", err)
+	}
+	defer lazy.Close()
+
+	thumb, ok := lazy.PartByName("/docProps/thumbnail.jpeg")
+	if !ok {
+		t.Fatal("expected /docProps/thumbnail.jpeg part")
+	}
+	bp, ok := thumb.(*BasePart)
+	if !ok {
+		t.Fatalf("expected *BasePart, got %T", thumb)
+	}
+	if bp.blob != nil || bp.rawSource() == nil {
+		t.Error("expected lazily loaded part to hold no blob and a source member")
+	}
+	eagerThumb, _ := eager.PartByName("/docProps/thumbnail.jpeg")
+	if !bytes.Equal(thumb.Blob(), eagerThumb.Blob()) {
+		t.Error("lazy Blob() differs from eagerly read content")
+	}
+}
+
+func TestOpcPackage_LazyLoading_FactoryPartsReadEagerly(t *testing.T) {
+	data, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		t.Fatalf("reading default.docx: %v", err)
+	}
+	factory := NewPartFactory()
+	factory.Register(CTWmlDocumentMain, func(pn PackURI, ct, rt string, blob []byte, pkg *OpcPackage) (Part, error) {
+		return NewXmlPart(pn, ct, blob, pkg)
+	})
+	pkg, err := OpenBytes(data, factory, WithLazyLoading())
+	if err != nil {
+		t.Fatalf("OpenBytes (lazy): %v", err)
+	}
+	defer pkg.Close()
+
+	docPart, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatalf("MainDocumentPart: %v", err)
+	}
+	if _, ok := docPart.(*XmlPart); !ok {
+		t.Errorf("expected *XmlPart for the main document, got %T", docPart)
+	}
+}
+
+func TestOpcPackage_LazyLoading_SaveCopiesRaw(t *testing.T) {
+	data, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		t.Fatalf("reading default.docx: %v", err)
+	}
+	pkg, err := OpenBytes(data, nil, WithLazyLoading())
+	if err != nil {
+		t.Fatalf("OpenBytes (lazy): %v", err)
+	}
+	defer pkg.Close()
+
+	replaced, _ := pkg.PartByName("/customXml/item1.xml")
+	replaced.(*BasePart).SetBlob([]byte("<root/>"))
+
+	out, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatalf("SaveToBytes: %v", err)
+	}
+
+	src, _ := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	dst, err := zip.NewReader(bytes.NewReader(out), int64(len(out)))
+	if err != nil {
+		t.Fatalf("reading saved zip: %v", err)
+	}
+	srcFiles := make(map[string]*zip.File)
+	for _, f := range src.File {
+		srcFiles[f.Name] = f
+	}
+	for _, f := range dst.File {
+		if f.Name != "docProps/thumbnail.jpeg" {
+			continue
+		}
+		orig := srcFiles[f.Name]
+		if f.CRC32 != orig.CRC32 || f.CompressedSize64 != orig.CompressedSize64 {
+			t.Errorf("expected %s to be copied raw", f.Name)
+		}
+	}
+
+	pkg2, err := OpenBytes(out, nil)
+	if err != nil {
+		t.Fatalf("OpenBytes (round-trip): %v", err)
+	}
+	item, _ := pkg2.PartByName("/customXml/item1.xml")
+	if string(item.Blob()) != "<root/>" {
+		t.Errorf("replaced blob not saved, got %q", item.Blob())
+	}
+	thumb, _ := pkg2.PartByName("/docProps/thumbnail.jpeg")
+	if len(thumb.Blob()) != 8324 {
+		t.Errorf("thumbnail length = %d, want 8324", len(thumb.Blob()))
+	}
+}
+
+func TestOpcPackage_LazyLoading_Close(t *testing.T) {
+	data, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		t.Fatalf("reading default.docx: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "lazy.docx")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := OpenFile(path, nil, WithLazyLoading())
+	if err != nil {
+		t.Fatalf("OpenFile (lazy): %v", err)
+	}
+	thumb, _ := pkg.PartByName("/docProps/thumbnail.jpeg")
+	if len(thumb.Blob()) == 0 {
+		t.Fatal("expected thumbnail content while open")
+	}
+	if err := pkg.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := pkg.Close(); err != nil {
+		t.Fatalf("second Close: %v", err)
+	}
+	if thumb.Blob() != nil {
+		t.Error("expected nil Blob() after the source is closed")
+	}
+	if _, err := ReadBlob(thumb); err == nil {
+		t.Error("expected a ReadBlob error after the source is closed")
+	}
+}
@@ -0,0 +1,91 @@
+package opc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// --------------------------------------------------------------------------
+// Recovery diagnostics
+// --------------------------------------------------------------------------
+
+// RepairKind classifies a repair made while reading a package with
+// WithRecovery.
+type RepairKind string
+
+const (
+	// RepairContentTypes: [Content_Types].xml was missing or unreadable;
+	// every content type was inferred.
+	RepairContentTypes RepairKind = "content-types"
+	// RepairContentType: a part had no content type and one was inferred.
+	RepairContentType RepairKind = "content-type"
+	// RepairMemberCase: a relationship target matched a ZIP member only when
+	// compared case-insensitively; the target was rewritten to the member name.
+	RepairMemberCase RepairKind = "member-case"
+	// RepairDanglingRel: a relationship pointed to a missing part, or to a
+	// target outside the package, and was dropped.
+	RepairDanglingRel RepairKind = "dangling-rel"
+	// RepairOrphanMember: a ZIP member was not reachable through any
+	// relationship and was added to the package as a part.
+	RepairOrphanMember RepairKind = "orphan-member"
+)
+
+// Repair describes one problem found, and worked around, while reading a
+// package in recovery mode.
+type Repair struct {
+	Kind     RepairKind
+	Partname PackURI // part concerned; the relationship source for RepairDanglingRel
+	Detail   string
+}
+
+// String returns a one-line human-readable description of the repair.
+func (r Repair) String() string {
+	return fmt.Sprintf("%s %s: %s", r.Kind, r.Partname, r.Detail)
+}
+
+// --------------------------------------------------------------------------
+// Content type inference
+// --------------------------------------------------------------------------
+
+// relTypeContentTypes maps relationship types to the content type of the
+// part they conventionally target.
+var relTypeContentTypes = map[string]string{
+	RTComments:           CTWmlComments,
+	RTCoreProperties:     CTOpcCoreProperties,
+	RTCustomProperties:   CTOfcCustomProperties,
+	RTCustomXml:          CTXml,
+	RTCustomXmlProps:     CTOfcCustomXmlProperties,
+	RTChart:              CTDmlChart,
+	RTDrawing:            CTOfcDrawing,
+	RTEndnotes:           CTWmlEndnotes,
+	RTExtendedProperties: CTOfcExtendedProperties,
+	RTFontTable:          CTWmlFontTable,
+	RTFooter:             CTWmlFooter,
+	RTFootnotes:          CTWmlFootnotes,
+	RTGlossaryDocument:   CTWmlDocumentGlossary,
+	RTHeader:             CTWmlHeader,
+	RTNumbering:          CTWmlNumbering,
+	RTOfficeDocument:     CTWmlDocumentMain,
+	RTPackage:            CTOfcPackage,
+	RTPrinterSettings:    CTWmlPrinterSettings,
+	RTSettings:           CTWmlSettings,
+	RTStyles:             CTWmlStyles,
+	RTTheme:              CTOfcTheme,
+	RTVmlDrawing:         CTOfcVmlDrawing,
+	RTWebSettings:        CTWmlWebSettings,
+}
+
+// ctOctetStream is the content type assigned when nothing better is known.
+const ctOctetStream = "application/octet-stream"
+
+// inferContentType guesses the content type of partname, first from the
+// type of the relationship that reached it, then from its extension.
+func inferContentType(partname PackURI, relType string) string {
+	if ct, ok := relTypeContentTypes[relType]; ok {
+		return ct
+	}
+	if ct, ok := DefaultContentTypes[strings.ToLower(partname.Ext())]; ok {
+		return ct
+	}
+	return ctOctetStream
+}
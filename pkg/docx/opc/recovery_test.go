@@ -0,0 +1,147 @@
+package opc
+
+import (
+	"strings"
+	"testing"
+)
+
+func repairKinds(repairs []Repair) map[RepairKind]int {
+	kinds := make(map[RepairKind]int)
+	for _, r := range repairs {
+		kinds[r.Kind]++
+	}
+	return kinds
+}
+
+func TestRecovery_CleanPackageHasNoRepairs(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), nil, WithRecovery())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	if repairs := pkg.Repairs(); len(repairs) != 0 {
+		t.Errorf("expected no repairs, got %v", repairs)
+	}
+}
+
+func TestRecovery_MissingContentTypes(t *testing.T) {
+	data := rewriteZip(t, loadDefaultDocx(t), map[string][]byte{
+		"[Content_Types].xml": nil,
+	})
+	if _, err := OpenBytes(data, nil); err == nil {
+		t.Fatal("expected error without recovery")
+	}
+	pkg, err := OpenBytes(data, nil, WithRecovery())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	kinds := repairKinds(pkg.Repairs())
+	if kinds[RepairContentTypes] != 1 || kinds[RepairContentType] == 0 {
+		t.Errorf("unexpected repairs: %v", pkg.Repairs())
+	}
+	doc, _ := pkg.MainDocumentPart()
+	if doc.ContentType() != CTWmlDocumentMain {
+		t.Errorf("document content type = %q, want %q", doc.ContentType(), CTWmlDocumentMain)
+	}
+	thumb, _ := pkg.PartByName("/docProps/thumbnail.jpeg")
+	if thumb.ContentType() != CTJpeg {
+		t.Errorf("thumbnail content type = %q, want %q", thumb.ContentType(), CTJpeg)
+	}
+}
+
+func TestRecovery_MemberCase(t *testing.T) {
+	data := loadDefaultDocx(t)
+	src, _ := OpenBytes(data, nil)
+	doc, _ := src.PartByName("/word/document.xml")
+	data = rewriteZip(t, data, map[string][]byte{
+		"word/document.xml": nil,
+		"word/Document.xml": doc.Blob(),
+	})
+	if _, err := OpenBytes(data, nil); err == nil {
+		t.Fatal("expected error without recovery")
+	}
+	pkg, err := OpenBytes(data, nil, WithRecovery())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	kinds := repairKinds(pkg.Repairs())
+	if kinds[RepairMemberCase] != 1 || kinds[RepairOrphanMember] != 0 {
+		t.Errorf("unexpected repairs: %v", pkg.Repairs())
+	}
+	main, err := pkg.MainDocumentPart()
+	if err != nil {
+		t.Fatalf("MainDocumentPart: %v", err)
+	}
+	if main.PartName() != "/word/Document.xml" {
+		t.Errorf("partname = %q", main.PartName())
+	}
+	// The override for /word/document.xml matches case-insensitively.
+	if main.ContentType() != CTWmlDocumentMain {
+		t.Errorf("content type = %q", main.ContentType())
+	}
+	if _, ok := pkg.PartByName("/word/styles.xml"); !ok {
+		t.Error("expected the document's relationships to be followed")
+	}
+
+	out, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatalf("SaveToBytes: %v", err)
+	}
+	if _, err := OpenBytes(out, nil); err != nil {
+		t.Errorf("repaired package does not open strictly: %v", err)
+	}
+}
+
+func TestRecovery_DanglingRelAndOrphan(t *testing.T) {
+	rels := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/image" Target="media/missing.png"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/image" Target="../../outside.png"/>
+</Relationships>`
+	data := rewriteZip(t, loadDefaultDocx(t), map[string][]byte{
+		"word/_rels/document.xml.rels": []byte(rels),
+		"word/media/extra.png":         []byte("\x89PNG"),
+	})
+	if _, err := OpenBytes(data, nil); err == nil {
+		t.Fatal("expected error without recovery")
+	}
+	pkg, err := OpenBytes(data, nil, WithRecovery())
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	kinds := repairKinds(pkg.Repairs())
+	if kinds[RepairDanglingRel] != 2 {
+		t.Errorf("expected 2 dangling rels, got %v", pkg.Repairs())
+	}
+	for _, r := range pkg.Repairs() {
+		if r.Kind == RepairDanglingRel && r.Partname != "/word/document.xml" {
+			t.Errorf("dangling rel source = %q, want /word/document.xml", r.Partname)
+		}
+	}
+
+	doc, _ := pkg.MainDocumentPart()
+	if doc.Rels().Len() != 1 || doc.Rels().GetByRID("rId1") == nil {
+		t.Errorf("expected only rId1 to remain, got %d rels", doc.Rels().Len())
+	}
+
+	extra, ok := pkg.PartByName("/word/media/extra.png")
+	if !ok {
+		t.Fatal("expected orphan /word/media/extra.png to be kept")
+	}
+	if extra.ContentType() != CTPng {
+		t.Errorf("orphan content type = %q, want %q", extra.ContentType(), CTPng)
+	}
+	// Parts only reachable from the dropped relationships are orphans too.
+	orphans := 0
+	for _, r := range pkg.Repairs() {
+		if r.Kind == RepairOrphanMember {
+			orphans++
+			if !strings.HasPrefix(r.String(), "orphan-member /") {
+				t.Errorf("Repair.String() = %q", r.String())
+			}
+		}
+	}
+	if orphans == 0 {
+		t.Error("expected orphan-member repairs")
+	}
+}
@@ -0,0 +1,734 @@
+package opc
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+
+	"github.com/user/go-docx/pkg/docx"
+)
+
+// --------------------------------------------------------------------------
+// OPC digital signatures (ECMA-376 Part 2, §13)
+// --------------------------------------------------------------------------
+//
+// A signed package relates an origin part from the package, the origin
+// relates one signature part per signature, and each signature part holds
+// an XML-DSig <Signature>. Its SignedInfo references an <Object> whose
+// <Manifest> lists the digest of every signed part and, through the OPC
+// RelationshipTransform, of every signed relationship.
+
+const (
+	nsDsig        = "http://www.w3.org/2000/09/xmldsig#"
+	nsOpcDsig     = "http://schemas.openxmlformats.org/package/2006/digital-signature"
+	algRelsTransf = "http://schemas.openxmlformats.org/package/2006/RelationshipTransform"
+	typeDsigObj   = "http://www.w3.org/2000/09/xmldsig#Object"
+
+	signatureOriginURI = PackURI("/_xmlsignatures/origin.sigs")
+	signaturePartTmpl  = "/_xmlsignatures/sig%d.xml"
+	signatureTimeFmt   = "YYYY-MM-DDThh:mm:ssTZD"
+	packageObjectID    = "idPackageObject"
+	packageSignatureID = "idPackageSignature"
+)
+
+// digestMethods maps XML-DSig digest algorithm URIs to hash functions.
+var digestMethods = map[string]crypto.Hash{
+	"http://www.w3.org/2000/09/xmldsig#sha1":        crypto.SHA1,
+	"http://www.w3.org/2001/04/xmlenc#sha256":       crypto.SHA256,
+	"http://www.w3.org/2001/04/xmldsig-more#sha384": crypto.SHA384,
+	"http://www.w3.org/2001/04/xmlenc#sha512":       crypto.SHA512,
+}
+
+// signatureMethod is an XML-DSig signature algorithm.
+type signatureMethod struct {
+	hash  crypto.Hash
+	ecdsa bool
+}
+
+// signatureMethods maps XML-DSig signature algorithm URIs to algorithms.
+var signatureMethods = map[string]signatureMethod{
+	"http://www.w3.org/2000/09/xmldsig#rsa-sha1":          {crypto.SHA1, false},
+	"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256":   {crypto.SHA256, false},
+	"http://www.w3.org/2001/04/xmldsig-more#rsa-sha384":   {crypto.SHA384, false},
+	"http://www.w3.org/2001/04/xmldsig-more#rsa-sha512":   {crypto.SHA512, false},
+	"http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha1":   {crypto.SHA1, true},
+	"http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256": {crypto.SHA256, true},
+	"http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha384": {crypto.SHA384, true},
+	"http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha512": {crypto.SHA512, true},
+}
+
+const (
+	algDigestSHA256  = "http://www.w3.org/2001/04/xmlenc#sha256"
+	algRsaSHA256     = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algEcdsaSHA256   = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256"
+	signatureTimeVal = "2006-01-02T15:04:05Z07:00"
+)
+
+// Signature is a digital signature part of a package.
+type Signature struct {
+	part Part
+	pkg  *OpcPackage
+}
+
+// Part returns the part holding the XML signature.
+func (s *Signature) Part() Part {
+	return s.part
+}
+
+// SignatureInfo describes a signature that verified successfully.
+type SignatureInfo struct {
+	// Certificate is the signer's certificate, from the signature's KeyInfo
+	// or its certificate part.
+	Certificate *x509.Certificate
+	// SigningTime is the time the signer claims to have signed, or the zero
+	// time if the signature does not record one.
+	SigningTime time.Time
+	// SignedParts lists the parts whose content is covered by the
+	// signature, including relationship parts, sorted by partname.
+	SignedParts []PackURI
+	// UnsignedParts lists the parts of the package, other than the
+	// signature parts, whose content the signature does not cover, such as
+	// parts added after signing. It is sorted by partname.
+	UnsignedParts []PackURI
+	// UnsignedRelationships maps the partname of a relationship part to
+	// the sorted Ids of its relationships the signature does not cover:
+	// all of them when the part is not referenced, otherwise those outside
+	// its RelationshipTransform selection. Relationships to signature parts
+	// are not listed.
+	UnsignedRelationships map[PackURI][]string
+}
+
+// Complete reports whether the signature covers the whole package, so that
+// nothing was added to it after signing: every part and relationship other
+// than the signatures themselves is signed.
+func (info *SignatureInfo) Complete() bool {
+	return len(info.UnsignedParts) == 0 && len(info.UnsignedRelationships) == 0
+}
+
+// Signatures returns the signatures of the package, found through the
+// digital signature origin part. It returns nil for an unsigned package.
+func (p *OpcPackage) Signatures() []*Signature {
+	var sigs []*Signature
+	for _, rel := range p.rels.AllByRelType(RTDigitalSignatureOrigin) {
+		origin := rel.TargetPart
+		if origin == nil || origin.Rels() == nil {
+			continue
+		}
+		for _, srel := range origin.Rels().AllByRelType(RTDigitalSignature) {
+			if srel.TargetPart != nil {
+				sigs = append(sigs, &Signature{part: srel.TargetPart, pkg: p})
+			}
+		}
+	}
+	return sigs
+}
+
+// Verify checks the signature value and the digest of every reference it
+// covers, including the parts and relationships listed in its manifest. A
+// signature that does not verify yields a *docx.InvalidSignatureError.
+// Content the signature does not reference is not an error; it is listed
+// in the UnsignedParts and UnsignedRelationships of the result, and
+// SignatureInfo.Complete reports whether there is any.
+//
+// Part digests are computed over the content ReadBlob returns; a part that
+// cannot be read fails with that I/O error rather than a digest mismatch.
+// To verify a received file, open it without a PartFactory (or with one
+// that keeps parts as BaseParts) so that each part still holds its
+// original bytes.
+//
+// Verify establishes that the content is unchanged since it was signed by
+// the holder of the certificate's key; whether to trust that certificate is
+// left to the caller.
+func (s *Signature) Verify() (*SignatureInfo, error) {
+	blob, err := ReadBlob(s.part)
+	if err != nil {
+		return nil, fmt.Errorf("opc: reading signature %q: %w", s.part.PartName(), err)
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(blob); err != nil {
+		return nil, docx.NewInvalidXmlError("opc: parsing signature %q: %v", s.part.PartName(), err)
+	}
+	root := doc.Root()
+	if !isDsig(root, "Signature") {
+		return nil, docx.NewInvalidXmlError("opc: %q is not an XML signature", s.part.PartName())
+	}
+	signedInfo := dsigChild(root, "SignedInfo")
+	if signedInfo == nil {
+		return nil, docx.NewInvalidXmlError("opc: signature %q has no SignedInfo", s.part.PartName())
+	}
+
+	// 1. Signature value over the canonical SignedInfo.
+	if alg := dsigAlgorithm(signedInfo, "CanonicalizationMethod"); alg != algC14N {
+		return nil, docx.NewDocxError("opc: unsupported canonicalization method %q", alg)
+	}
+	method, ok := signatureMethods[dsigAlgorithm(signedInfo, "SignatureMethod")]
+	if !ok {
+		return nil, docx.NewDocxError("opc: unsupported signature method %q", dsigAlgorithm(signedInfo, "SignatureMethod"))
+	}
+	cert, err := s.certificate(root)
+	if err != nil {
+		return nil, err
+	}
+	sigValue, err := decodeDsigB64(dsigChild(root, "SignatureValue"))
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignatureValue(cert, method, canonicalize(signedInfo), sigValue); err != nil {
+		return nil, err
+	}
+
+	// 2. References: same-document objects, then the package manifest.
+	info := &SignatureInfo{Certificate: cert}
+	signed := map[PackURI]bool{}
+	selected := map[PackURI][]*relsSelection{}
+	for _, ref := range dsigChildren(signedInfo, "Reference") {
+		uri := ref.SelectAttrValue("URI", "")
+		if !strings.HasPrefix(uri, "#") {
+			if err := s.verifyPackageReference(ref, signed, selected); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		target := findByID(root, uri[1:])
+		if target == nil {
+			return nil, docx.NewInvalidSignatureError("opc: reference %q not found in signature", uri)
+		}
+		if err := verifyDigest(ref, canonicalize(target)); err != nil {
+			return nil, err
+		}
+		if isDsig(target, "Object") {
+			if manifest := dsigChild(target, "Manifest"); manifest != nil {
+				for _, mref := range dsigChildren(manifest, "Reference") {
+					if err := s.verifyPackageReference(mref, signed, selected); err != nil {
+						return nil, err
+					}
+				}
+			}
+			if t, ok := signatureTime(target); ok {
+				info.SigningTime = t
+			}
+		}
+	}
+	for pn := range signed {
+		info.SignedParts = append(info.SignedParts, pn)
+	}
+	sort.Slice(info.SignedParts, func(i, j int) bool { return info.SignedParts[i] < info.SignedParts[j] })
+	s.findUnsigned(info, signed, selected)
+	return info, nil
+}
+
+// findUnsigned fills in the parts and relationships of the package that
+// the verified references in signed and selected do not cover.
+func (s *Signature) findUnsigned(info *SignatureInfo, signed map[PackURI]bool, selected map[PackURI][]*relsSelection) {
+	checkRels := func(relsPart PackURI, rels *Relationships) {
+		if rels == nil || signed[relsPart] && len(selected[relsPart]) == 0 {
+			return
+		}
+		var ids []string
+		for _, rel := range rels.All() {
+			if isSignatureRelType(rel.RelType) {
+				continue
+			}
+			covered := false
+			for _, sel := range selected[relsPart] {
+				covered = covered || sel.ids[rel.RID] || sel.types[rel.RelType]
+			}
+			if !covered {
+				ids = append(ids, rel.RID)
+			}
+		}
+		if len(ids) > 0 {
+			sort.Strings(ids)
+			if info.UnsignedRelationships == nil {
+				info.UnsignedRelationships = map[PackURI][]string{}
+			}
+			info.UnsignedRelationships[relsPart] = ids
+		}
+	}
+	checkRels(PackageURI.RelsURI(), s.pkg.rels)
+	for _, part := range s.pkg.Parts() {
+		if isSignatureContentType(part.ContentType()) {
+			continue
+		}
+		if !signed[part.PartName()] {
+			info.UnsignedParts = append(info.UnsignedParts, part.PartName())
+		}
+		checkRels(part.PartName().RelsURI(), part.Rels())
+	}
+}
+
+// certificate returns the signer's certificate from KeyInfo, falling back
+// to the certificate part related from the signature part.
+func (s *Signature) certificate(root *etree.Element) (*x509.Certificate, error) {
+	var der []byte
+	if ki := dsigChild(root, "KeyInfo"); ki != nil {
+		if xd := dsigChild(ki, "X509Data"); xd != nil {
+			if c := dsigChild(xd, "X509Certificate"); c != nil {
+				b, err := decodeDsigB64(c)
+				if err != nil {
+					return nil, err
+				}
+				der = b
+			}
+		}
+	}
+	if der == nil && s.part.Rels() != nil {
+		if rel, err := s.part.Rels().GetByRelType(RTDigitalSignatureCert); err == nil && rel.TargetPart != nil {
+			if der, err = ReadBlob(rel.TargetPart); err != nil {
+				return nil, fmt.Errorf("opc: reading signer certificate: %w", err)
+			}
+		}
+	}
+	if der == nil {
+		return nil, docx.NewDocxError("opc: signature %q carries no certificate", s.part.PartName())
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, docx.NewDocxError("opc: parsing signer certificate: %v", err)
+	}
+	return cert, nil
+}
+
+// verifyPackageReference checks a reference to a part ("/word/document.xml
+// ?ContentType=...") or to a relationship part through the
+// RelationshipTransform, recording the referenced partname in signed and
+// the relationships a transform keeps in selected.
+func (s *Signature) verifyPackageReference(ref *etree.Element, signed map[PackURI]bool, selected map[PackURI][]*relsSelection) error {
+	raw := ref.SelectAttrValue("URI", "")
+	pathPart, query, _ := strings.Cut(raw, "?")
+	path, err := url.PathUnescape(pathPart)
+	if err != nil {
+		return docx.NewInvalidSignatureError("opc: invalid reference URI %q", raw)
+	}
+	partname := NewPackURI(path)
+	// Content types contain "+", so the query is not form-decoded.
+	contentType := strings.TrimPrefix(query, "ContentType=")
+
+	var relsSelect *relsSelection
+	c14n := false
+	if transforms := dsigChild(ref, "Transforms"); transforms != nil {
+		for _, t := range dsigChildren(transforms, "Transform") {
+			switch alg := t.SelectAttrValue("Algorithm", ""); alg {
+			case algRelsTransf:
+				relsSelect = parseRelsSelection(t)
+			case algC14N:
+				c14n = true
+			default:
+				return docx.NewDocxError("opc: unsupported transform %q", alg)
+			}
+		}
+	}
+
+	var content []byte
+	if relsSelect != nil {
+		rels, ok := s.pkg.relsForRelsPart(partname)
+		if !ok {
+			return docx.NewInvalidSignatureError("opc: signed relationships %q not found", partname)
+		}
+		if contentType != "" && contentType != CTOpcRelationships {
+			return docx.NewInvalidSignatureError("opc: content type of %q changed", partname)
+		}
+		content = relsSelect.canonical(rels)
+	} else {
+		part, ok := s.pkg.PartByName(partname)
+		if !ok {
+			return docx.NewInvalidSignatureError("opc: signed part %q not found", partname)
+		}
+		if contentType != "" && part.ContentType() != contentType {
+			return docx.NewInvalidSignatureError("opc: content type of %q changed", partname)
+		}
+		var err error
+		if content, err = ReadBlob(part); err != nil {
+			return fmt.Errorf("opc: reading signed part %q: %w", partname, err)
+		}
+		if c14n {
+			doc := etree.NewDocument()
+			if err := doc.ReadFromBytes(content); err != nil {
+				return docx.NewInvalidSignatureError("opc: signed part %q is not XML", partname)
+			}
+			content = canonicalize(doc.Root())
+		}
+	}
+	if err := verifyDigest(ref, content); err != nil {
+		return err
+	}
+	signed[partname] = true
+	if relsSelect != nil {
+		selected[partname] = append(selected[partname], relsSelect)
+	}
+	return nil
+}
+
+// relsForRelsPart returns the relationships stored in the relationship part
+// partname, e.g. the package rels for "/_rels/.rels".
+func (p *OpcPackage) relsForRelsPart(partname PackURI) (*Relationships, bool) {
+	dir, file := partname.BaseURI(), partname.Filename()
+	if !strings.HasSuffix(dir, "/_rels") || !strings.HasSuffix(file, ".rels") {
+		return nil, false
+	}
+	source := strings.TrimSuffix(dir, "_rels") + strings.TrimSuffix(file, ".rels")
+	if source == "/" {
+		return p.rels, true
+	}
+	part, ok := p.PartByName(PackURI(source))
+	if !ok || part.Rels() == nil {
+		return nil, false
+	}
+	return part.Rels(), true
+}
+
+// --------------------------------------------------------------------------
+// RelationshipTransform
+// --------------------------------------------------------------------------
+
+// relsSelection is the set of relationships a RelationshipTransform keeps.
+type relsSelection struct {
+	ids   map[string]bool
+	types map[string]bool
+}
+
+func parseRelsSelection(t *etree.Element) *relsSelection {
+	sel := &relsSelection{ids: map[string]bool{}, types: map[string]bool{}}
+	for _, c := range t.ChildElements() {
+		switch c.Tag {
+		case "RelationshipReference":
+			sel.ids[c.SelectAttrValue("SourceId", "")] = true
+		case "RelationshipsGroupReference":
+			sel.types[c.SelectAttrValue("SourceType", "")] = true
+		}
+	}
+	return sel
+}
+
+// canonical applies the transform to rels: it keeps the selected
+// relationships, makes TargetMode explicit, sorts them by Id, and returns
+// the canonical XML of the result.
+func (sel *relsSelection) canonical(rels *Relationships) []byte {
+	var kept []*Relationship
+	for _, rel := range rels.All() {
+		if sel.ids[rel.RID] || sel.types[rel.RelType] {
+			kept = append(kept, rel)
+		}
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i].RID < kept[j].RID })
+
+	var sb strings.Builder
+	sb.WriteString(`<Relationships xmlns="` + NsOpcRelationships + `">`)
+	for _, rel := range kept {
+		mode := TargetModeInternal
+		if rel.IsExternal {
+			mode = TargetModeExternal
+		}
+		sb.WriteString(`<Relationship Id="` + escapeC14NAttr(rel.RID) +
+			`" Target="` + escapeC14NAttr(rel.TargetRef) +
+			`" TargetMode="` + mode +
+			`" Type="` + escapeC14NAttr(rel.RelType) + `"></Relationship>`)
+	}
+	sb.WriteString(`</Relationships>`)
+	return []byte(sb.String())
+}
+
+// --------------------------------------------------------------------------
+// Signing
+// --------------------------------------------------------------------------
+
+// Sign adds a signature over every part and relationship of the package,
+// made with key for cert, creating the signature origin part if needed.
+// RSA and ECDSA keys are supported; digests use SHA-256. The signature
+// covers the package as it is when Sign is called: parts changed afterwards
+// will fail verification.
+func (p *OpcPackage) Sign(cert *x509.Certificate, key crypto.Signer) (*Signature, error) {
+	sigAlg := algRsaSHA256
+	switch key.Public().(type) {
+	case *rsa.PublicKey:
+	case *ecdsa.PublicKey:
+		sigAlg = algEcdsaSHA256
+	default:
+		return nil, docx.NewDocxError("opc: unsupported signing key type %T", key.Public())
+	}
+
+	parts := p.Parts()
+	for _, part := range parts {
+		part.BeforeMarshal()
+	}
+
+	doc := etree.NewDocument()
+	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8" standalone="yes"`)
+	root := doc.CreateElement("Signature")
+	root.CreateAttr("xmlns", nsDsig)
+	root.CreateAttr("Id", packageSignatureID)
+	signedInfo := root.CreateElement("SignedInfo")
+	signedInfo.CreateElement("CanonicalizationMethod").CreateAttr("Algorithm", algC14N)
+	signedInfo.CreateElement("SignatureMethod").CreateAttr("Algorithm", sigAlg)
+	sigValue := root.CreateElement("SignatureValue")
+	x509Data := root.CreateElement("KeyInfo").CreateElement("X509Data")
+	x509Data.CreateElement("X509Certificate").SetText(base64.StdEncoding.EncodeToString(cert.Raw))
+
+	object := root.CreateElement("Object")
+	object.CreateAttr("Id", packageObjectID)
+	manifest := object.CreateElement("Manifest")
+
+	// Parts, then their relationships, skipping the signatures themselves.
+	addRelsRef := func(source PackURI, rels *Relationships) {
+		var ids []string
+		for _, rel := range rels.All() {
+			if !isSignatureRelType(rel.RelType) {
+				ids = append(ids, rel.RID)
+			}
+		}
+		if len(ids) == 0 {
+			return
+		}
+		sort.Strings(ids)
+		sel := &relsSelection{ids: map[string]bool{}}
+		ref := manifest.CreateElement("Reference")
+		ref.CreateAttr("URI", referenceURI(source.RelsURI(), CTOpcRelationships))
+		transforms := ref.CreateElement("Transforms")
+		t := transforms.CreateElement("Transform")
+		t.CreateAttr("Algorithm", algRelsTransf)
+		for _, id := range ids {
+			rr := t.CreateElement("mdssi:RelationshipReference")
+			rr.CreateAttr("xmlns:mdssi", nsOpcDsig)
+			rr.CreateAttr("SourceId", id)
+			sel.ids[id] = true
+		}
+		transforms.CreateElement("Transform").CreateAttr("Algorithm", algC14N)
+		addDigest(ref, sel.canonical(rels))
+	}
+	for _, part := range parts {
+		if isSignatureContentType(part.ContentType()) {
+			continue
+		}
+		ref := manifest.CreateElement("Reference")
+		ref.CreateAttr("URI", referenceURI(part.PartName(), part.ContentType()))
+		blob, err := ReadBlob(part)
+		if err != nil {
+			return nil, fmt.Errorf("opc: reading %q: %w", part.PartName(), err)
+		}
+		addDigest(ref, blob)
+	}
+	addRelsRef(PackageURI, p.rels)
+	for _, part := range parts {
+		if !isSignatureContentType(part.ContentType()) && part.Rels() != nil {
+			addRelsRef(part.PartName(), part.Rels())
+		}
+	}
+
+	// Signing time.
+	props := object.CreateElement("SignatureProperties")
+	prop := props.CreateElement("SignatureProperty")
+	prop.CreateAttr("Id", "idSignatureTime")
+	prop.CreateAttr("Target", "#"+packageSignatureID)
+	st := prop.CreateElement("mdssi:SignatureTime")
+	st.CreateAttr("xmlns:mdssi", nsOpcDsig)
+	st.CreateElement("mdssi:Format").SetText(signatureTimeFmt)
+	st.CreateElement("mdssi:Value").SetText(time.Now().UTC().Format(signatureTimeVal))
+
+	// SignedInfo references the object; then sign SignedInfo.
+	ref := signedInfo.CreateElement("Reference")
+	ref.CreateAttr("URI", "#"+packageObjectID)
+	ref.CreateAttr("Type", typeDsigObj)
+	addDigest(ref, canonicalize(object))
+
+	sig, err := signValue(key, canonicalize(signedInfo))
+	if err != nil {
+		return nil, err
+	}
+	sigValue.SetText(base64.StdEncoding.EncodeToString(sig))
+
+	blob, err := doc.WriteToBytes()
+	if err != nil {
+		return nil, fmt.Errorf("opc: serializing signature: %w", err)
+	}
+
+	// Wire up origin and signature parts.
+	origin, ok := p.PartByName(signatureOriginURI)
+	if !ok {
+		origin = NewBasePart(signatureOriginURI, CTOpcDigitalSignatureOrigin, nil, p)
+		p.AddPart(origin)
+	}
+	p.rels.GetOrAdd(RTDigitalSignatureOrigin, origin)
+	if origin.Rels() == nil {
+		origin.SetRels(NewRelationships(signatureOriginURI.BaseURI()))
+	}
+	sigPart := NewBasePart(p.NextPartname(signaturePartTmpl), CTOpcDigitalSignatureXmlsig, blob, p)
+	p.AddPart(sigPart)
+	origin.Rels().GetOrAdd(RTDigitalSignature, sigPart)
+	return &Signature{part: sigPart, pkg: p}, nil
+}
+
+// referenceURI builds a manifest reference URI for partname.
+func referenceURI(partname PackURI, contentType string) string {
+	return string(partname) + "?ContentType=" + contentType
+}
+
+func addDigest(ref *etree.Element, content []byte) {
+	ref.CreateElement("DigestMethod").CreateAttr("Algorithm", algDigestSHA256)
+	h := crypto.SHA256.New()
+	h.Write(content)
+	ref.CreateElement("DigestValue").SetText(base64.StdEncoding.EncodeToString(h.Sum(nil)))
+}
+
+func signValue(key crypto.Signer, signedInfo []byte) ([]byte, error) {
+	h := crypto.SHA256.New()
+	h.Write(signedInfo)
+	digest := h.Sum(nil)
+	sig, err := key.Sign(rand.Reader, digest, crypto.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("opc: signing: %w", err)
+	}
+	if pub, ok := key.Public().(*ecdsa.PublicKey); ok {
+		// XML-DSig encodes ECDSA signatures as r||s, not ASN.1.
+		var esig struct{ R, S *big.Int }
+		if _, err := asn1.Unmarshal(sig, &esig); err != nil {
+			return nil, fmt.Errorf("opc: signing: %w", err)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		out := make([]byte, 2*size)
+		esig.R.FillBytes(out[:size])
+		esig.S.FillBytes(out[size:])
+		sig = out
+	}
+	return sig, nil
+}
+
+// --------------------------------------------------------------------------
+// Verification helpers
+// --------------------------------------------------------------------------
+
+func verifySignatureValue(cert *x509.Certificate, method signatureMethod, signedInfo, sig []byte) error {
+	h := method.hash.New()
+	h.Write(signedInfo)
+	digest := h.Sum(nil)
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if method.ecdsa {
+			break
+		}
+		if rsa.VerifyPKCS1v15(pub, method.hash, digest, sig) != nil {
+			return docx.NewInvalidSignatureError("opc: signature value does not verify")
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if !method.ecdsa {
+			break
+		}
+		half := len(sig) / 2
+		r, s := new(big.Int).SetBytes(sig[:half]), new(big.Int).SetBytes(sig[half:])
+		if len(sig)%2 != 0 || !ecdsa.Verify(pub, digest, r, s) {
+			return docx.NewInvalidSignatureError("opc: signature value does not verify")
+		}
+		return nil
+	}
+	return docx.NewDocxError("opc: signature method does not match the %T certificate key", cert.PublicKey)
+}
+
+// verifyDigest compares the digest of content with the DigestValue of ref.
+func verifyDigest(ref *etree.Element, content []byte) error {
+	hash, ok := digestMethods[dsigAlgorithm(ref, "DigestMethod")]
+	if !ok {
+		return docx.NewDocxError("opc: unsupported digest method %q", dsigAlgorithm(ref, "DigestMethod"))
+	}
+	want, err := decodeDsigB64(dsigChild(ref, "DigestValue"))
+	if err != nil {
+		return err
+	}
+	h := hash.New()
+	h.Write(content)
+	if !bytes.Equal(h.Sum(nil), want) {
+		return docx.NewInvalidSignatureError("opc: digest mismatch for reference %q", ref.SelectAttrValue("URI", ""))
+	}
+	return nil
+}
+
+// signatureTime extracts the OPC SignatureTime value from an Object.
+func signatureTime(object *etree.Element) (time.Time, bool) {
+	for _, el := range object.FindElements(".//Value") {
+		if el.NamespaceURI() != nsOpcDsig || el.Parent() == nil || el.Parent().Tag != "SignatureTime" {
+			continue
+		}
+		for _, layout := range []string{signatureTimeVal, "2006-01-02T15:04:05.999999999Z07:00", "2006-01-02T15:04Z07:00", "2006-01-02"} {
+			if t, err := time.Parse(layout, strings.TrimSpace(el.Text())); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func isSignatureRelType(relType string) bool {
+	return relType == RTDigitalSignatureOrigin || relType == RTDigitalSignature || relType == RTDigitalSignatureCert
+}
+
+func isSignatureContentType(ct string) bool {
+	return ct == CTOpcDigitalSignatureOrigin || ct == CTOpcDigitalSignatureXmlsig || ct == CTOpcDigitalSignatureCert
+}
+
+// isDsig reports whether el is the XML-DSig element with the given name.
+func isDsig(el *etree.Element, name string) bool {
+	return el != nil && el.Tag == name && el.NamespaceURI() == nsDsig
+}
+
+func dsigChild(el *etree.Element, name string) *etree.Element {
+	for _, c := range el.ChildElements() {
+		if isDsig(c, name) {
+			return c
+		}
+	}
+	return nil
+}
+
+func dsigChildren(el *etree.Element, name string) []*etree.Element {
+	var out []*etree.Element
+	for _, c := range el.ChildElements() {
+		if isDsig(c, name) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func dsigAlgorithm(el *etree.Element, child string) string {
+	if c := dsigChild(el, child); c != nil {
+		return c.SelectAttrValue("Algorithm", "")
+	}
+	return ""
+}
+
+func decodeDsigB64(el *etree.Element) ([]byte, error) {
+	if el == nil {
+		return nil, docx.NewInvalidXmlError("opc: signature is missing a base64 value")
+	}
+	text := strings.Join(strings.Fields(el.Text()), "")
+	b, err := base64.StdEncoding.DecodeString(text)
+	if err != nil {
+		return nil, docx.NewInvalidXmlError("opc: invalid base64 in <%s>: %v", el.Tag, err)
+	}
+	return b, nil
+}
+
+// findByID returns the element under root whose Id attribute is id.
+func findByID(root *etree.Element, id string) *etree.Element {
+	if root.SelectAttrValue("Id", "") == id {
+		return root
+	}
+	for _, c := range root.ChildElements() {
+		if found := findByID(c, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package opc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+
+	"github.com/user/go-docx/pkg/docx"
+)
+
+func selfSignedCert(t *testing.T, key crypto.Signer) *x509.Certificate {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "go-docx test signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatalf("CreateCertificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate: %v", err)
+	}
+	return cert
+}
+
+func signDefaultDocx(t *testing.T) []byte {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := OpenBytes(loadDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	if len(pkg.Signatures()) != 0 {
+		t.Fatal("default.docx should be unsigned")
+	}
+	if _, err := pkg.Sign(selfSignedCert(t, key), key); err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	data, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatalf("SaveToBytes: %v", err)
+	}
+	return data
+}
+
+func TestSignature_SignAndVerify(t *testing.T) {
+	pkg, err := OpenBytes(signDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	sigs := pkg.Signatures()
+	if len(sigs) != 1 {
+		t.Fatalf("Signatures() = %d, want 1", len(sigs))
+	}
+	if got := sigs[0].Part().ContentType(); got != CTOpcDigitalSignatureXmlsig {
+		t.Errorf("signature content type = %q", got)
+	}
+	info, err := sigs[0].Verify()
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if info.Certificate.Subject.CommonName != "go-docx test signer" {
+		t.Errorf("certificate = %v", info.Certificate.Subject)
+	}
+	if info.SigningTime.IsZero() || time.Since(info.SigningTime) > time.Hour {
+		t.Errorf("SigningTime = %v", info.SigningTime)
+	}
+	want := map[PackURI]bool{"/word/document.xml": true, "/_rels/.rels": true, "/word/_rels/document.xml.rels": true}
+	for _, pn := range info.SignedParts {
+		delete(want, pn)
+	}
+	if len(want) != 0 {
+		t.Errorf("SignedParts %v missing %v", info.SignedParts, want)
+	}
+	if !info.Complete() {
+		t.Errorf("unsigned content in a freshly signed package: %v, %v", info.UnsignedParts, info.UnsignedRelationships)
+	}
+}
+
+func TestSignature_ReportsUnsignedContent(t *testing.T) {
+	pkg, err := OpenBytes(signDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	doc, _ := pkg.PartByName("/word/document.xml")
+	added := NewBasePart("/word/media/image1.png", CTPng, []byte("png"), pkg)
+	pkg.AddPart(added)
+	rel := doc.Rels().GetOrAdd(RTImage, added)
+	extra := pkg.Rels().GetOrAdd(RTExtendedProperties+"#added", added)
+
+	info, err := pkg.Signatures()[0].Verify()
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if info.Complete() {
+		t.Error("Complete() = true after adding a part")
+	}
+	if !reflect.DeepEqual(info.UnsignedParts, []PackURI{"/word/media/image1.png"}) {
+		t.Errorf("UnsignedParts = %v", info.UnsignedParts)
+	}
+	want := map[PackURI][]string{
+		"/_rels/.rels":                  {extra.RID},
+		"/word/_rels/document.xml.rels": {rel.RID},
+	}
+	if !reflect.DeepEqual(info.UnsignedRelationships, want) {
+		t.Errorf("UnsignedRelationships = %v, want %v", info.UnsignedRelationships, want)
+	}
+}
+
+func TestSignature_ECDSA(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, _ := OpenBytes(loadDefaultDocx(t), nil)
+	sig, err := pkg.Sign(selfSignedCert(t, key), key)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if _, err := sig.Verify(); err != nil {
+		t.Errorf("Verify: %v", err)
+	}
+}
+
+func TestSignature_DetectsTampering(t *testing.T) {
+	signed := signDefaultDocx(t)
+	var ise *docx.InvalidSignatureError
+
+	t.Run("part content", func(t *testing.T) {
+		pkg, _ := OpenBytes(signed, nil)
+		part, _ := pkg.PartByName("/word/settings.xml")
+		part.(*BasePart).SetBlob(append(part.Blob(), ' '))
+		if _, err := pkg.Signatures()[0].Verify(); !errors.As(err, &ise) {
+			t.Errorf("error = %v, want *docx.InvalidSignatureError", err)
+		}
+	})
+	t.Run("relationship", func(t *testing.T) {
+		pkg, _ := OpenBytes(signed, nil)
+		doc, _ := pkg.PartByName("/word/document.xml")
+		doc.Rels().GetByRID("rId1").TargetRef = "elsewhere.xml"
+		if _, err := pkg.Signatures()[0].Verify(); !errors.As(err, &ise) {
+			t.Errorf("error = %v, want *docx.InvalidSignatureError", err)
+		}
+	})
+	t.Run("signature value", func(t *testing.T) {
+		pkg, _ := OpenBytes(signed, nil)
+		sig := pkg.Signatures()[0]
+		doc := etree.NewDocument()
+		if err := doc.ReadFromBytes(sig.Part().Blob()); err != nil {
+			t.Fatal(err)
+		}
+		ref := doc.FindElement("//SignedInfo/Reference")
+		ref.CreateAttr("URI", "#idPackageObject")
+		ref.CreateAttr("Id", "tampered")
+		blob, _ := doc.WriteToBytes()
+		sig.Part().(*BasePart).SetBlob(blob)
+		if _, err := sig.Verify(); !errors.As(err, &ise) {
+			t.Errorf("error = %v, want *docx.InvalidSignatureError", err)
+		}
+	})
+}
+
+func TestSignature_VerifyReportsReadErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "signed.docx")
+	if err := os.WriteFile(path, signDefaultDocx(t), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := OpenFile(path, nil, WithLazyLoading())
+	if err != nil {
+		t.Fatalf("OpenFile (lazy): %v", err)
+	}
+	sig := pkg.Signatures()[0]
+	if _, err := sig.Verify(); err != nil {
+		t.Fatalf("Verify while open: %v", err)
+	}
+	if err := pkg.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var ise *docx.InvalidSignatureError
+	if _, err := sig.Verify(); err == nil || errors.As(err, &ise) {
+		t.Errorf("error = %v, want the read error", err)
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	doc := etree.NewDocument()
+	err := doc.ReadFromString(`<a:root xmlns:a="urn:a" xmlns="urn:d"><a:child  z="1" a:b="&#9;x" y='"q"'>t&gt;<e/></a:child></a:root>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(canonicalize(doc.FindElement("//child")))
+	want := `<a:child xmlns="urn:d" xmlns:a="urn:a" y="&quot;q&quot;" z="1" a:b="&#x9;x">t&gt;<e></e></a:child>`
+	if got != want {
+		t.Errorf("canonicalize =\n  %s\nwant\n  %s", got, want)
+	}
+}
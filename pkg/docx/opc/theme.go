@@ -0,0 +1,139 @@
+package opc
+
+import (
+	"fmt"
+	"strings"
+)
+
+const themePartTmpl = "/word/theme/theme%d.xml"
+
+// ThemePart returns the theme part of the package. For a document this is
+// the part related from the main document part; for a .thmx theme package,
+// whose main part is the theme itself, it is that part.
+func (p *OpcPackage) ThemePart() (Part, error) {
+	main, err := p.MainDocumentPart()
+	if err != nil {
+		return p.RelatedPart(RTTheme)
+	}
+	if main.ContentType() == CTOfcTheme {
+		return main, nil
+	}
+	rel, err := main.Rels().GetByRelType(RTTheme)
+	if err != nil {
+		return nil, err
+	}
+	if rel.IsExternal || rel.TargetPart == nil {
+		return nil, fmt.Errorf("opc: theme relationship %s is external or unresolved", rel.RID)
+	}
+	return rel.TargetPart, nil
+}
+
+// ReplaceTheme replaces the theme of the main document with the theme of
+// src, which may be another document or a .thmx theme package. Parts the
+// source theme relates to, such as fill images, are copied along with it
+// and keep their relationship ids; external relationships are carried over
+// as they are, and internal ones whose target did not resolve are dropped.
+// The replacement keeps the partname of
+// the current theme, or gets a new one if the document has no theme yet.
+func (p *OpcPackage) ReplaceTheme(src *OpcPackage) error {
+	theme, err := src.ThemePart()
+	if err != nil {
+		return fmt.Errorf("opc: source package has no theme: %w", err)
+	}
+	main, err := p.MainDocumentPart()
+	if err != nil {
+		return err
+	}
+
+	var old Part
+	if rel, err := main.Rels().GetByRelType(RTTheme); err == nil && !rel.IsExternal {
+		old = rel.TargetPart
+	}
+	pn := p.NextPartname(themePartTmpl)
+	if old != nil {
+		pn = old.PartName()
+		// Free the old theme's partnames for reuse by the copies.
+		delete(p.parts, pn)
+		for _, rel := range old.Rels().All() {
+			if !rel.IsExternal && rel.TargetPart != nil && !p.isReferenced(rel.TargetPart, old) {
+				delete(p.parts, rel.TargetPart.PartName())
+			}
+		}
+	}
+
+	replacement := NewBasePart(pn, CTOfcTheme, theme.Blob(), p)
+	for _, rel := range theme.Rels().All() {
+		if rel.IsExternal {
+			replacement.Rels().Load(rel.RID, rel.RelType, rel.TargetRef, nil, true)
+			continue
+		}
+		if rel.TargetPart == nil {
+			continue
+		}
+		target := rel.TargetPart
+		copied := NewBasePart(p.uniquePartname(target.PartName()), target.ContentType(), target.Blob(), p)
+		p.AddPart(copied)
+		replacement.Rels().Load(rel.RID, rel.RelType, copied.PartName().RelativeRef(pn.BaseURI()), copied, false)
+	}
+	p.AddPart(replacement)
+
+	if old == nil {
+		main.Rels().GetOrAdd(RTTheme, replacement)
+		return nil
+	}
+	p.retarget(old, replacement)
+	return nil
+}
+
+// isReferenced reports whether part is the target of a relationship from
+// the package or from any part other than except.
+func (p *OpcPackage) isReferenced(part, except Part) bool {
+	for _, rel := range p.rels.All() {
+		if rel.TargetPart == part {
+			return true
+		}
+	}
+	for _, other := range p.parts {
+		if other == except {
+			continue
+		}
+		for _, rel := range other.Rels().All() {
+			if rel.TargetPart == part {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// retarget points every relationship in the package that targets old at
+// replacement instead.
+func (p *OpcPackage) retarget(old, replacement Part) {
+	rels := []*Relationships{p.rels}
+	for _, part := range p.parts {
+		rels = append(rels, part.Rels())
+	}
+	for _, rs := range rels {
+		for _, rel := range rs.All() {
+			if rel.TargetPart == old {
+				rel.TargetPart = replacement
+			}
+		}
+	}
+}
+
+// uniquePartname returns pn if no part of the package uses it, or else the
+// first free partname formed by numbering pn's stem, as in
+// "/word/media/image3.png" for a taken "/word/media/image1.png".
+func (p *OpcPackage) uniquePartname(pn PackURI) PackURI {
+	if _, taken := p.parts[pn]; !taken {
+		return pn
+	}
+	name, ext := string(pn), pn.Ext()
+	if ext != "" {
+		name = strings.TrimSuffix(name, "."+ext)
+		ext = "." + ext
+	}
+	name = strings.TrimRight(name, "0123456789")
+	return p.NextPartname(strings.ReplaceAll(name, "%", "%%") + "%d" + ext)
+}
@@ -0,0 +1,123 @@
+package opc
+
+import (
+	"strings"
+	"testing"
+)
+
+// newThmxPackage builds a .thmx theme package whose theme relates to an image.
+func newThmxPackage(t *testing.T) *OpcPackage {
+	t.Helper()
+	pkg := NewOpcPackage(nil)
+	theme := NewBasePart("/theme/theme/theme1.xml", CTOfcTheme, []byte(`<a:theme name="Brand"/>`), pkg)
+	image := NewBasePart("/theme/media/image1.png", CTPng, []byte("PNG"), pkg)
+	pkg.AddPart(theme)
+	pkg.AddPart(image)
+	theme.Rels().Load("rId3", RTImage, "../media/image1.png", image, false)
+	pkg.RelateTo(theme, RTOfficeDocument)
+	return pkg
+}
+
+func TestOpcPackage_ThemePart(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	theme, err := pkg.ThemePart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if theme.PartName() != "/word/theme/theme1.xml" || !strings.Contains(string(theme.Blob()), `name="Office Theme"`) {
+		t.Errorf("ThemePart() = %s", theme.PartName())
+	}
+	if theme, err := newThmxPackage(t).ThemePart(); err != nil || theme.PartName() != "/theme/theme/theme1.xml" {
+		t.Errorf(".thmx ThemePart() = %v, %v", theme, err)
+	}
+}
+
+func TestOpcPackage_ReplaceTheme(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := newThmxPackage(t)
+	if err := pkg.ReplaceTheme(src); err != nil {
+		t.Fatal(err)
+	}
+	nParts := len(pkg.Parts())
+	// Replacing again must not leave the first copy's image behind.
+	if err := pkg.ReplaceTheme(src); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(pkg.Parts()); got != nParts {
+		t.Errorf("part count after second replace = %d, want %d", got, nParts)
+	}
+
+	data, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err = OpenBytes(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	theme, err := pkg.ThemePart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if theme.PartName() != "/word/theme/theme1.xml" || string(theme.Blob()) != `<a:theme name="Brand"/>` {
+		t.Errorf("theme = %s %q", theme.PartName(), theme.Blob())
+	}
+	rel := theme.Rels().GetByRID("rId3")
+	if rel == nil || rel.RelType != RTImage || string(rel.TargetPart.Blob()) != "PNG" {
+		t.Fatalf("theme image relationship = %+v", rel)
+	}
+	if rel.TargetPart.PartName() != "/theme/media/image1.png" {
+		t.Errorf("image partname = %s", rel.TargetPart.PartName())
+	}
+}
+
+func TestOpcPackage_ReplaceThemeAddsTheme(t *testing.T) {
+	pkg := NewOpcPackage(nil)
+	doc := NewBasePart("/word/document.xml", CTWmlDocumentMain, nil, pkg)
+	pkg.AddPart(doc)
+	pkg.RelateTo(doc, RTOfficeDocument)
+	pkg.AddPart(NewBasePart("/theme/media/image1.png", CTPng, nil, pkg))
+	if err := pkg.ReplaceTheme(newThmxPackage(t)); err != nil {
+		t.Fatal(err)
+	}
+	theme, err := pkg.ThemePart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if theme.PartName() != "/word/theme/theme1.xml" {
+		t.Errorf("new theme partname = %s", theme.PartName())
+	}
+	if got := theme.Rels().GetByRID("rId3").TargetPart.PartName(); got != "/theme/media/image2.png" {
+		t.Errorf("copied image partname = %s, want a free name", got)
+	}
+	if err := pkg.ReplaceTheme(NewOpcPackage(nil)); err == nil {
+		t.Error("expected an error for a source without a theme")
+	}
+}
+
+func TestOpcPackage_ReplaceThemeRelationships(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := newThmxPackage(t)
+	theme, _ := src.ThemePart()
+	theme.Rels().Load("rId4", RTHyperlink, "https://example.com/brand", nil, true)
+	theme.Rels().Load("rId5", RTImage, "../media/missing.png", nil, false)
+	if err := pkg.ReplaceTheme(src); err != nil {
+		t.Fatal(err)
+	}
+	theme, _ = pkg.ThemePart()
+	if rel := theme.Rels().GetByRID("rId4"); rel == nil || !rel.IsExternal || rel.TargetRef != "https://example.com/brand" {
+		t.Errorf("external relationship = %+v", rel)
+	}
+	if rel := theme.Rels().GetByRID("rId5"); rel != nil {
+		t.Errorf("unresolved internal relationship carried over as %+v", rel)
+	}
+}
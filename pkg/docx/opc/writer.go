@@ -3,14 +3,24 @@ package opc
 import (
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 )
 
 // PackageWriter writes an OPC package to a ZIP stream.
-type PackageWriter struct{}
+type PackageWriter struct {
+	// deterministic selects byte-reproducible output; see
+	// WithDeterministicOutput.
+	deterministic bool
+}
 
 // Write serializes the package relationships and parts to the writer.
 func (pw *PackageWriter) Write(w io.Writer, pkgRels *Relationships, parts []Part) error {
 	physWriter := NewPhysPkgWriter(w)
+	if pw.deterministic {
+		physWriter.setDeterministic()
+		parts = canonicalPartOrder(parts)
+	}
 
 	// 1. Write [Content_Types].xml
 	if err := pw.writeContentTypes(physWriter, parts); err != nil {
@@ -24,7 +34,7 @@ func (pw *PackageWriter) Write(w io.Writer, pkgRels *Relationships, parts []Part
 
 	// 3. Write each part's blob and its .rels (if any)
 	for _, part := range parts {
-		if err := physWriter.Write(part.PartName(), part.Blob()); err != nil {
+		if err := pw.writePart(physWriter, part); err != nil {
 			return fmt.Errorf("opc: writing part %q: %w", part.PartName(), err)
 		}
 		if part.Rels() != nil && part.Rels().Len() > 0 {
@@ -37,6 +47,23 @@ func (pw *PackageWriter) Write(w io.Writer, pkgRels *Relationships, parts []Part
 	return physWriter.Close()
 }
 
+// rawSourced is implemented by parts (via BasePart) that may still be backed
+// by an unmodified member of the archive they were lazily read from.
+type rawSourced interface {
+	rawSource() *zipMember
+}
+
+// writePart writes the part content, copying it raw from its source archive
+// when it was lazily loaded and never replaced.
+func (pw *PackageWriter) writePart(physWriter *PhysPkgWriter, part Part) error {
+	if rs, ok := part.(rawSourced); ok && !pw.deterministic {
+		if m := rs.rawSource(); m != nil {
+			return physWriter.writeRaw(part.PartName(), m)
+		}
+	}
+	return physWriter.Write(part.PartName(), part.Blob())
+}
+
 func (pw *PackageWriter) writeContentTypes(physWriter *PhysPkgWriter, parts []Part) error {
 	var infos []PartInfo
 	for _, p := range parts {
@@ -53,10 +80,58 @@ func (pw *PackageWriter) writeContentTypes(physWriter *PhysPkgWriter, parts []Pa
 }
 
 func (pw *PackageWriter) writeRels(physWriter *PhysPkgWriter, sourceURI PackURI, rels *Relationships) error {
-	blob, err := SerializeRelationships(rels)
+	all := rels.All()
+	if pw.deterministic {
+		all = sortedByRID(all)
+	}
+	blob, err := serializeRelationships(all)
 	if err != nil {
 		return fmt.Errorf("opc: serializing rels for %q: %w", sourceURI, err)
 	}
 	relsURI := sourceURI.RelsURI()
 	return physWriter.Write(relsURI, blob)
 }
+
+// canonicalPartOrder returns parts in the member order used for
+// deterministic output: the main document part, the rest of /word, other
+// parts, then /docProps; by partname within each group.
+func canonicalPartOrder(parts []Part) []Part {
+	rank := func(p Part) int {
+		pn := string(p.PartName())
+		switch {
+		case p.ContentType() == CTWmlDocumentMain:
+			return 0
+		case strings.HasPrefix(pn, "/word/"):
+			return 1
+		case strings.HasPrefix(pn, "/docProps/"):
+			return 3
+		default:
+			return 2
+		}
+	}
+	sorted := make([]Part, len(parts))
+	copy(sorted, parts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ri, rj := rank(sorted[i]), rank(sorted[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return sorted[i].PartName() < sorted[j].PartName()
+	})
+	return sorted
+}
+
+// sortedByRID returns rels ordered by the number in their rId, falling back
+// to string order for rIds not of the form "rIdN".
+func sortedByRID(rels []*Relationship) []*Relationship {
+	sorted := make([]*Relationship, len(rels))
+	copy(sorted, rels)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ni, nj := parseRIdNum(sorted[i].RID), parseRIdNum(sorted[j].RID)
+		if ni != nj {
+			return ni < nj
+		}
+		return sorted[i].RID < sorted[j].RID
+	})
+	return sorted
+}
@@ -0,0 +1,108 @@
+package opc
+
+import (
+	"archive/zip"
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOpcPackage_PartsSorted(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	parts := pkg.Parts()
+	for i := 1; i < len(parts); i++ {
+		if parts[i-1].PartName() >= parts[i].PartName() {
+			t.Fatalf("Parts() not sorted: %q before %q", parts[i-1].PartName(), parts[i].PartName())
+		}
+	}
+}
+
+func TestSave_Deterministic(t *testing.T) {
+	data := loadDefaultDocx(t)
+	var outputs [][]byte
+	for _, opts := range [][]OpenOption{nil, nil, {WithLazyLoading()}} {
+		pkg, err := OpenBytes(data, nil, opts...)
+		if err != nil {
+			t.Fatalf("OpenBytes: %v", err)
+		}
+		out, err := pkg.SaveToBytes(WithDeterministicOutput())
+		if err != nil {
+			t.Fatalf("SaveToBytes: %v", err)
+		}
+		pkg.Close()
+		outputs = append(outputs, out)
+	}
+	for i := 1; i < len(outputs); i++ {
+		if !bytes.Equal(outputs[0], outputs[i]) {
+			t.Errorf("output %d differs from output 0", i)
+		}
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(outputs[0]), int64(len(outputs[0])))
+	if err != nil {
+		t.Fatalf("reading saved zip: %v", err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+		if !f.Modified.Equal(deterministicModTime) {
+			t.Errorf("%s modified = %v, want %v", f.Name, f.Modified, deterministicModTime)
+		}
+	}
+	wantPrefix := []string{"[Content_Types].xml", "_rels/.rels", "word/document.xml", "word/_rels/document.xml.rels"}
+	if len(names) < len(wantPrefix) || strings.Join(names[:4], ",") != strings.Join(wantPrefix, ",") {
+		t.Errorf("member order starts %v, want %v", names, wantPrefix)
+	}
+	if last := names[len(names)-1]; !strings.HasPrefix(last, "docProps/") {
+		t.Errorf("last member = %q, want a docProps member", last)
+	}
+
+	for _, f := range zr.File {
+		if f.Name != "word/_rels/document.xml.rels" {
+			continue
+		}
+		rc, _ := f.Open()
+		var buf bytes.Buffer
+		buf.ReadFrom(rc)
+		rc.Close()
+		ids := regexp.MustCompile(`Id="(rId\d+)"`).FindAllStringSubmatch(buf.String(), -1)
+		var got []string
+		for _, m := range ids {
+			got = append(got, m[1])
+		}
+		if want := "rId1,rId2,rId3,rId4,rId5,rId6,rId7,rId8"; strings.Join(got, ",") != want {
+			t.Errorf("rels order = %v, want %s", got, want)
+		}
+	}
+}
+
+func TestSave_NonDeterministicTimestamps(t *testing.T) {
+	pkg, err := OpenBytes(loadDefaultDocx(t), nil)
+	if err != nil {
+		t.Fatalf("OpenBytes: %v", err)
+	}
+	before := time.Now().Add(-time.Minute)
+	out, err := pkg.SaveToBytes()
+	if err != nil {
+		t.Fatalf("SaveToBytes: %v", err)
+	}
+	after := time.Now().Add(time.Minute)
+
+	zr, err := zip.NewReader(bytes.NewReader(out), int64(len(out)))
+	if err != nil {
+		t.Fatalf("reading saved zip: %v", err)
+	}
+	for _, f := range zr.File {
+		// ZIP timestamps carry no zone, so compare the wall clock.
+		got := time.Date(f.Modified.Year(), f.Modified.Month(), f.Modified.Day(),
+			f.Modified.Hour(), f.Modified.Minute(), f.Modified.Second(), 0, time.Local)
+		if f.ModifiedDate == 0 || got.Before(before) || got.After(after) {
+			t.Errorf("%s modified = %v (DOS date %#x), want the time of saving", f.Name, f.Modified, f.ModifiedDate)
+		}
+	}
+}
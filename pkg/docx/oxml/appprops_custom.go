@@ -0,0 +1,352 @@
+package oxml
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/beevik/etree"
+)
+
+// ===========================================================================
+// CT_ExtendedProperties — custom methods
+// ===========================================================================
+
+// NewExtendedProperties creates a new empty <ep:Properties> element.
+func NewExtendedProperties() *CT_ExtendedProperties {
+	xml := `<ep:Properties ` +
+		`xmlns:ep="http://schemas.openxmlformats.org/officeDocument/2006/extended-properties" ` +
+		`xmlns:vt="http://schemas.openxmlformats.org/officeDocument/2006/docPropsVTypes"/>`
+	el, err := ParseXml([]byte(xml))
+	if err != nil {
+		panic(fmt.Sprintf("appprops_custom: failed to parse Properties XML: %v", err))
+	}
+	return &CT_ExtendedProperties{Element{E: el}}
+}
+
+// ParseExtendedProperties parses the XML of a docProps/app.xml part.
+//
+// Word writes this part with an unprefixed default namespace; those elements
+// are rewritten to the "ep" prefix so the generated accessors can find them.
+func ParseExtendedProperties(blob []byte) (*CT_ExtendedProperties, error) {
+	el, err := ParseXml(blob)
+	if err != nil {
+		return nil, err
+	}
+	normalizeDefaultNamespace(el, "ep")
+	if el.Space != "ep" || el.Tag != "Properties" {
+		return nil, fmt.Errorf("oxml: expected <Properties> root in extended properties, got <%s>", el.FullTag())
+	}
+	return &CT_ExtendedProperties{Element{E: el}}, nil
+}
+
+// --- Text property helpers ---
+
+// textOf returns the text content of a child element returned by a generated
+// accessor, or "" if the element is absent.
+func (ep *CT_ExtendedProperties) textOf(el *CT_ExtPropText) string {
+	if el == nil {
+		return ""
+	}
+	return el.E.Text()
+}
+
+// intOf returns the integer content of a child element, or 0 if the element is
+// absent or does not hold a valid integer.
+func (ep *CT_ExtendedProperties) intOf(el *CT_ExtPropText) int {
+	if el == nil {
+		return 0
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(el.E.Text()))
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// setText sets the text of a child element via get-or-add.
+func (ep *CT_ExtendedProperties) setText(getOrAdd func() *CT_ExtPropText, value string) {
+	getOrAdd().E.SetText(value)
+}
+
+// setInt sets the integer content of a child element via get-or-add.
+// Negative values are rejected since every integer property is a count or a
+// duration.
+func (ep *CT_ExtendedProperties) setInt(getOrAdd func() *CT_ExtPropText, value int) error {
+	if value < 0 {
+		return fmt.Errorf("extended property requires non-negative int, got %d", value)
+	}
+	getOrAdd().E.SetText(strconv.Itoa(value))
+	return nil
+}
+
+// --- Text properties ---
+
+// ApplicationText returns the name of the producing application (Application) or "".
+func (ep *CT_ExtendedProperties) ApplicationText() string {
+	return ep.textOf(ep.Application())
+}
+
+// SetApplicationText sets the name of the producing application (Application).
+func (ep *CT_ExtendedProperties) SetApplicationText(v string) {
+	ep.setText(ep.GetOrAddApplication, v)
+}
+
+// AppVersionText returns the application version (AppVersion), e.g. "16.0000", or "".
+func (ep *CT_ExtendedProperties) AppVersionText() string {
+	return ep.textOf(ep.AppVersion())
+}
+
+// SetAppVersionText sets the application version (AppVersion). Word expects
+// the "XX.YYYY" form.
+func (ep *CT_ExtendedProperties) SetAppVersionText(v string) {
+	ep.setText(ep.GetOrAddAppVersion, v)
+}
+
+// CompanyText returns the company name (Company) or "".
+func (ep *CT_ExtendedProperties) CompanyText() string {
+	return ep.textOf(ep.Company())
+}
+
+// SetCompanyText sets the company name (Company).
+func (ep *CT_ExtendedProperties) SetCompanyText(v string) {
+	ep.setText(ep.GetOrAddCompany, v)
+}
+
+// ManagerText returns the manager name (Manager) or "".
+func (ep *CT_ExtendedProperties) ManagerText() string {
+	return ep.textOf(ep.Manager())
+}
+
+// SetManagerText sets the manager name (Manager).
+func (ep *CT_ExtendedProperties) SetManagerText(v string) {
+	ep.setText(ep.GetOrAddManager, v)
+}
+
+// TemplateText returns the name of the attached template (Template), e.g.
+// "Normal.dotm", or "".
+func (ep *CT_ExtendedProperties) TemplateText() string {
+	return ep.textOf(ep.Template())
+}
+
+// SetTemplateText sets the name of the attached template (Template).
+func (ep *CT_ExtendedProperties) SetTemplateText(v string) {
+	ep.setText(ep.GetOrAddTemplate, v)
+}
+
+// --- Integer properties ---
+
+// TotalTimeVal returns the total editing time in minutes (TotalTime), or 0.
+func (ep *CT_ExtendedProperties) TotalTimeVal() int {
+	return ep.intOf(ep.TotalTime())
+}
+
+// SetTotalTimeVal sets the total editing time in minutes (TotalTime).
+func (ep *CT_ExtendedProperties) SetTotalTimeVal(v int) error {
+	return ep.setInt(ep.GetOrAddTotalTime, v)
+}
+
+// PagesVal returns the page count (Pages), or 0.
+func (ep *CT_ExtendedProperties) PagesVal() int {
+	return ep.intOf(ep.Pages())
+}
+
+// SetPagesVal sets the page count (Pages).
+func (ep *CT_ExtendedProperties) SetPagesVal(v int) error {
+	return ep.setInt(ep.GetOrAddPages, v)
+}
+
+// WordsVal returns the word count (Words), or 0.
+func (ep *CT_ExtendedProperties) WordsVal() int {
+	return ep.intOf(ep.Words())
+}
+
+// SetWordsVal sets the word count (Words).
+func (ep *CT_ExtendedProperties) SetWordsVal(v int) error {
+	return ep.setInt(ep.GetOrAddWords, v)
+}
+
+// CharactersVal returns the character count excluding spaces (Characters), or 0.
+func (ep *CT_ExtendedProperties) CharactersVal() int {
+	return ep.intOf(ep.Characters())
+}
+
+// SetCharactersVal sets the character count excluding spaces (Characters).
+func (ep *CT_ExtendedProperties) SetCharactersVal(v int) error {
+	return ep.setInt(ep.GetOrAddCharacters, v)
+}
+
+// CharactersWithSpacesVal returns the character count including spaces
+// (CharactersWithSpaces), or 0.
+func (ep *CT_ExtendedProperties) CharactersWithSpacesVal() int {
+	return ep.intOf(ep.CharactersWithSpaces())
+}
+
+// SetCharactersWithSpacesVal sets the character count including spaces
+// (CharactersWithSpaces).
+func (ep *CT_ExtendedProperties) SetCharactersWithSpacesVal(v int) error {
+	return ep.setInt(ep.GetOrAddCharactersWithSpaces, v)
+}
+
+// LinesVal returns the line count (Lines), or 0.
+func (ep *CT_ExtendedProperties) LinesVal() int {
+	return ep.intOf(ep.Lines())
+}
+
+// SetLinesVal sets the line count (Lines).
+func (ep *CT_ExtendedProperties) SetLinesVal(v int) error {
+	return ep.setInt(ep.GetOrAddLines, v)
+}
+
+// ParagraphsVal returns the paragraph count (Paragraphs), or 0.
+func (ep *CT_ExtendedProperties) ParagraphsVal() int {
+	return ep.intOf(ep.Paragraphs())
+}
+
+// SetParagraphsVal sets the paragraph count (Paragraphs).
+func (ep *CT_ExtendedProperties) SetParagraphsVal(v int) error {
+	return ep.setInt(ep.GetOrAddParagraphs, v)
+}
+
+// --- HeadingPairs / TitlesOfParts ---
+
+// HeadingPair is one entry of the HeadingPairs vector: a group name such as
+// "Title" or "Headings" and the number of TitlesOfParts entries it covers.
+type HeadingPair struct {
+	Name  string
+	Count int
+}
+
+// HeadingPairsList returns the (name, count) pairs stored in HeadingPairs, or
+// nil if the element is absent.
+func (ep *CT_ExtendedProperties) HeadingPairsList() []HeadingPair {
+	hp := ep.HeadingPairs()
+	if hp == nil || hp.Vector() == nil {
+		return nil
+	}
+	variants := hp.Vector().VariantList()
+	var result []HeadingPair
+	for i := 0; i+1 < len(variants); i += 2 {
+		pair := HeadingPair{}
+		if s := variants[i].Lpstr(); s != nil {
+			pair.Name = s.E.Text()
+		}
+		if n := variants[i+1].I4(); n != nil {
+			pair.Count = parseIntAttr(n.E.Text())
+		}
+		result = append(result, pair)
+	}
+	return result
+}
+
+// SetHeadingPairsList replaces HeadingPairs with the given pairs. Passing an
+// empty slice removes the element.
+func (ep *CT_ExtendedProperties) SetHeadingPairsList(pairs []HeadingPair) {
+	ep.RemoveHeadingPairs()
+	if len(pairs) == 0 {
+		return
+	}
+	vec := ep.GetOrAddHeadingPairs().GetOrAddVector()
+	vec.SetSize(2 * len(pairs))
+	vec.SetBaseType("variant")
+	for _, pair := range pairs {
+		vec.AddVariant().GetOrAddLpstr().E.SetText(pair.Name)
+		vec.AddVariant().GetOrAddI4().E.SetText(strconv.Itoa(pair.Count))
+	}
+}
+
+// TitlesOfPartsList returns the part titles stored in TitlesOfParts, or nil if
+// the element is absent.
+func (ep *CT_ExtendedProperties) TitlesOfPartsList() []string {
+	tp := ep.TitlesOfParts()
+	if tp == nil || tp.Vector() == nil {
+		return nil
+	}
+	var result []string
+	for _, s := range tp.Vector().LpstrList() {
+		result = append(result, s.E.Text())
+	}
+	return result
+}
+
+// SetTitlesOfPartsList replaces TitlesOfParts with the given titles. Passing an
+// empty slice removes the element.
+func (ep *CT_ExtendedProperties) SetTitlesOfPartsList(titles []string) {
+	ep.RemoveTitlesOfParts()
+	if len(titles) == 0 {
+		return
+	}
+	vec := ep.GetOrAddTitlesOfParts().GetOrAddVector()
+	vec.SetSize(len(titles))
+	vec.SetBaseType("lpstr")
+	for _, title := range titles {
+		vec.AddLpstr().E.SetText(title)
+	}
+}
+
+// --- Statistics ---
+
+// DocumentStatistics holds the text statistics Word stores in the extended
+// properties part.
+type DocumentStatistics struct {
+	Words                int
+	Characters           int // excluding whitespace
+	CharactersWithSpaces int
+	Paragraphs           int // non-empty paragraphs only
+}
+
+// ComputeStatistics counts words, characters and paragraphs in the body text,
+// including paragraphs nested in tables and content controls. Paragraph marks
+// are not counted as characters and empty paragraphs are not counted, which
+// matches the numbers Word reports in its Word Count dialog.
+func ComputeStatistics(body *CT_Body) DocumentStatistics {
+	var stats DocumentStatistics
+	if body == nil {
+		return stats
+	}
+	for _, p := range descendantParagraphs(body.E) {
+		text := p.ParagraphText()
+		if text == "" {
+			continue
+		}
+		stats.Paragraphs++
+		stats.Words += len(strings.FieldsFunc(text, unicode.IsSpace))
+		stats.CharactersWithSpaces += utf8.RuneCountInString(text)
+		for _, r := range text {
+			if !unicode.IsSpace(r) {
+				stats.Characters++
+			}
+		}
+	}
+	return stats
+}
+
+// RecomputeStatistics recalculates Words, Characters, CharactersWithSpaces and
+// Paragraphs from the body text so saved files don't carry stale counts.
+// Layout-dependent values (Pages, Lines) cannot be derived without rendering
+// and are left untouched.
+func (ep *CT_ExtendedProperties) RecomputeStatistics(body *CT_Body) DocumentStatistics {
+	stats := ComputeStatistics(body)
+	// setInt only fails on negative input, which counts never are.
+	_ = ep.SetWordsVal(stats.Words)
+	_ = ep.SetCharactersVal(stats.Characters)
+	_ = ep.SetCharactersWithSpacesVal(stats.CharactersWithSpaces)
+	_ = ep.SetParagraphsVal(stats.Paragraphs)
+	return stats
+}
+
+// descendantParagraphs returns all <w:p> descendants of e in document order,
+// without descending into the paragraphs themselves.
+func descendantParagraphs(e *etree.Element) []*CT_P {
+	var result []*CT_P
+	for _, child := range e.ChildElements() {
+		if child.Space == "w" && child.Tag == "p" {
+			result = append(result, &CT_P{Element{E: child}})
+			continue
+		}
+		result = append(result, descendantParagraphs(child)...)
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+package oxml
+
+import (
+	"strings"
+	"testing"
+)
+
+const testAppXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Properties xmlns="http://schemas.openxmlformats.org/officeDocument/2006/extended-properties" xmlns:vt="http://schemas.openxmlformats.org/officeDocument/2006/docPropsVTypes">
+  <Template>Normal.dotm</Template>
+  <TotalTime>12</TotalTime>
+  <Pages>3</Pages>
+  <Words>42</Words>
+  <Application>Microsoft Office Word</Application>
+  <HeadingPairs>
+    <vt:vector size="2" baseType="variant">
+      <vt:variant><vt:lpstr>Title</vt:lpstr></vt:variant>
+      <vt:variant><vt:i4>1</vt:i4></vt:variant>
+    </vt:vector>
+  </HeadingPairs>
+  <TitlesOfParts>
+    <vt:vector size="1" baseType="lpstr"><vt:lpstr>Report</vt:lpstr></vt:vector>
+  </TitlesOfParts>
+  <Company>Acme</Company>
+  <AppVersion>16.0000</AppVersion>
+</Properties>`
+
+func TestParseExtendedProperties_DefaultNamespace(t *testing.T) {
+	ep, err := ParseExtendedProperties([]byte(testAppXml))
+	if err != nil {
+		t.Fatalf("ParseExtendedProperties: %v", err)
+	}
+	if got := ep.TemplateText(); got != "Normal.dotm" {
+		t.Errorf("TemplateText() = %q, want %q", got, "Normal.dotm")
+	}
+	if got := ep.ApplicationText(); got != "Microsoft Office Word" {
+		t.Errorf("ApplicationText() = %q", got)
+	}
+	if got := ep.CompanyText(); got != "Acme" {
+		t.Errorf("CompanyText() = %q, want %q", got, "Acme")
+	}
+	if got := ep.AppVersionText(); got != "16.0000" {
+		t.Errorf("AppVersionText() = %q", got)
+	}
+	if got := ep.TotalTimeVal(); got != 12 {
+		t.Errorf("TotalTimeVal() = %d, want 12", got)
+	}
+	if got := ep.PagesVal(); got != 3 {
+		t.Errorf("PagesVal() = %d, want 3", got)
+	}
+	if got := ep.WordsVal(); got != 42 {
+		t.Errorf("WordsVal() = %d, want 42", got)
+	}
+	if got := ep.ManagerText(); got != "" {
+		t.Errorf("ManagerText() = %q, want empty", got)
+	}
+
+	pairs := ep.HeadingPairsList()
+	if len(pairs) != 1 || pairs[0] != (HeadingPair{Name: "Title", Count: 1}) {
+		t.Errorf("HeadingPairsList() = %+v", pairs)
+	}
+	titles := ep.TitlesOfPartsList()
+	if len(titles) != 1 || titles[0] != "Report" {
+		t.Errorf("TitlesOfPartsList() = %v", titles)
+	}
+}
+
+func TestParseExtendedProperties_RejectsOtherRoot(t *testing.T) {
+	_, err := ParseExtendedProperties([]byte(`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"/>`))
+	if err == nil {
+		t.Fatal("expected error for non-Properties root")
+	}
+}
+
+func TestExtendedProperties_SettersKeepSequence(t *testing.T) {
+	ep := NewExtendedProperties()
+	ep.SetAppVersionText("16.0000")
+	ep.SetCompanyText("Acme")
+	ep.SetTemplateText("Normal.dotm")
+	if err := ep.SetPagesVal(2); err != nil {
+		t.Fatalf("SetPagesVal: %v", err)
+	}
+	if err := ep.SetLinesVal(-1); err == nil {
+		t.Error("expected error for negative Lines")
+	}
+
+	var order []string
+	for _, child := range ep.E.ChildElements() {
+		order = append(order, child.Tag)
+	}
+	want := "Template,Company,Pages,AppVersion"
+	if got := strings.Join(order, ","); got != want {
+		t.Errorf("child order = %s, want %s", got, want)
+	}
+}
+
+func TestExtendedProperties_HeadingPairsRoundTrip(t *testing.T) {
+	ep := NewExtendedProperties()
+	ep.SetHeadingPairsList([]HeadingPair{{"Title", 1}, {"Headings", 2}})
+	ep.SetTitlesOfPartsList([]string{"Doc", "Intro", "Summary"})
+
+	pairs := ep.HeadingPairsList()
+	if len(pairs) != 2 || pairs[1] != (HeadingPair{Name: "Headings", Count: 2}) {
+		t.Errorf("HeadingPairsList() = %+v", pairs)
+	}
+	size, err := ep.HeadingPairs().Vector().Size()
+	if err != nil || size != 4 {
+		t.Errorf("HeadingPairs vector size = %d (%v), want 4", size, err)
+	}
+	if got := strings.Join(ep.TitlesOfPartsList(), "|"); got != "Doc|Intro|Summary" {
+		t.Errorf("TitlesOfPartsList() = %s", got)
+	}
+
+	ep.SetTitlesOfPartsList(nil)
+	if ep.TitlesOfParts() != nil {
+		t.Error("expected TitlesOfParts to be removed")
+	}
+}
+
+func TestComputeStatistics(t *testing.T) {
+	xml := `<w:body xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:p><w:r><w:t xml:space="preserve">Hello brave  world</w:t></w:r></w:p>` +
+		`<w:p/>` +
+		`<w:tbl><w:tr><w:tc><w:p><w:r><w:t>Cell</w:t><w:tab/><w:t>text</w:t></w:r></w:p></w:tc></w:tr></w:tbl>` +
+		`<w:sectPr/>` +
+		`</w:body>`
+	el, err := ParseXml([]byte(xml))
+	if err != nil {
+		t.Fatalf("ParseXml: %v", err)
+	}
+	body := &CT_Body{Element{E: el}}
+
+	ep := NewExtendedProperties()
+	if err := ep.SetWordsVal(999); err != nil {
+		t.Fatal(err)
+	}
+	stats := ep.RecomputeStatistics(body)
+
+	want := DocumentStatistics{Words: 5, Characters: 23, CharactersWithSpaces: 27, Paragraphs: 2}
+	if stats != want {
+		t.Errorf("RecomputeStatistics() = %+v, want %+v", stats, want)
+	}
+	if got := ep.WordsVal(); got != 5 {
+		t.Errorf("WordsVal() after recompute = %d, want 5", got)
+	}
+	if got := ep.ParagraphsVal(); got != 2 {
+		t.Errorf("ParagraphsVal() after recompute = %d, want 2", got)
+	}
+}
@@ -1,8 +1,13 @@
 package oxml
 
 import (
+	"fmt"
+	"math"
+	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/user/go-docx/pkg/docx"
 )
 
 // --- Attribute conversion helpers used by generated code ---
@@ -75,3 +80,219 @@ func parseOptionalEnum[T any](s string, fromXml func(string) (T, error)) *T {
 	}
 	return &v
 }
+
+// --- Measurement and other typed attribute helpers ---
+//
+// Each parseX function accepts the lexical forms the spec allows for the
+// corresponding simple type and returns an error for anything else; the
+// generated accessors go through parseXAttr, which yields the zero value
+// instead. formatX writes the value back in the type's native unit.
+
+// EMUs per unit of the integer forms of the measurement types.
+const (
+	emusPerHalfPoint   = docx.EmusPerPt / 2
+	emusPerEighthPoint = float64(docx.EmusPerPt) / 8
+)
+
+// universalMeasureUnits maps the units of ST_UniversalMeasure to EMUs.
+var universalMeasureUnits = map[string]float64{
+	"mm": docx.EmusPerMm,
+	"cm": docx.EmusPerCm,
+	"in": docx.EmusPerInch,
+	"pt": docx.EmusPerPt,
+	"pc": 12 * docx.EmusPerPt,
+	"pi": 12 * docx.EmusPerPt,
+}
+
+// The lexical forms of the measurement types. ParseFloat alone would also
+// take "NaN", "Inf", exponents and hex floats.
+var (
+	integerPattern          = regexp.MustCompile(`^-?[0-9]+$`)
+	universalMeasurePattern = regexp.MustCompile(`^(-?[0-9]+(?:\.[0-9]+)?)(mm|cm|in|pt|pc|pi)$`)
+	percentPattern          = regexp.MustCompile(`^-?[0-9]+(?:\.[0-9]+)?%$`)
+)
+
+// toLength converts v EMUs to a Length, rejecting values out of its range.
+func toLength(v float64) (docx.Length, bool) {
+	v = math.Round(v)
+	if v < math.MinInt64 || v >= math.MaxInt64 {
+		return 0, false
+	}
+	return docx.Length(v), true
+}
+
+// parseUniversalMeasure parses an ST_UniversalMeasure value such as "1in",
+// "2.5cm" or "-12pt".
+func parseUniversalMeasure(s string) (docx.Length, error) {
+	s = strings.TrimSpace(s)
+	if m := universalMeasurePattern.FindStringSubmatch(s); m != nil {
+		if v, err := strconv.ParseFloat(m[1], 64); err == nil {
+			if l, ok := toLength(v * universalMeasureUnits[m[2]]); ok {
+				return l, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%q is not a universal measure", s)
+}
+
+// parseMeasure parses an integer number of units of emusPerUnit EMUs each,
+// or a universal measure.
+func parseMeasure(s string, emusPerUnit float64) (docx.Length, error) {
+	s = strings.TrimSpace(s)
+	if integerPattern.MatchString(s) {
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			if l, ok := toLength(float64(v) * emusPerUnit); ok {
+				return l, nil
+			}
+		}
+	} else if l, err := parseUniversalMeasure(s); err == nil {
+		return l, nil
+	}
+	return 0, fmt.Errorf("%q is not a measurement", s)
+}
+
+// formatMeasure formats l as a whole number of emusPerUnit units.
+func formatMeasure(l docx.Length, emusPerUnit float64) string {
+	return strconv.FormatInt(int64(math.Round(float64(l)/emusPerUnit)), 10)
+}
+
+// parseTwips parses an ST_TwipsMeasure or ST_SignedTwipsMeasure value.
+func parseTwips(s string) (docx.Length, error) { return parseMeasure(s, docx.EmusPerTwip) }
+
+// parseHalfPoints parses an ST_HpsMeasure or ST_SignedHpsMeasure value.
+func parseHalfPoints(s string) (docx.Length, error) { return parseMeasure(s, emusPerHalfPoint) }
+
+// parseEighthPoints parses an ST_EighthPointMeasure value.
+func parseEighthPoints(s string) (docx.Length, error) { return parseMeasure(s, emusPerEighthPoint) }
+
+// parseEmu parses an ST_Coordinate value.
+func parseEmu(s string) (docx.Length, error) { return parseMeasure(s, 1) }
+
+func parseTwipsAttr(s string) docx.Length        { return valueOrZero(parseTwips(s)) }
+func parseHalfPointsAttr(s string) docx.Length   { return valueOrZero(parseHalfPoints(s)) }
+func parseEighthPointsAttr(s string) docx.Length { return valueOrZero(parseEighthPoints(s)) }
+func parseEmuAttr(s string) docx.Length          { return valueOrZero(parseEmu(s)) }
+func parseUniversalMeasureAttr(s string) docx.Length {
+	return valueOrZero(parseUniversalMeasure(s))
+}
+
+func formatTwipsAttr(v docx.Length) string        { return formatMeasure(v, docx.EmusPerTwip) }
+func formatHalfPointsAttr(v docx.Length) string   { return formatMeasure(v, emusPerHalfPoint) }
+func formatEighthPointsAttr(v docx.Length) string { return formatMeasure(v, emusPerEighthPoint) }
+func formatEmuAttr(v docx.Length) string          { return formatMeasure(v, 1) }
+
+// formatUniversalMeasureAttr formats v in points, e.g. "10.5pt".
+func formatUniversalMeasureAttr(v docx.Length) string {
+	return strconv.FormatFloat(v.Pt(), 'f', -1, 64) + "pt"
+}
+
+// parsePercent parses a percentage that is either a number followed by "%"
+// or an integer in units of 1/perPercent of a percent.
+func parsePercent(s string, perPercent float64) (float64, error) {
+	s = strings.TrimSpace(s)
+	if percentPattern.MatchString(s) {
+		if v, err := strconv.ParseFloat(s[:len(s)-1], 64); err == nil {
+			return v, nil
+		}
+	} else if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return float64(v) / perPercent, nil
+	}
+	return 0, fmt.Errorf("%q is not a percentage", s)
+}
+
+// parseDrawingPct parses a DrawingML ST_Percentage value, whose integer form
+// is in thousandths of a percent, so that "50%" and "50000" are both 50.
+func parseDrawingPct(s string) (float64, error) { return parsePercent(s, 1000) }
+
+// parseWmlPct parses the percentage form of a WordprocessingML value such
+// as the w:w of a w:tblW of type "pct", whose integer form is in fiftieths
+// of a percent, so that "50%" and "2500" are both 50.
+func parseWmlPct(s string) (float64, error) { return parsePercent(s, 50) }
+
+// parseTextScale parses an ST_TextScale value, a whole percentage written
+// either as a bare integer or with a "%" suffix, so that "150" and "150%"
+// are both 150.
+func parseTextScale(s string) (int, error) {
+	v, err := parsePercent(s, 1)
+	if err != nil {
+		return 0, err
+	}
+	if v != math.Trunc(v) {
+		return 0, fmt.Errorf("%q is not a whole percentage", s)
+	}
+	return int(v), nil
+}
+
+func parseDrawingPctAttr(s string) float64 { return valueOrZero(parseDrawingPct(s)) }
+func parseWmlPctAttr(s string) float64     { return valueOrZero(parseWmlPct(s)) }
+func parseTextScaleAttr(s string) int      { return valueOrZero(parseTextScale(s)) }
+
+// formatDrawingPctAttr and formatWmlPctAttr write the integer form, which
+// every version of the spec accepts.
+func formatDrawingPctAttr(v float64) string { return strconv.FormatInt(int64(math.Round(v*1000)), 10) }
+func formatWmlPctAttr(v float64) string     { return strconv.FormatInt(int64(math.Round(v*50)), 10) }
+func formatTextScaleAttr(v int) string      { return strconv.Itoa(v) }
+
+// parseOnOff parses an ST_OnOff value. Unlike parseBoolAttr it rejects
+// values outside true/false, 1/0 and on/off.
+func parseOnOff(s string) (bool, error) {
+	switch strings.TrimSpace(s) {
+	case "true", "1", "on":
+		return true, nil
+	case "false", "0", "off":
+		return false, nil
+	}
+	return false, fmt.Errorf("%q is not a boolean", s)
+}
+
+func parseOnOffAttr(s string) bool { return valueOrZero(parseOnOff(s)) }
+
+// formatOnOffAttr formats v as "1" or "0", as Word writes ST_OnOff.
+func formatOnOffAttr(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+// parseHexColor parses an ST_HexColor value. It returns nil for "auto".
+func parseHexColor(s string) (*docx.RGBColor, error) {
+	s = strings.TrimSpace(s)
+	if s == "auto" {
+		return nil, nil
+	}
+	c, err := docx.RGBColorFromString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a hex color", s)
+	}
+	return &c, nil
+}
+
+func parseHexColorAttr(s string) *docx.RGBColor { return valueOrZero(parseHexColor(s)) }
+
+// formatHexColorAttr formats v as six hex digits, or "auto" if v is nil.
+func formatHexColorAttr(v *docx.RGBColor) string {
+	if v == nil {
+		return "auto"
+	}
+	return v.String()
+}
+
+// valueOrZero returns v, or the zero value of T if err is not nil.
+func valueOrZero[T any](v T, err error) T {
+	if err != nil {
+		var zero T
+		return zero
+	}
+	return v
+}
+
+// parseOptionalAttr parses s with parse into a pointer, or nil if s is not
+// valid.
+func parseOptionalAttr[T any](s string, parse func(string) (T, error)) *T {
+	v, err := parse(s)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
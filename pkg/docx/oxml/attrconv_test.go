@@ -2,7 +2,10 @@ package oxml
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+
+	"github.com/user/go-docx/pkg/docx"
 )
 
 func TestParseIntAttr(t *testing.T) {
@@ -130,3 +133,149 @@ func TestParseOptionalIntAttr(t *testing.T) {
 		t.Errorf("parseOptionalIntAttr(\"42\") = %v, want *42", got)
 	}
 }
+
+func TestParseMeasurements(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		parse func(string) (docx.Length, error)
+		input string
+		want  docx.Length
+	}{
+		{"twips", parseTwips, "1440", docx.Inches(1)},
+		{"twips negative", parseTwips, "-720", -docx.Inches(0.5)},
+		{"twips inches", parseTwips, "1in", docx.Inches(1)},
+		{"twips cm", parseTwips, "2.5cm", docx.Cm(2.5)},
+		{"half points", parseHalfPoints, "24", docx.Pt(12)},
+		{"half points pt", parseHalfPoints, "10.5pt", docx.Pt(10.5)},
+		{"eighth points", parseEighthPoints, "4", docx.Pt(0.5)},
+		{"emu", parseEmu, "914400", docx.Inches(1)},
+		{"emu mm", parseEmu, "3mm", docx.Mm(3)},
+		{"universal pica", parseUniversalMeasure, "1pc", docx.Pt(12)},
+		{"universal pi", parseUniversalMeasure, "2pi", docx.Pt(24)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tc.parse(tc.input)
+			if err != nil {
+				t.Fatalf("parse(%q) error: %v", tc.input, err)
+			}
+			if got != tc.want {
+				t.Errorf("parse(%q) = %d, want %d", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseMeasurements_Invalid(t *testing.T) {
+	t.Parallel()
+	for _, s := range []string{"", "abc", "12px", "in", "1.5", "NaN", "Inf", "-Inf", "1e3", "1e400", "0x1p4",
+		"+12", "NaNin", "Infpt", "1e3in", "0x1p4pt", ".5in", "1.in", "99999999999999999999"} {
+		if _, err := parseTwips(s); err == nil {
+			t.Errorf("parseTwips(%q) succeeded", s)
+		}
+		if err := checkValueAttr(parseHalfPoints)(s); err == nil {
+			t.Errorf("checkValueAttr(parseHalfPoints)(%q) succeeded", s)
+		}
+	}
+	for _, s := range []string{"1440", "1" + strings.Repeat("0", 400) + "in"} {
+		if _, err := parseUniversalMeasure(s); err == nil {
+			t.Errorf("parseUniversalMeasure(%q) succeeded", s)
+		}
+	}
+	if got := parseTwipsAttr("abc"); got != 0 {
+		t.Errorf("parseTwipsAttr(\"abc\") = %d, want 0", got)
+	}
+	if got := parseOptionalAttr("abc", parseTwips); got != nil {
+		t.Errorf("parseOptionalAttr(\"abc\") = %v, want nil", got)
+	}
+}
+
+func TestFormatMeasurements(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		got, want string
+	}{
+		{formatTwipsAttr(docx.Inches(1)), "1440"},
+		{formatHalfPointsAttr(docx.Pt(10.5)), "21"},
+		{formatEighthPointsAttr(docx.Pt(0.5)), "4"},
+		{formatEmuAttr(docx.Cm(1)), "360000"},
+		{formatUniversalMeasureAttr(docx.Pt(10.5)), "10.5pt"},
+	}
+	for _, tc := range tests {
+		if tc.got != tc.want {
+			t.Errorf("got %q, want %q", tc.got, tc.want)
+		}
+	}
+}
+
+func TestParsePct(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		parse func(string) (float64, error)
+		input string
+		want  float64
+	}{
+		{parseDrawingPct, "50%", 50},
+		{parseDrawingPct, "12.5%", 12.5},
+		{parseDrawingPct, "50000", 50},
+		{parseDrawingPct, "-25000", -25},
+		{parseWmlPct, "50%", 50},
+		{parseWmlPct, "2500", 50},
+		{parseWmlPct, "5000", 100},
+		{parseWmlPct, "-1250", -25},
+	}
+	for _, tc := range tests {
+		got, err := tc.parse(tc.input)
+		if err != nil || got != tc.want {
+			t.Errorf("parse(%q) = %v, %v, want %v", tc.input, got, err, tc.want)
+		}
+	}
+	for _, parse := range []func(string) (float64, error){parseDrawingPct, parseWmlPct} {
+		for _, s := range []string{"50.5", "NaN%", "Inf%", "1e2%", "0x10%"} {
+			if _, err := parse(s); err == nil {
+				t.Errorf("accepted %q", s)
+			}
+		}
+	}
+	if got := formatDrawingPctAttr(12.5); got != "12500" {
+		t.Errorf("formatDrawingPctAttr(12.5) = %q, want %q", got, "12500")
+	}
+	if got := formatWmlPctAttr(12.5); got != "625" {
+		t.Errorf("formatWmlPctAttr(12.5) = %q, want %q", got, "625")
+	}
+}
+
+func TestParseOnOff(t *testing.T) {
+	t.Parallel()
+	for input, want := range map[string]bool{"true": true, "1": true, "on": true, "false": false, "0": false, "off": false} {
+		got, err := parseOnOff(input)
+		if err != nil || got != want {
+			t.Errorf("parseOnOff(%q) = %v, %v, want %v", input, got, err, want)
+		}
+	}
+	if _, err := parseOnOff("yes"); err == nil {
+		t.Error("parseOnOff accepted \"yes\"")
+	}
+	if formatOnOffAttr(true) != "1" || formatOnOffAttr(false) != "0" {
+		t.Error("formatOnOffAttr should write 1 and 0")
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	t.Parallel()
+	got, err := parseHexColor("3C2F80")
+	if err != nil || got == nil || *got != docx.NewRGBColor(0x3C, 0x2F, 0x80) {
+		t.Errorf("parseHexColor(\"3C2F80\") = %v, %v", got, err)
+	}
+	if got, err := parseHexColor("auto"); got != nil || err != nil {
+		t.Errorf("parseHexColor(\"auto\") = %v, %v, want nil, nil", got, err)
+	}
+	if _, err := parseHexColor("red"); err == nil {
+		t.Error("parseHexColor accepted \"red\"")
+	}
+	if got := formatHexColorAttr(nil); got != "auto" {
+		t.Errorf("formatHexColorAttr(nil) = %q, want auto", got)
+	}
+}
@@ -0,0 +1,605 @@
+package oxml
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/beevik/etree"
+
+	"github.com/user/go-docx/pkg/docx/enum"
+)
+
+// chartUri is the graphic data type of a chart reference.
+const chartUri = "http://schemas.openxmlformats.org/drawingml/2006/chart"
+
+// ChartData holds the values plotted by a chart.
+type ChartData struct {
+	// Categories are the category labels of bar, column, line, area and pie
+	// charts.
+	Categories []string
+	// XValues are the x values shared by all series of a scatter chart.
+	XValues []float64
+	// Series are the plotted series, each with one value per category or x
+	// value.
+	Series []ChartSeries
+}
+
+// ChartSeries is a named series of chart values.
+type ChartSeries struct {
+	Name   string
+	Values []float64
+}
+
+// isScatter reports whether chartType plots x/y pairs instead of categories.
+func isScatter(chartType enum.XlChartType) bool {
+	return chartType == enum.XlChartTypeXYScatter || chartType == enum.XlChartTypeXYScatterLines
+}
+
+// pointCount returns the number of points in each series of d.
+func (d ChartData) pointCount() int {
+	if len(d.XValues) > 0 {
+		return len(d.XValues)
+	}
+	return len(d.Categories)
+}
+
+func (d ChartData) validate(chartType enum.XlChartType) error {
+	if len(d.Series) == 0 {
+		return fmt.Errorf("oxml: chart data has no series")
+	}
+	if isScatter(chartType) && len(d.XValues) == 0 {
+		return fmt.Errorf("oxml: scatter chart data has no x values")
+	}
+	if !isScatter(chartType) && len(d.Categories) == 0 {
+		return fmt.Errorf("oxml: chart data has no categories")
+	}
+	n := d.pointCount()
+	for _, s := range d.Series {
+		if len(s.Values) != n {
+			return fmt.Errorf("oxml: chart series %q has %d values, want %d", s.Name, len(s.Values), n)
+		}
+	}
+	return nil
+}
+
+// ===========================================================================
+// CT_ChartSpace — custom methods
+// ===========================================================================
+
+// NewChartSpace creates a <c:chartSpace> plotting data as a chart of the
+// given type. Its <c:externalData> refers to the embedded workbook as
+// "rId1", the first relationship of a new chart part; see NewChartWorkbook
+// for the workbook itself.
+func NewChartSpace(chartType enum.XlChartType, data ChartData) (*CT_ChartSpace, error) {
+	if err := data.validate(chartType); err != nil {
+		return nil, err
+	}
+	xml := `<c:chartSpace ` +
+		`xmlns:c="http://schemas.openxmlformats.org/drawingml/2006/chart" ` +
+		`xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<c:date1904 val="0"/>` +
+		`<c:roundedCorners val="0"/>` +
+		`<c:chart>` +
+		`<c:autoTitleDeleted val="0"/>` +
+		`<c:plotArea><c:layout/></c:plotArea>` +
+		`<c:legend><c:legendPos val="r"/><c:overlay val="0"/></c:legend>` +
+		`<c:plotVisOnly val="1"/>` +
+		`<c:dispBlanksAs val="gap"/>` +
+		`</c:chart>` +
+		`<c:externalData r:id="rId1"><c:autoUpdate val="0"/></c:externalData>` +
+		`</c:chartSpace>`
+	el, err := ParseXml([]byte(xml))
+	if err != nil {
+		panic(fmt.Sprintf("chart_custom: failed to parse chartSpace XML: %v", err))
+	}
+	cs := &CT_ChartSpace{Element{E: el}}
+	plotArea := cs.Chart().PlotArea().E
+
+	var plot *etree.Element
+	switch chartType {
+	case enum.XlChartTypeColumnClustered, enum.XlChartTypeColumnStacked,
+		enum.XlChartTypeBarClustered, enum.XlChartTypeBarStacked:
+		plot = plotArea.CreateElement("c:barChart")
+		barDir, grouping := "col", "clustered"
+		if chartType == enum.XlChartTypeBarClustered || chartType == enum.XlChartTypeBarStacked {
+			barDir = "bar"
+		}
+		if chartType == enum.XlChartTypeColumnStacked || chartType == enum.XlChartTypeBarStacked {
+			grouping = "stacked"
+		}
+		valElement(plot, "c:barDir", barDir)
+		valElement(plot, "c:grouping", grouping)
+		valElement(plot, "c:varyColors", "0")
+		addChartSeries(plot, chartType, data)
+		valElement(plot, "c:gapWidth", "150")
+		if grouping == "stacked" {
+			valElement(plot, "c:overlap", "100")
+		}
+	case enum.XlChartTypeLine, enum.XlChartTypeLineMarkers:
+		plot = plotArea.CreateElement("c:lineChart")
+		valElement(plot, "c:grouping", "standard")
+		valElement(plot, "c:varyColors", "0")
+		addChartSeries(plot, chartType, data)
+		valElement(plot, "c:marker", "1")
+	case enum.XlChartTypeArea:
+		plot = plotArea.CreateElement("c:areaChart")
+		valElement(plot, "c:grouping", "standard")
+		valElement(plot, "c:varyColors", "0")
+		addChartSeries(plot, chartType, data)
+	case enum.XlChartTypePie:
+		plot = plotArea.CreateElement("c:pieChart")
+		valElement(plot, "c:varyColors", "1")
+		addChartSeries(plot, chartType, data)
+		valElement(plot, "c:firstSliceAng", "0")
+		return cs, nil
+	case enum.XlChartTypeXYScatter, enum.XlChartTypeXYScatterLines:
+		plot = plotArea.CreateElement("c:scatterChart")
+		valElement(plot, "c:scatterStyle", "lineMarker")
+		valElement(plot, "c:varyColors", "0")
+		addChartSeries(plot, chartType, data)
+	default:
+		return nil, fmt.Errorf("oxml: unsupported chart type %d", chartType)
+	}
+
+	// Axes. A bar chart's category axis is vertical; a scatter chart has
+	// two value axes.
+	const catAxId, valAxId = "2094734552", "2094734553"
+	valElement(plot, "c:axId", catAxId)
+	valElement(plot, "c:axId", valAxId)
+	catPos, valPos := "b", "l"
+	if plot.Tag == "barChart" && plot.FindElement("c:barDir").SelectAttrValue("val", "") == "bar" {
+		catPos, valPos = "l", "b"
+	}
+	if isScatter(chartType) {
+		addValAx(plotArea, catAxId, valAxId, catPos, "midCat")
+	} else {
+		catAx := plotArea.CreateElement("c:catAx")
+		valElement(catAx, "c:axId", catAxId)
+		valElement(catAx.CreateElement("c:scaling"), "c:orientation", "minMax")
+		valElement(catAx, "c:delete", "0")
+		valElement(catAx, "c:axPos", catPos)
+		valElement(catAx, "c:majorTickMark", "out")
+		valElement(catAx, "c:minorTickMark", "none")
+		valElement(catAx, "c:tickLblPos", "nextTo")
+		valElement(catAx, "c:crossAx", valAxId)
+		valElement(catAx, "c:crosses", "autoZero")
+		valElement(catAx, "c:auto", "1")
+		valElement(catAx, "c:lblAlgn", "ctr")
+		valElement(catAx, "c:lblOffset", "100")
+		valElement(catAx, "c:noMultiLvlLbl", "0")
+	}
+	addValAx(plotArea, valAxId, catAxId, valPos, "between")
+	return cs, nil
+}
+
+// addValAx appends a <c:valAx> to plotArea.
+func addValAx(plotArea *etree.Element, axId, crossAx, pos, crossBetween string) {
+	valAx := plotArea.CreateElement("c:valAx")
+	valElement(valAx, "c:axId", axId)
+	valElement(valAx.CreateElement("c:scaling"), "c:orientation", "minMax")
+	valElement(valAx, "c:delete", "0")
+	valElement(valAx, "c:axPos", pos)
+	if pos == "l" {
+		valAx.CreateElement("c:majorGridlines")
+	}
+	numFmt := valAx.CreateElement("c:numFmt")
+	numFmt.CreateAttr("formatCode", "General")
+	numFmt.CreateAttr("sourceLinked", "1")
+	valElement(valAx, "c:majorTickMark", "out")
+	valElement(valAx, "c:minorTickMark", "none")
+	valElement(valAx, "c:tickLblPos", "nextTo")
+	valElement(valAx, "c:crossAx", crossAx)
+	valElement(valAx, "c:crosses", "autoZero")
+	valElement(valAx, "c:crossBetween", crossBetween)
+}
+
+// addChartSeries appends a <c:ser> to plot for each series of data, with
+// formulas pointing at the layout written by NewChartWorkbook.
+func addChartSeries(plot *etree.Element, chartType enum.XlChartType, data ChartData) {
+	n := data.pointCount()
+	for i, s := range data.Series {
+		col := columnName(i + 1)
+		ser := plot.CreateElement("c:ser")
+		valElement(ser, "c:idx", strconv.Itoa(i))
+		valElement(ser, "c:order", strconv.Itoa(i))
+		strRef := ser.CreateElement("c:tx").CreateElement("c:strRef")
+		strRef.CreateElement("c:f").SetText(fmt.Sprintf("Sheet1!$%s$1", col))
+		addCache(strRef.CreateElement("c:strCache"), []string{s.Name})
+
+		switch chartType {
+		case enum.XlChartTypeXYScatter:
+			ln := ser.CreateElement("c:spPr").CreateElement("a:ln")
+			ln.CreateAttr("w", "19050")
+			ln.CreateElement("a:noFill")
+		case enum.XlChartTypeLine:
+			valElement(ser.CreateElement("c:marker"), "c:symbol", "none")
+		case enum.XlChartTypeColumnClustered, enum.XlChartTypeColumnStacked,
+			enum.XlChartTypeBarClustered, enum.XlChartTypeBarStacked:
+			valElement(ser, "c:invertIfNegative", "0")
+		}
+
+		rows := fmt.Sprintf("$%%s$2:$%%s$%d", n+1)
+		valTag := "c:val"
+		if isScatter(chartType) {
+			addNumRef(ser.CreateElement("c:xVal"), "Sheet1!"+fmt.Sprintf(rows, "A", "A"), data.XValues)
+			valTag = "c:yVal"
+		} else {
+			catRef := ser.CreateElement("c:cat").CreateElement("c:strRef")
+			catRef.CreateElement("c:f").SetText("Sheet1!" + fmt.Sprintf(rows, "A", "A"))
+			addCache(catRef.CreateElement("c:strCache"), data.Categories)
+		}
+		addNumRef(ser.CreateElement(valTag), "Sheet1!"+fmt.Sprintf(rows, col, col), s.Values)
+
+		switch chartType {
+		case enum.XlChartTypeLine, enum.XlChartTypeLineMarkers,
+			enum.XlChartTypeXYScatter, enum.XlChartTypeXYScatterLines:
+			valElement(ser, "c:smooth", "0")
+		}
+	}
+}
+
+// addNumRef appends a <c:numRef> with formula f and cached values to parent.
+// NaN and infinite values have no representation in the cache and are left
+// blank.
+func addNumRef(parent *etree.Element, f string, values []float64) {
+	numRef := parent.CreateElement("c:numRef")
+	numRef.CreateElement("c:f").SetText(f)
+	numCache := numRef.CreateElement("c:numCache")
+	numCache.CreateElement("c:formatCode").SetText("General")
+	valElement(numCache, "c:ptCount", strconv.Itoa(len(values)))
+	for i, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		pt := numCache.CreateElement("c:pt")
+		pt.CreateAttr("idx", strconv.Itoa(i))
+		pt.CreateElement("c:v").SetText(strconv.FormatFloat(v, 'g', -1, 64))
+	}
+}
+
+// addCache appends <c:ptCount> and one <c:pt> per point to a string or
+// number cache.
+func addCache(cache *etree.Element, pts []string) {
+	valElement(cache, "c:ptCount", strconv.Itoa(len(pts)))
+	for i, v := range pts {
+		pt := cache.CreateElement("c:pt")
+		pt.CreateAttr("idx", strconv.Itoa(i))
+		pt.CreateElement("c:v").SetText(v)
+	}
+}
+
+// valElement appends a child with tag and a "val" attribute to parent.
+func valElement(parent *etree.Element, tag, val string) *etree.Element {
+	el := parent.CreateElement(tag)
+	el.CreateAttr("val", val)
+	return el
+}
+
+// plot returns the first plot element (<c:barChart>, <c:lineChart>, ...)
+// of the chart, or nil when it has none.
+func (cs *CT_ChartSpace) plot() *etree.Element {
+	for _, child := range cs.Chart().PlotArea().E.ChildElements() {
+		if child.Space == "c" && len(child.Tag) > 5 && child.Tag[len(child.Tag)-5:] == "Chart" {
+			return child
+		}
+	}
+	return nil
+}
+
+// ChartType returns the type of the chart's first plot. ok is false when
+// the chart has no plot or a type not covered by NewChartSpace.
+func (cs *CT_ChartSpace) ChartType() (chartType enum.XlChartType, ok bool) {
+	plot := cs.plot()
+	if plot == nil {
+		return 0, false
+	}
+	childVal := func(tag string) string {
+		if el := plot.FindElement(tag); el != nil {
+			return el.SelectAttrValue("val", "")
+		}
+		return ""
+	}
+	switch plot.Tag {
+	case "barChart":
+		stacked := childVal("c:grouping") == "stacked" || childVal("c:grouping") == "percentStacked"
+		switch {
+		case childVal("c:barDir") == "bar" && stacked:
+			return enum.XlChartTypeBarStacked, true
+		case childVal("c:barDir") == "bar":
+			return enum.XlChartTypeBarClustered, true
+		case stacked:
+			return enum.XlChartTypeColumnStacked, true
+		}
+		return enum.XlChartTypeColumnClustered, true
+	case "lineChart":
+		if plot.FindElement("c:ser/c:marker/c:symbol[@val='none']") != nil {
+			return enum.XlChartTypeLine, true
+		}
+		return enum.XlChartTypeLineMarkers, true
+	case "areaChart":
+		return enum.XlChartTypeArea, true
+	case "pieChart":
+		return enum.XlChartTypePie, true
+	case "scatterChart":
+		if plot.FindElement("c:ser/c:spPr/a:ln/a:noFill") != nil {
+			return enum.XlChartTypeXYScatter, true
+		}
+		return enum.XlChartTypeXYScatterLines, true
+	}
+	return 0, false
+}
+
+// ChartData returns the values cached in the series of the chart's first
+// plot. Categories or x values are read from the first series. Points
+// missing from a number cache are returned as NaN.
+func (cs *CT_ChartSpace) ChartData() (ChartData, error) {
+	var data ChartData
+	plot := cs.plot()
+	if plot == nil {
+		return data, fmt.Errorf("oxml: chart has no plot")
+	}
+	for i, ser := range plot.SelectElements("c:ser") {
+		var s ChartSeries
+		if tx := ser.FindElement("c:tx"); tx != nil {
+			if v := tx.FindElement(".//c:v"); v != nil {
+				s.Name = v.Text()
+			}
+		}
+		valTag := "c:val"
+		if ser.SelectElement("c:yVal") != nil {
+			valTag = "c:yVal"
+		}
+		values, err := cachedNumbers(ser.SelectElement(valTag))
+		if err != nil {
+			return data, err
+		}
+		s.Values = values
+		data.Series = append(data.Series, s)
+
+		if i > 0 {
+			continue
+		}
+		if xVal := ser.SelectElement("c:xVal"); xVal != nil {
+			if xVal.FindElement("c:numRef") != nil || xVal.FindElement("c:numLit") != nil {
+				if data.XValues, err = cachedNumbers(xVal); err != nil {
+					return data, err
+				}
+			} else if data.Categories, err = cachedPoints(xVal); err != nil {
+				return data, err
+			}
+		} else if cat := ser.SelectElement("c:cat"); cat != nil {
+			if data.Categories, err = cachedPoints(cat); err != nil {
+				return data, err
+			}
+		}
+	}
+	return data, nil
+}
+
+// maxBlankPoints bounds the number of points a cache may declare beyond
+// those it holds, so that a hostile ptCount or idx cannot force a huge
+// allocation. Blank points are omitted from a cache, so a few are expected.
+const maxBlankPoints = 1024
+
+// cachedPoints returns the cached point values of the string or number
+// reference (or literal) inside parent, indexed by point. It fails when the
+// cache declares a negative point count or index, or far more points than
+// it holds.
+func cachedPoints(parent *etree.Element) ([]string, error) {
+	if parent == nil {
+		return nil, nil
+	}
+	var cache *etree.Element
+	for _, path := range []string{"c:strRef/c:strCache", "c:numRef/c:numCache", "c:strLit", "c:numLit",
+		"c:multiLvlStrRef/c:multiLvlStrCache/c:lvl"} {
+		if cache = parent.FindElement(path); cache != nil {
+			break
+		}
+	}
+	if cache == nil {
+		return nil, nil
+	}
+	pts := cache.SelectElements("c:pt")
+	limit := 4*len(pts) + maxBlankPoints
+	n := 0
+	if ptCount := cache.SelectElement("c:ptCount"); ptCount != nil {
+		val := ptCount.SelectAttrValue("val", "0")
+		var err error
+		if n, err = strconv.Atoi(val); err != nil || n < 0 || n > limit {
+			return nil, fmt.Errorf("oxml: chart cache ptCount %q out of range for %d points", val, len(pts))
+		}
+	}
+	idxs := make([]int, len(pts))
+	for i, pt := range pts {
+		val := pt.SelectAttrValue("idx", "")
+		idx, err := strconv.Atoi(val)
+		if err != nil || idx < 0 || idx >= limit {
+			return nil, fmt.Errorf("oxml: chart cache idx %q out of range for %d points", val, len(pts))
+		}
+		idxs[i] = idx
+		if idx >= n {
+			n = idx + 1
+		}
+	}
+	result := make([]string, n)
+	for i, pt := range pts {
+		if v := pt.SelectElement("c:v"); v != nil {
+			result[idxs[i]] = v.Text()
+		}
+	}
+	return result, nil
+}
+
+// cachedNumbers returns the cached point values inside parent as numbers.
+func cachedNumbers(parent *etree.Element) ([]float64, error) {
+	pts, err := cachedPoints(parent)
+	if pts == nil || err != nil {
+		return nil, err
+	}
+	result := make([]float64, len(pts))
+	for i, v := range pts {
+		if v == "" {
+			result[i] = math.NaN()
+			continue
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("oxml: invalid chart value %q: %w", v, err)
+		}
+		result[i] = f
+	}
+	return result, nil
+}
+
+// ===========================================================================
+// Embedded chart workbook
+// ===========================================================================
+
+// NewChartWorkbook returns an .xlsx package holding data in the layout the
+// formulas of NewChartSpace refer to: series names across row 1 from
+// column B, categories or x values down column A from row 2, and each
+// series' values below its name. Word opens it when the chart is edited.
+func NewChartWorkbook(data ChartData) ([]byte, error) {
+	const (
+		nsMain = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+		nsPkg  = "http://schemas.openxmlformats.org/package/2006/relationships"
+		rtDoc  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
+		rtWs   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet"
+	)
+
+	ws := etree.NewElement("worksheet")
+	ws.CreateAttr("xmlns", nsMain)
+	sheetData := ws.CreateElement("sheetData")
+	header := newRow(sheetData, 1)
+	for i, s := range data.Series {
+		setCell(header, columnName(i+1)+"1", s.Name, 0, false)
+	}
+	for r := 0; r < data.pointCount(); r++ {
+		row := newRow(sheetData, r+2)
+		ref := strconv.Itoa(r + 2)
+		if len(data.XValues) > 0 {
+			setCell(row, "A"+ref, "", data.XValues[r], true)
+		} else {
+			setCell(row, "A"+ref, data.Categories[r], 0, false)
+		}
+		for i, s := range data.Series {
+			if r < len(s.Values) {
+				setCell(row, columnName(i+1)+ref, "", s.Values[r], true)
+			}
+		}
+	}
+
+	files := []struct {
+		name string
+		xml  string
+	}{
+		{"[Content_Types].xml", `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`</Types>`},
+		{"_rels/.rels", `<Relationships xmlns="` + nsPkg + `">` +
+			`<Relationship Id="rId1" Type="` + rtDoc + `" Target="xl/workbook.xml"/></Relationships>`},
+		{"xl/workbook.xml", `<workbook xmlns="` + nsMain + `" ` +
+			`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", `<Relationships xmlns="` + nsPkg + `">` +
+			`<Relationship Id="rId1" Type="` + rtWs + `" Target="worksheets/sheet1.xml"/></Relationships>`},
+		{"xl/worksheets/sheet1.xml", ""},
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate})
+		if err != nil {
+			return nil, fmt.Errorf("oxml: writing chart workbook: %w", err)
+		}
+		body := f.xml
+		if body == "" {
+			doc := etree.NewDocument()
+			doc.SetRoot(ws)
+			if body, err = doc.WriteToString(); err != nil {
+				return nil, fmt.Errorf("oxml: writing chart workbook: %w", err)
+			}
+		}
+		blob := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" + body
+		if _, err := io.WriteString(w, blob); err != nil {
+			return nil, fmt.Errorf("oxml: writing chart workbook: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("oxml: writing chart workbook: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func newRow(sheetData *etree.Element, r int) *etree.Element {
+	row := sheetData.CreateElement("row")
+	row.CreateAttr("r", strconv.Itoa(r))
+	return row
+}
+
+// setCell appends cell ref to row, holding num when isNum, else the inline
+// string text. A NaN or infinite num leaves the cell blank.
+func setCell(row *etree.Element, ref, text string, num float64, isNum bool) {
+	c := row.CreateElement("c")
+	c.CreateAttr("r", ref)
+	if isNum {
+		if !math.IsNaN(num) && !math.IsInf(num, 0) {
+			c.CreateElement("v").SetText(strconv.FormatFloat(num, 'g', -1, 64))
+		}
+		return
+	}
+	c.CreateAttr("t", "inlineStr")
+	c.CreateElement("is").CreateElement("t").SetText(text)
+}
+
+// columnName returns the spreadsheet column name of the zero-based column
+// index i: A, B, ..., Z, AA, ...
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// ===========================================================================
+// CT_R — chart methods
+// ===========================================================================
+
+// AddChart adds an inline chart of cx by cy EMU to this run. rId is the
+// relationship id of the chart part from the story part.
+func (r *CT_R) AddChart(shapeId int, rId string, cx, cy int64) *CT_Inline {
+	ref := OxmlElement("c:chart", "r")
+	ref.CreateAttr("r:id", rId)
+	inline := newGraphicInline(cx, cy, shapeId, fmt.Sprintf("Chart %d", shapeId), chartUri, ref)
+	r.AddDrawingWithInline(inline)
+	return inline
+}
+
+// ChartRIds returns the relationship ids of the charts drawn in this run.
+func (r *CT_R) ChartRIds() []string {
+	var result []string
+	for _, drawing := range r.FindAllChildren("w:drawing") {
+		for _, gd := range drawing.FindElements(".//a:graphicData") {
+			if gd.SelectAttrValue("uri", "") != chartUri {
+				continue
+			}
+			for _, child := range gd.ChildElements() {
+				ref := &CT_ChartRef{Element{E: child}}
+				if id, err := ref.Id(); err == nil {
+					result = append(result, id)
+				}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,210 @@
+package oxml
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx/enum"
+)
+
+var testChartData = ChartData{
+	Categories: []string{"Q1", "Q2", "Q3"},
+	Series: []ChartSeries{
+		{Name: "East", Values: []float64{1.5, 2, 3}},
+		{Name: "West", Values: []float64{4, 5, 6.25}},
+	},
+}
+
+func TestNewChartSpace_RoundTrip(t *testing.T) {
+	types := []enum.XlChartType{
+		enum.XlChartTypeColumnClustered, enum.XlChartTypeColumnStacked,
+		enum.XlChartTypeBarClustered, enum.XlChartTypeBarStacked,
+		enum.XlChartTypeLine, enum.XlChartTypeLineMarkers,
+		enum.XlChartTypeArea, enum.XlChartTypePie,
+	}
+	for _, ct := range types {
+		cs, err := NewChartSpace(ct, testChartData)
+		if err != nil {
+			t.Fatalf("NewChartSpace(%d): %v", ct, err)
+		}
+		// Read back from serialized XML, as from a chart part.
+		blob, _ := SerializeXml(cs.E)
+		el, err := ParseXml(blob)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cs = &CT_ChartSpace{Element{E: el}}
+		if got, ok := cs.ChartType(); !ok || got != ct {
+			t.Errorf("ChartType() = %d, %v; want %d", got, ok, ct)
+		}
+		data, err := cs.ChartData()
+		if err != nil {
+			t.Fatalf("ChartData: %v", err)
+		}
+		if !reflect.DeepEqual(data, testChartData) {
+			t.Errorf("ChartData() for %d = %+v", ct, data)
+		}
+		if id, _ := cs.ExternalData().Id(); id != "rId1" {
+			t.Errorf("externalData r:id = %q", id)
+		}
+	}
+}
+
+func TestNewChartSpace_Scatter(t *testing.T) {
+	data := ChartData{
+		XValues: []float64{0.5, 1, 2},
+		Series:  []ChartSeries{{Name: "y", Values: []float64{1, 4, 9}}},
+	}
+	for _, ct := range []enum.XlChartType{enum.XlChartTypeXYScatter, enum.XlChartTypeXYScatterLines} {
+		cs, err := NewChartSpace(ct, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := cs.ChartType(); got != ct {
+			t.Errorf("ChartType() = %d, want %d", got, ct)
+		}
+		got, _ := cs.ChartData()
+		if !reflect.DeepEqual(got, data) {
+			t.Errorf("ChartData() = %+v", got)
+		}
+		if n := len(cs.Chart().PlotArea().E.SelectElements("c:valAx")); n != 2 {
+			t.Errorf("scatter chart has %d value axes, want 2", n)
+		}
+	}
+}
+
+func TestNewChartSpace_Invalid(t *testing.T) {
+	bad := []ChartData{
+		{Categories: []string{"a"}},
+		{Categories: []string{"a"}, Series: []ChartSeries{{Values: []float64{1, 2}}}},
+	}
+	for _, d := range bad {
+		if _, err := NewChartSpace(enum.XlChartTypeLine, d); err == nil {
+			t.Errorf("expected an error for %+v", d)
+		}
+	}
+	if _, err := NewChartSpace(enum.XlChartTypeXYScatter, testChartData); err == nil {
+		t.Error("expected an error for a scatter chart without x values")
+	}
+}
+
+func TestCT_ChartSpace_ChartDataSparseCache(t *testing.T) {
+	el, err := ParseXml([]byte(`<c:chartSpace xmlns:c="` + Nsmap["c"] + `"><c:chart><c:plotArea><c:lineChart>` +
+		`<c:ser><c:tx><c:v>lit</c:v></c:tx><c:val><c:numRef><c:numCache><c:ptCount val="3"/>` +
+		`<c:pt idx="0"><c:v>1</c:v></c:pt><c:pt idx="2"><c:v>3</c:v></c:pt></c:numCache></c:numRef></c:val></c:ser>` +
+		`</c:lineChart></c:plotArea></c:chart></c:chartSpace>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := (&CT_ChartSpace{Element{E: el}}).ChartData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vals := data.Series[0].Values
+	if data.Series[0].Name != "lit" || len(vals) != 3 || vals[0] != 1 || !math.IsNaN(vals[1]) || vals[2] != 3 {
+		t.Errorf("ChartData() = %+v", data)
+	}
+}
+
+func TestCT_ChartSpace_ChartDataHostileCache(t *testing.T) {
+	for _, cache := range []string{
+		`<c:ptCount val="-1"/>`,
+		`<c:ptCount val="2000000000"/><c:pt idx="0"><c:v>1</c:v></c:pt>`,
+		`<c:ptCount val="1"/><c:pt idx="2000000000"><c:v>1</c:v></c:pt>`,
+		`<c:ptCount val="1"/><c:pt idx="-1"><c:v>1</c:v></c:pt>`,
+	} {
+		el, err := ParseXml([]byte(`<c:chartSpace xmlns:c="` + Nsmap["c"] + `"><c:chart><c:plotArea><c:lineChart>` +
+			`<c:ser><c:val><c:numRef><c:numCache>` + cache + `</c:numCache></c:numRef></c:val></c:ser>` +
+			`</c:lineChart></c:plotArea></c:chart></c:chartSpace>`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := (&CT_ChartSpace{Element{E: el}}).ChartData(); err == nil {
+			t.Errorf("ChartData() with cache %s: expected an error", cache)
+		}
+	}
+}
+
+func TestNewChartSpace_NonFiniteValues(t *testing.T) {
+	data := ChartData{
+		Categories: []string{"a", "b", "c"},
+		Series:     []ChartSeries{{Name: "s", Values: []float64{1, math.NaN(), math.Inf(1)}}},
+	}
+	cs, err := NewChartSpace(enum.XlChartTypeLine, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xml := SerializeForReading(cs.E)
+	if strings.Contains(xml, "NaN") || strings.Contains(xml, "Inf") {
+		t.Errorf("chart caches a non-finite value: %s", xml)
+	}
+	got, err := cs.ChartData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vals := got.Series[0].Values
+	if len(vals) != 3 || vals[0] != 1 || !math.IsNaN(vals[1]) || !math.IsNaN(vals[2]) {
+		t.Errorf("ChartData() values = %v", vals)
+	}
+}
+
+func TestNewChartWorkbook(t *testing.T) {
+	blob, err := NewChartWorkbook(testChartData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(blob), int64(len(blob)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sheet string
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			rc, _ := f.Open()
+			b, _ := io.ReadAll(rc)
+			rc.Close()
+			sheet = string(b)
+		}
+	}
+	if len(names) != 5 || names[0] != "[Content_Types].xml" {
+		t.Errorf("workbook members = %v", names)
+	}
+	for _, want := range []string{
+		`<c r="B1" t="inlineStr"><is><t>East</t></is></c>`,
+		`<c r="A4" t="inlineStr"><is><t>Q3</t></is></c>`,
+		`<c r="C4"><v>6.25</v></c>`,
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("sheet1.xml missing %s", want)
+		}
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 1: "B", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(i); got != want {
+			t.Errorf("columnName(%d) = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestCT_R_AddChart(t *testing.T) {
+	r := &CT_R{Element{E: OxmlElement("w:r")}}
+	inline := r.AddChart(3, "rId9", 5486400, 3200400)
+	if inline.ExtentCx() != 5486400 || inline.ExtentCy() != 3200400 {
+		t.Errorf("unexpected extent: %s", inline.Xml())
+	}
+	if name, _ := inline.DocPr().Name(); name != "Chart 3" {
+		t.Errorf("docPr name = %q", name)
+	}
+	if got := r.ChartRIds(); len(got) != 1 || got[0] != "rId9" {
+		t.Errorf("ChartRIds() = %v", got)
+	}
+}
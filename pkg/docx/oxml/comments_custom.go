@@ -90,13 +90,5 @@ func (cs *CT_Comments) nextAvailableCommentID() int {
 
 // InnerContentElements returns all <w:p> and <w:tbl> direct children in document order.
 func (c *CT_Comment) InnerContentElements() []interface{} {
-	var result []interface{}
-	for _, child := range c.E.ChildElements() {
-		if child.Space == "w" && child.Tag == "p" {
-			result = append(result, &CT_P{Element{E: child}})
-		} else if child.Space == "w" && child.Tag == "tbl" {
-			result = append(result, &CT_Tbl{Element{E: child}})
-		}
-	}
-	return result
+	return blockContent(c.Children())
 }
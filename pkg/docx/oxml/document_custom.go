@@ -1,6 +1,11 @@
 package oxml
 
-import "github.com/beevik/etree"
+import (
+	"fmt"
+
+	"github.com/beevik/etree"
+	"github.com/user/go-docx/pkg/docx/enum"
+)
 
 // ===========================================================================
 // CT_Document — custom methods
@@ -45,15 +50,7 @@ func (doc *CT_Document) SectPrList() []*CT_SectPr {
 // InnerContentElements returns all <w:p> and <w:tbl> direct children in document order.
 // Elements inside wrapper elements (w:ins, w:sdt, etc.) are not included.
 func (b *CT_Body) InnerContentElements() []interface{} {
-	var result []interface{}
-	for _, child := range b.E.ChildElements() {
-		if child.Space == "w" && child.Tag == "p" {
-			result = append(result, &CT_P{Element{E: child}})
-		} else if child.Space == "w" && child.Tag == "tbl" {
-			result = append(result, &CT_Tbl{Element{E: child}})
-		}
-	}
-	return result
+	return blockContent(b.Children())
 }
 
 // ClearContent removes all content child elements from this <w:body>,
@@ -104,3 +101,46 @@ func (b *CT_Body) SetSectPr(sectPr *CT_SectPr) {
 	b.RemoveSectPr()
 	b.E.AddChild(sectPr.E)
 }
+
+// AddPermRange makes the block-level content from first through last, both
+// children of this body, an editable exception in a protected document by
+// bracketing it with w:permStart and w:permEnd. editors selects who may
+// edit the range; set w:ed on the returned element instead to name a single
+// user. The range gets the next unused permission id in the document.
+func (b *CT_Body) AddPermRange(first, last *etree.Element, editors enum.WdEditorType) (*CT_PermStart, error) {
+	if first.Parent() != b.E || last.Parent() != b.E {
+		return nil, fmt.Errorf("oxml: permission range bounds must be children of <w:body>")
+	}
+	if first.Index() > last.Index() {
+		return nil, fmt.Errorf("oxml: permission range ends before it starts")
+	}
+	start, end := newPermRange(b.E, editors)
+	insertBefore(b.E, start.E, first)
+	b.E.InsertChildAt(last.Index()+1, end.E)
+	return start, nil
+}
+
+// newPermRange creates a detached w:permStart/w:permEnd pair with the next
+// unused permission id of the document containing el.
+func newPermRange(el *etree.Element, editors enum.WdEditorType) (*CT_PermStart, *CT_Perm) {
+	root := el
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	next := 0
+	for _, ps := range root.FindElements("//permStart") {
+		if ps.Space != "w" {
+			continue
+		}
+		if id := parseIntAttr(ps.SelectAttrValue("w:id", "")); id >= next {
+			next = id + 1
+		}
+	}
+	id := formatIntAttr(next)
+	start := &CT_PermStart{Element{E: OxmlElement("w:permStart")}}
+	start.SetId(id)
+	start.SetEdGrp(&editors)
+	end := &CT_Perm{Element{E: OxmlElement("w:permEnd")}}
+	end.SetId(id)
+	return start, end
+}
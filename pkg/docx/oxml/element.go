@@ -152,11 +152,22 @@ func (el *Element) SetText(text string) {
 
 // --- XPath ---
 
-// XPath executes an XPath query using standard OOXML namespace mappings.
-// Note: etree XPath uses local paths; for namespace-aware lookups, prefer
-// FindChild/FindAllChildren.
-func (el *Element) XPath(expr string) []*etree.Element {
-	return el.E.FindElements(expr)
+// XPath compiles expr and evaluates it with this element as the context
+// node, returning the elements it selects in document order. See Query for
+// the supported syntax. For typed results, or to evaluate an expression
+// more than once, compile it with CompileQuery and use QueryAll or
+// QueryFirst.
+func (el *Element) XPath(expr string) ([]*etree.Element, error) {
+	q, err := CompileQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	return q.Select(el.E), nil
+}
+
+// MustXPath is like XPath but panics if expr is not a valid query.
+func (el *Element) MustXPath(expr string) []*etree.Element {
+	return MustCompileQuery(expr).Select(el.E)
 }
 
 // --- Utilities ---
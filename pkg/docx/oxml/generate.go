@@ -1,3 +1,3 @@
 package oxml
 
-//go:generate go run github.com/user/go-docx/cmd/codegen -schema ../../../schema/ -out .
+//go:generate go run github.com/user/go-docx/cmd/codegen -schema ../../../schema/ -out . -tests
@@ -0,0 +1,66 @@
+package oxml
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/beevik/etree"
+)
+
+// Helpers for the generated zz_gen_*_test.go files.
+
+// enumTestValue returns the smallest non-negative value of an enum type that
+// has an XML form.
+func enumTestValue[T ~int](toXml func(T) string) T {
+	for v := T(0); v < 1024; v++ {
+		if toXml(v) != "" {
+			return v
+		}
+	}
+	panic("enumTestValue: no value with an XML form")
+}
+
+// testElement returns a <tag> element with an empty child for each tag of
+// order except those in skip.
+func testElement(tag string, order []string, skip ...string) *etree.Element {
+	el := OxmlElement(tag)
+	for _, t := range order {
+		if !slices.Contains(skip, t) {
+			el.AddChild(OxmlElement(t))
+		}
+	}
+	return el
+}
+
+// assertChildOrder checks that child was inserted into parent, an element
+// of type typeName, after every child the schema puts before it and before
+// every one of its successors.
+func assertChildOrder(t *testing.T, typeName string, parent, child *etree.Element) {
+	t.Helper()
+	if child.Parent() != parent {
+		t.Fatalf("<%s> was not inserted", child.FullTag())
+	}
+	meta := metaByName[typeName]
+	tag := child.FullTag()
+	cm := meta.child(tag)
+	if cm == nil {
+		t.Fatalf("no schema metadata for <%s> in %s", tag, typeName)
+	}
+	var pred []string
+	for _, other := range meta.children {
+		if slices.Contains(other.successors, tag) {
+			pred = append(pred, other.tag)
+		}
+	}
+	seen := false
+	for _, c := range parent.ChildElements() {
+		switch {
+		case c == child:
+			seen = true
+		case !seen && slices.Contains(cm.successors, c.FullTag()),
+			seen && slices.Contains(pred, c.FullTag()):
+			t.Errorf("<%s> inserted out of order: %s", tag, (&Element{E: parent}).Xml())
+			return
+		}
+	}
+}
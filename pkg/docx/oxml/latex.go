@@ -0,0 +1,1012 @@
+package oxml
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/beevik/etree"
+)
+
+// This file converts between a LaTeX subset and Office Math (OMML). The
+// supported subset covers what Word's own equation editor produces for
+// everyday formulas:
+//
+//	x^2, x_i, x_i^2, {ab}^{n}          sub- and superscripts
+//	\frac{a}{b}, \binom{n}{k}          fractions
+//	\sqrt{x}, \sqrt[3]{x}              radicals
+//	\sum, \prod, \int, \oint, ...      n-ary operators with limits
+//	\left( ... \middle| ... \right)    delimiters
+//	\begin{pmatrix} a & b \\ c & d \end{pmatrix}  matrices
+//	\alpha, \infty, \leq, ...          Greek letters and symbols
+//	\sin, \log, ...                    function names
+//	\text{...}, \mathrm{...}, \mathbf{...}
+
+// latexSymbols maps LaTeX commands to the characters they stand for.
+var latexSymbols = map[string]string{}
+
+// latexSymbolNames maps characters back to their LaTeX command. Where
+// several commands yield the same character the first listed wins.
+var latexSymbolNames = map[string]string{}
+
+// latexNary maps n-ary operator commands to their characters.
+var latexNary = map[string]string{
+	"sum": "∑", "prod": "∏", "coprod": "∐", "int": "∫", "iint": "∬", "iiint": "∭",
+	"oint": "∮", "bigcup": "⋃", "bigcap": "⋂", "bigvee": "⋁", "bigwedge": "⋀",
+}
+
+// latexFunctions are the function names written upright, e.g. \sin.
+var latexFunctions = map[string]bool{
+	"sin": true, "cos": true, "tan": true, "cot": true, "sec": true, "csc": true,
+	"arcsin": true, "arccos": true, "arctan": true, "sinh": true, "cosh": true, "tanh": true,
+	"log": true, "ln": true, "lg": true, "exp": true, "lim": true, "max": true, "min": true,
+	"sup": true, "inf": true, "det": true, "gcd": true, "deg": true, "dim": true, "arg": true,
+}
+
+// latexMatrixDelims maps matrix environments to their enclosing delimiters.
+var latexMatrixDelims = map[string][2]string{
+	"matrix": {"", ""}, "pmatrix": {"(", ")"}, "bmatrix": {"[", "]"},
+	"Bmatrix": {"{", "}"}, "vmatrix": {"|", "|"}, "Vmatrix": {"‖", "‖"},
+}
+
+func init() {
+	pairs := []string{
+		"alpha", "α", "beta", "β", "gamma", "γ", "delta", "δ", "epsilon", "ϵ", "varepsilon", "ε",
+		"zeta", "ζ", "eta", "η", "theta", "θ", "vartheta", "ϑ", "iota", "ι", "kappa", "κ",
+		"lambda", "λ", "mu", "μ", "nu", "ν", "xi", "ξ", "pi", "π", "varpi", "ϖ", "rho", "ρ",
+		"varrho", "ϱ", "sigma", "σ", "varsigma", "ς", "tau", "τ", "upsilon", "υ", "phi", "ϕ",
+		"varphi", "φ", "chi", "χ", "psi", "ψ", "omega", "ω",
+		"Gamma", "Γ", "Delta", "Δ", "Theta", "Θ", "Lambda", "Λ", "Xi", "Ξ", "Pi", "Π",
+		"Sigma", "Σ", "Upsilon", "Υ", "Phi", "Φ", "Psi", "Ψ", "Omega", "Ω",
+		"times", "×", "cdot", "⋅", "pm", "±", "mp", "∓", "div", "÷", "ast", "∗", "star", "⋆",
+		"circ", "∘", "bullet", "∙", "oplus", "⊕", "otimes", "⊗",
+		"leq", "≤", "le", "≤", "geq", "≥", "ge", "≥", "neq", "≠", "ne", "≠", "approx", "≈",
+		"equiv", "≡", "sim", "∼", "simeq", "≃", "cong", "≅", "propto", "∝", "ll", "≪", "gg", "≫",
+		"in", "∈", "notin", "∉", "ni", "∋", "subset", "⊂", "supset", "⊃", "subseteq", "⊆",
+		"supseteq", "⊇", "cup", "∪", "cap", "∩", "setminus", "∖", "emptyset", "∅",
+		"forall", "∀", "exists", "∃", "neg", "¬", "land", "∧", "wedge", "∧", "lor", "∨", "vee", "∨",
+		"to", "→", "rightarrow", "→", "leftarrow", "←", "gets", "←", "leftrightarrow", "↔",
+		"Rightarrow", "⇒", "Leftarrow", "⇐", "Leftrightarrow", "⇔", "implies", "⇒", "iff", "⇔",
+		"mapsto", "↦", "uparrow", "↑", "downarrow", "↓",
+		"infty", "∞", "partial", "∂", "nabla", "∇", "hbar", "ℏ", "ell", "ℓ", "Re", "ℜ", "Im", "ℑ",
+		"aleph", "ℵ", "prime", "′", "angle", "∠", "perp", "⊥", "parallel", "∥", "mid", "∣",
+		"ldots", "…", "dots", "…", "cdots", "⋯", "vdots", "⋮", "ddots", "⋱",
+		"langle", "⟨", "rangle", "⟩", "lceil", "⌈", "rceil", "⌉", "lfloor", "⌊", "rfloor", "⌋",
+		"lvert", "|", "rvert", "|", "vert", "|", "|", "‖", "lVert", "‖", "rVert", "‖",
+		"{", "{", "}", "}", "%", "%", "&", "&", "#", "#", "_", "_", "$", "$",
+		",", " ", ":", " ", ";", " ", "quad", " ", "qquad", "  ",
+		" ", " ", "!", "",
+	}
+	for i := 0; i < len(pairs); i += 2 {
+		latexSymbols[pairs[i]] = pairs[i+1]
+		if _, ok := latexSymbolNames[pairs[i+1]]; !ok && pairs[i+1] != "" {
+			latexSymbolNames[pairs[i+1]] = pairs[i]
+		}
+	}
+	// A plain bar stays plain on the way back.
+	delete(latexSymbolNames, "|")
+	for name, c := range latexNary {
+		latexSymbolNames[c] = name
+	}
+}
+
+// ---------------------------------------------------------------------------
+// LaTeX → OMML
+// ---------------------------------------------------------------------------
+
+// latexToken is a lexical unit of LaTeX source. kind is one of: 'c' for a
+// command (text is its name), 'x' for a literal character, 's' for
+// whitespace, 'n' for a row separator (\\), or the character itself for
+// '{', '}', '^', '_' and '&'.
+type latexToken struct {
+	kind byte
+	text string
+}
+
+// tokenizeLatex splits src into tokens. It fails on a trailing backslash,
+// which escapes nothing.
+func tokenizeLatex(src string) ([]latexToken, error) {
+	var toks []latexToken
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		switch {
+		case unicode.IsSpace(r):
+			toks = append(toks, latexToken{'s', " "})
+		case r == '\\' && i+1 == len(src):
+			return nil, fmt.Errorf("oxml: LaTeX: trailing backslash at end of input")
+		case r == '\\':
+			next, nsize := utf8.DecodeRuneInString(src[i+1:])
+			switch {
+			case next == '\\':
+				toks = append(toks, latexToken{'n', ""})
+				size = 2
+			case next < utf8.RuneSelf && unicode.IsLetter(next):
+				j := i + 1
+				for j < len(src) && src[j] < utf8.RuneSelf && unicode.IsLetter(rune(src[j])) {
+					j++
+				}
+				toks = append(toks, latexToken{'c', src[i+1 : j]})
+				size = j - i
+			default:
+				toks = append(toks, latexToken{'c', string(next)})
+				size = 1 + nsize
+			}
+		case r == '{' || r == '}' || r == '^' || r == '_' || r == '&':
+			toks = append(toks, latexToken{byte(r), string(r)})
+		default:
+			toks = append(toks, latexToken{'x', string(r)})
+		}
+		i += size
+	}
+	return toks, nil
+}
+
+// latexParser builds OMML math objects from LaTeX tokens.
+type latexParser struct {
+	toks []latexToken
+	pos  int
+}
+
+// stopFunc reports whether a sequence ends before tok.
+type stopFunc func(tok latexToken) bool
+
+// peek returns the next non-space token, or ok=false at the end of input.
+func (p *latexParser) peek() (latexToken, bool) {
+	for p.pos < len(p.toks) && p.toks[p.pos].kind == 's' {
+		p.pos++
+	}
+	if p.pos >= len(p.toks) {
+		return latexToken{}, false
+	}
+	return p.toks[p.pos], true
+}
+
+func (p *latexParser) next() (latexToken, bool) {
+	tok, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return tok, ok
+}
+
+// expect consumes the next token, which must be of the given kind and,
+// when text is non-empty, have that text.
+func (p *latexParser) expect(kind byte, text string) error {
+	tok, ok := p.next()
+	if !ok || tok.kind != kind || text != "" && tok.text != text {
+		want := text
+		if want == "" {
+			want = string(kind)
+		}
+		return fmt.Errorf("oxml: LaTeX: expected %q at token %d", want, p.pos)
+	}
+	return nil
+}
+
+// parseSeq parses math objects until stop matches the next token or the
+// input ends.
+func (p *latexParser) parseSeq(stop stopFunc) ([]*etree.Element, error) {
+	var seq []*etree.Element
+	for {
+		tok, ok := p.peek()
+		if !ok || stop != nil && stop(tok) {
+			return mergeMathRuns(seq), nil
+		}
+		if tok.kind == 'c' && latexNary[tok.text] != "" {
+			p.pos++
+			nary, err := p.parseNary(tok.text, stop)
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, nary)
+			continue
+		}
+		atom, err := p.parseScripted()
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, atom...)
+	}
+}
+
+// parseScripted parses an atom followed by optional ^ and _ scripts.
+func (p *latexParser) parseScripted() ([]*etree.Element, error) {
+	var base []*etree.Element
+	if tok, _ := p.peek(); tok.kind != '^' && tok.kind != '_' {
+		var err error
+		if base, err = p.parseAtom(); err != nil {
+			return nil, err
+		}
+	}
+	sub, sup, err := p.parseScripts()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case sub != nil && sup != nil:
+		return []*etree.Element{mathEl("m:sSubSup", mathArg("m:e", base), mathArg("m:sub", sub), mathArg("m:sup", sup))}, nil
+	case sub != nil:
+		return []*etree.Element{mathEl("m:sSub", mathArg("m:e", base), mathArg("m:sub", sub))}, nil
+	case sup != nil:
+		return []*etree.Element{mathEl("m:sSup", mathArg("m:e", base), mathArg("m:sup", sup))}, nil
+	}
+	return base, nil
+}
+
+// parseScripts parses any ^ and _ scripts at the current position. A
+// missing script is nil; a present but empty one is a non-nil empty slice.
+func (p *latexParser) parseScripts() (sub, sup []*etree.Element, err error) {
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.kind != '^' && tok.kind != '_' {
+			return sub, sup, nil
+		}
+		p.pos++
+		arg, err := p.parseArg()
+		if err != nil {
+			return nil, nil, err
+		}
+		if arg == nil {
+			arg = []*etree.Element{}
+		}
+		if tok.kind == '_' {
+			if sub != nil {
+				return nil, nil, fmt.Errorf("oxml: LaTeX: double subscript at token %d", p.pos)
+			}
+			sub = arg
+		} else {
+			if sup != nil {
+				return nil, nil, fmt.Errorf("oxml: LaTeX: double superscript at token %d", p.pos)
+			}
+			sup = arg
+		}
+	}
+}
+
+// parseArg parses a braced group or a single atom.
+func (p *latexParser) parseArg() ([]*etree.Element, error) {
+	tok, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("oxml: LaTeX: missing argument at end of input")
+	}
+	if tok.kind == '{' {
+		return p.parseGroup()
+	}
+	return p.parseAtom()
+}
+
+// parseGroup parses a {...} group.
+func (p *latexParser) parseGroup() ([]*etree.Element, error) {
+	if err := p.expect('{', ""); err != nil {
+		return nil, err
+	}
+	seq, err := p.parseSeq(func(t latexToken) bool { return t.kind == '}' })
+	if err != nil {
+		return nil, err
+	}
+	return seq, p.expect('}', "")
+}
+
+// rawGroup returns the source text of a {...} group, spaces included.
+func (p *latexParser) rawGroup() (string, error) {
+	if err := p.expect('{', ""); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	depth := 0
+	for ; p.pos < len(p.toks); p.pos++ {
+		tok := p.toks[p.pos]
+		switch tok.kind {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				p.pos++
+				return sb.String(), nil
+			}
+			depth--
+		case 'c':
+			if s, ok := latexSymbols[tok.text]; ok && len(tok.text) == 1 {
+				sb.WriteString(s)
+				continue
+			}
+			if c, ok := latexTextChars[tok.text]; ok {
+				sb.WriteString(c)
+				// Skip the empty group ending the command, as in \textbackslash{}.
+				if p.pos+2 < len(p.toks) && p.toks[p.pos+1].kind == '{' && p.toks[p.pos+2].kind == '}' {
+					p.pos += 2
+				}
+				continue
+			}
+			sb.WriteString(`\` + tok.text)
+			continue
+		}
+		sb.WriteString(tok.text)
+	}
+	return "", fmt.Errorf("oxml: LaTeX: unterminated group")
+}
+
+// latexTextChars maps the text-mode commands for characters that cannot
+// be escaped with a backslash to those characters.
+var latexTextChars = map[string]string{
+	"textbackslash": `\`, "textasciicircum": "^", "textasciitilde": "~",
+}
+
+// latexTextEscape escapes s for the argument of \text, the inverse of
+// rawGroup.
+func latexTextEscape(s string) string {
+	var sb strings.Builder
+	for _, c := range s {
+		switch c {
+		case '\\':
+			sb.WriteString(`\textbackslash{}`)
+		case '^':
+			sb.WriteString(`\textasciicircum{}`)
+		case '~':
+			sb.WriteString(`\textasciitilde{}`)
+		case '{', '}', '%', '&', '#', '$', '_':
+			sb.WriteByte('\\')
+			sb.WriteRune(c)
+		default:
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+// parseAtom parses a single character, group or command.
+func (p *latexParser) parseAtom() ([]*etree.Element, error) {
+	tok, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("oxml: LaTeX: unexpected end of input")
+	}
+	switch tok.kind {
+	case 'x':
+		return []*etree.Element{mathRun(tok.text, "")}, nil
+	case '{':
+		p.pos--
+		return p.parseGroup()
+	case 'c':
+		return p.parseCommand(tok.text)
+	case 'n':
+		return nil, fmt.Errorf("oxml: LaTeX: row separator \\\\ outside a matrix at token %d", p.pos)
+	}
+	return nil, fmt.Errorf("oxml: LaTeX: unexpected %q at token %d", tok.text, p.pos)
+}
+
+func (p *latexParser) parseCommand(name string) ([]*etree.Element, error) {
+	if s, ok := latexSymbols[name]; ok {
+		if s == "" {
+			return nil, nil
+		}
+		return []*etree.Element{mathRun(s, "")}, nil
+	}
+	if latexFunctions[name] {
+		return []*etree.Element{mathRun(name, "p")}, nil
+	}
+	if c, ok := latexNary[name]; ok {
+		// An operator used as a plain symbol, e.g. in a script.
+		return []*etree.Element{mathRun(c, "")}, nil
+	}
+	switch name {
+	case "frac", "dfrac", "tfrac", "binom":
+		num, err := p.parseArg()
+		if err != nil {
+			return nil, err
+		}
+		den, err := p.parseArg()
+		if err != nil {
+			return nil, err
+		}
+		f := mathEl("m:f")
+		if name == "binom" {
+			f.AddChild(mathEl("m:fPr", mathVal("m:type", "noBar")))
+		}
+		f.AddChild(mathArg("m:num", num))
+		f.AddChild(mathArg("m:den", den))
+		if name == "binom" {
+			return []*etree.Element{mathDelim("(", ")", "", [][]*etree.Element{{f}})}, nil
+		}
+		return []*etree.Element{f}, nil
+	case "sqrt":
+		var deg []*etree.Element
+		if tok, _ := p.peek(); tok.kind == 'x' && tok.text == "[" {
+			p.pos++
+			var err error
+			deg, err = p.parseSeq(func(t latexToken) bool { return t.kind == 'x' && t.text == "]" })
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect('x', "]"); err != nil {
+				return nil, err
+			}
+		}
+		e, err := p.parseArg()
+		if err != nil {
+			return nil, err
+		}
+		rad := mathEl("m:rad")
+		if len(deg) == 0 {
+			rad.AddChild(mathEl("m:radPr", mathVal("m:degHide", "1")))
+		}
+		rad.AddChild(mathArg("m:deg", deg))
+		rad.AddChild(mathArg("m:e", e))
+		return []*etree.Element{rad}, nil
+	case "left":
+		return p.parseLeftRight()
+	case "begin":
+		return p.parseMatrix()
+	case "text", "textrm", "mbox", "operatorname":
+		text, err := p.rawGroup()
+		if err != nil {
+			return nil, err
+		}
+		if name == "operatorname" {
+			return []*etree.Element{mathRun(text, "p")}, nil
+		}
+		run := mathRun(text, "")
+		rPr := mathEl("m:rPr", mathEl("m:nor"))
+		run.InsertChildAt(0, rPr)
+		return []*etree.Element{run}, nil
+	case "mathrm", "mathbf", "mathit":
+		arg, err := p.parseArg()
+		if err != nil {
+			return nil, err
+		}
+		sty := map[string]string{"mathrm": "p", "mathbf": "b", "mathit": "i"}[name]
+		for _, el := range arg {
+			for _, r := range append([]*etree.Element{el}, el.FindElements(".//m:r")...) {
+				if r.Space == "m" && r.Tag == "r" {
+					setMathRunStyle(r, sty)
+				}
+			}
+		}
+		return mergeMathRuns(arg), nil
+	case "displaystyle", "textstyle", "limits", "nolimits":
+		return nil, nil
+	}
+	return nil, fmt.Errorf("oxml: LaTeX: unsupported command \\%s", name)
+}
+
+// parseNary parses the limits and body of an n-ary operator. The body
+// runs to the next top-level relation or additive operator.
+func (p *latexParser) parseNary(name string, stop stopFunc) (*etree.Element, error) {
+	sub, sup, err := p.parseScripts()
+	if err != nil {
+		return nil, err
+	}
+	body, err := p.parseSeq(func(t latexToken) bool {
+		if stop != nil && stop(t) {
+			return true
+		}
+		if t.kind == 'x' {
+			return strings.Contains("+-=<>,", t.text)
+		}
+		if t.kind == 'c' {
+			switch latexSymbols[t.text] {
+			case "≤", "≥", "≠", "≈", "≡", "→", "⇒", "⇔", "±", "∓":
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	limLoc := "undOvr"
+	if strings.Contains(name, "int") {
+		limLoc = "subSup"
+	}
+	pr := mathEl("m:naryPr", mathVal("m:chr", latexNary[name]), mathVal("m:limLoc", limLoc))
+	if sub == nil {
+		pr.AddChild(mathVal("m:subHide", "1"))
+	}
+	if sup == nil {
+		pr.AddChild(mathVal("m:supHide", "1"))
+	}
+	return mathEl("m:nary", pr, mathArg("m:sub", sub), mathArg("m:sup", sup), mathArg("m:e", body)), nil
+}
+
+// delimiter parses the delimiter after \left, \middle or \right.
+func (p *latexParser) delimiter() (string, error) {
+	tok, ok := p.next()
+	if !ok {
+		return "", fmt.Errorf("oxml: LaTeX: missing delimiter")
+	}
+	switch tok.kind {
+	case 'x':
+		if tok.text == "." {
+			return "", nil
+		}
+		return tok.text, nil
+	case 'c':
+		if s, ok := latexSymbols[tok.text]; ok && s != "" {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("oxml: LaTeX: invalid delimiter %q", tok.text)
+}
+
+func (p *latexParser) parseLeftRight() ([]*etree.Element, error) {
+	beg, err := p.delimiter()
+	if err != nil {
+		return nil, err
+	}
+	var args [][]*etree.Element
+	sep := ""
+	for {
+		seq, err := p.parseSeq(func(t latexToken) bool {
+			return t.kind == 'c' && (t.text == "right" || t.text == "middle")
+		})
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, seq)
+		tok, ok := p.next()
+		if !ok {
+			return nil, fmt.Errorf("oxml: LaTeX: \\left without \\right")
+		}
+		if tok.text == "right" {
+			break
+		}
+		if sep, err = p.delimiter(); err != nil {
+			return nil, err
+		}
+	}
+	end, err := p.delimiter()
+	if err != nil {
+		return nil, err
+	}
+	return []*etree.Element{mathDelim(beg, end, sep, args)}, nil
+}
+
+func (p *latexParser) parseMatrix() ([]*etree.Element, error) {
+	env, err := p.rawGroup()
+	if err != nil {
+		return nil, err
+	}
+	delims, ok := latexMatrixDelims[env]
+	if !ok {
+		return nil, fmt.Errorf("oxml: LaTeX: unsupported environment %q", env)
+	}
+	m := mathEl("m:m")
+	row := mathEl("m:mr")
+	for {
+		cell, err := p.parseSeq(func(t latexToken) bool {
+			return t.kind == '&' || t.kind == 'n' || t.kind == 'c' && t.text == "end"
+		})
+		if err != nil {
+			return nil, err
+		}
+		row.AddChild(mathArg("m:e", cell))
+		tok, ok := p.next()
+		if !ok {
+			return nil, fmt.Errorf("oxml: LaTeX: \\begin{%s} without \\end", env)
+		}
+		if tok.kind == '&' {
+			continue
+		}
+		m.AddChild(row)
+		if tok.kind == 'n' {
+			row = mathEl("m:mr")
+			if next, _ := p.peek(); next.kind == 'c' && next.text == "end" {
+				p.pos++
+				break
+			}
+			continue
+		}
+		break
+	}
+	if end, err := p.rawGroup(); err != nil || end != env {
+		return nil, fmt.Errorf("oxml: LaTeX: \\begin{%s} ended by \\end{%s}", env, end)
+	}
+	if delims[0] == "" {
+		return []*etree.Element{m}, nil
+	}
+	return []*etree.Element{mathDelim(delims[0], delims[1], "", [][]*etree.Element{{m}})}, nil
+}
+
+// mathEl creates an OMML element with the given children. The m prefix is
+// declared by the enclosing <m:oMath>.
+func mathEl(tag string, children ...*etree.Element) *etree.Element {
+	el := etree.NewElement(tag)
+	for _, c := range children {
+		el.AddChild(c)
+	}
+	return el
+}
+
+// mathVal creates an OMML property element with an m:val attribute.
+func mathVal(tag, val string) *etree.Element {
+	el := mathEl(tag)
+	el.CreateAttr("m:val", val)
+	return el
+}
+
+// mathArg creates an argument element (m:e, m:sub, ...) holding content.
+func mathArg(tag string, content []*etree.Element) *etree.Element {
+	return mathEl(tag, mergeMathRuns(content)...)
+}
+
+// mathRun creates an <m:r> holding text, with math style sty ("p" for
+// plain, "b" for bold, ...) unless sty is empty.
+func mathRun(text, sty string) *etree.Element {
+	r := mathEl("m:r")
+	if sty != "" {
+		setMathRunStyle(r, sty)
+	}
+	t := r.CreateElement("m:t")
+	t.SetText(text)
+	if strings.TrimSpace(text) != text {
+		t.CreateAttr("xml:space", "preserve")
+	}
+	return r
+}
+
+// setMathRunStyle sets the m:sty of run r.
+func setMathRunStyle(r *etree.Element, sty string) {
+	rPr := r.SelectElement("m:rPr")
+	if rPr == nil {
+		rPr = mathEl("m:rPr")
+		r.InsertChildAt(0, rPr)
+	}
+	if old := rPr.SelectElement("m:sty"); old != nil {
+		rPr.RemoveChild(old)
+	}
+	rPr.AddChild(mathVal("m:sty", sty))
+}
+
+// mathDelim creates an <m:d> enclosing args between beg and end, separated
+// by sep.
+func mathDelim(beg, end, sep string, args [][]*etree.Element) *etree.Element {
+	dPr := mathEl("m:dPr")
+	if beg != "(" {
+		dPr.AddChild(mathVal("m:begChr", beg))
+	}
+	if sep != "" && sep != "|" {
+		dPr.AddChild(mathVal("m:sepChr", sep))
+	}
+	if end != ")" {
+		dPr.AddChild(mathVal("m:endChr", end))
+	}
+	d := mathEl("m:d", dPr)
+	for _, a := range args {
+		d.AddChild(mathArg("m:e", a))
+	}
+	return d
+}
+
+// mergeMathRuns joins adjacent runs with the same properties, so that
+// "x+1" becomes one run as Word writes it.
+func mergeMathRuns(seq []*etree.Element) []*etree.Element {
+	var out []*etree.Element
+	for _, el := range seq {
+		if n := len(out); n > 0 && isMathRun(el) && isMathRun(out[n-1]) &&
+			mathRunProps(el) == mathRunProps(out[n-1]) {
+			prev := out[n-1].SelectElement("m:t")
+			text := prev.Text() + el.SelectElement("m:t").Text()
+			prev.SetText(text)
+			if prev.SelectAttr("xml:space") == nil && strings.TrimSpace(text) != text {
+				prev.CreateAttr("xml:space", "preserve")
+			}
+			continue
+		}
+		out = append(out, el)
+	}
+	return out
+}
+
+func isMathRun(el *etree.Element) bool {
+	return el.Space == "m" && el.Tag == "r" && len(el.SelectElements("m:t")) == 1
+}
+
+// mathRunProps returns the serialized m:rPr of run r, for comparison.
+func mathRunProps(r *etree.Element) string {
+	rPr := r.SelectElement("m:rPr")
+	if rPr == nil {
+		return ""
+	}
+	doc := etree.NewDocument()
+	doc.SetRoot(rPr.Copy())
+	s, _ := doc.WriteToString()
+	return s
+}
+
+// ---------------------------------------------------------------------------
+// OMML → LaTeX
+// ---------------------------------------------------------------------------
+
+// latexWriter accumulates LaTeX, inserting a space where a command name
+// would otherwise run into following letters.
+type latexWriter struct {
+	sb strings.Builder
+}
+
+func (w *latexWriter) write(s string) {
+	if s == "" {
+		return
+	}
+	cur := w.sb.String()
+	if endsWithCommand(cur) {
+		r, _ := utf8.DecodeRuneInString(s)
+		if r < utf8.RuneSelf && unicode.IsLetter(r) {
+			w.sb.WriteByte(' ')
+		}
+	}
+	w.sb.WriteString(s)
+}
+
+// endsWithCommand reports whether s ends with a \command name.
+func endsWithCommand(s string) bool {
+	i := len(s)
+	for i > 0 && s[i-1] < utf8.RuneSelf && unicode.IsLetter(rune(s[i-1])) {
+		i--
+	}
+	return i < len(s) && i > 0 && s[i-1] == '\\' && (i < 2 || s[i-2] != '\\')
+}
+
+// ommlToLatex converts the math objects among children to LaTeX.
+func ommlToLatex(children []*etree.Element) string {
+	var w latexWriter
+	for _, el := range children {
+		w.write(ommlObjectToLatex(el))
+	}
+	return w.sb.String()
+}
+
+// ommlArgToLatex converts the content of argument element el, which may
+// be nil.
+func ommlArgToLatex(el *etree.Element) string {
+	if el == nil {
+		return ""
+	}
+	return ommlToLatex(el.ChildElements())
+}
+
+// latexGroup braces s unless it is a single character or command.
+func latexGroup(s string) string {
+	if utf8.RuneCountInString(s) == 1 || len(s) > 1 && s[0] == '\\' && !strings.ContainsAny(s[1:], `\{}^_ `) {
+		return s
+	}
+	return "{" + s + "}"
+}
+
+// mathChild returns the first child of el with the given m: tag, or nil.
+func mathChild(el *etree.Element, tag string) *etree.Element {
+	for _, c := range el.ChildElements() {
+		if c.Space == "m" && c.Tag == tag {
+			return c
+		}
+	}
+	return nil
+}
+
+// mathPropVal returns the m:val of property tag of the properties element
+// pr, whether it is present, and def when it has no m:val.
+func mathPropVal(pr *etree.Element, tag, def string) (string, bool) {
+	if pr == nil {
+		return def, false
+	}
+	p := mathChild(pr, tag)
+	if p == nil {
+		return def, false
+	}
+	if v := p.SelectAttr("m:val"); v != nil {
+		return v.Value, true
+	}
+	return def, true
+}
+
+// mathOn reports whether the on/off property tag of pr is on.
+func mathOn(pr *etree.Element, tag string) bool {
+	v, ok := mathPropVal(pr, tag, "on")
+	return ok && (v == "on" || v == "1" || v == "true")
+}
+
+func ommlObjectToLatex(el *etree.Element) string {
+	if el.Space != "m" {
+		// w:r and friends inside math, e.g. from tracked changes.
+		return ommlToLatex(el.ChildElements())
+	}
+	switch el.Tag {
+	case "r":
+		return mathRunToLatex(el)
+	case "f":
+		num, den := ommlArgToLatex(mathChild(el, "num")), ommlArgToLatex(mathChild(el, "den"))
+		switch typ, _ := mathPropVal(mathChild(el, "fPr"), "type", "bar"); typ {
+		case "noBar":
+			return `\binom{` + num + "}{" + den + "}"
+		case "lin":
+			return latexGroup(num) + "/" + latexGroup(den)
+		}
+		return `\frac{` + num + "}{" + den + "}"
+	case "rad":
+		e := ommlArgToLatex(mathChild(el, "e"))
+		deg := ommlArgToLatex(mathChild(el, "deg"))
+		if deg == "" || mathOn(mathChild(el, "radPr"), "degHide") {
+			return `\sqrt{` + e + "}"
+		}
+		return `\sqrt[` + deg + "]{" + e + "}"
+	case "sSub", "sSup", "sSubSup", "sPre":
+		var w latexWriter
+		if el.Tag == "sPre" {
+			w.write("{}")
+		} else {
+			w.write(latexGroup(ommlArgToLatex(mathChild(el, "e"))))
+		}
+		if sub := mathChild(el, "sub"); sub != nil {
+			w.write("_" + latexGroup(ommlArgToLatex(sub)))
+		}
+		if sup := mathChild(el, "sup"); sup != nil {
+			w.write("^" + latexGroup(ommlArgToLatex(sup)))
+		}
+		if el.Tag == "sPre" {
+			w.write(latexGroup(ommlArgToLatex(mathChild(el, "e"))))
+		}
+		return w.sb.String()
+	case "nary":
+		pr := mathChild(el, "naryPr")
+		chr, _ := mathPropVal(pr, "chr", "∫")
+		var w latexWriter
+		if name, ok := latexSymbolNames[chr]; ok {
+			w.write(`\` + name)
+		} else {
+			w.write(chr)
+		}
+		if sub := ommlArgToLatex(mathChild(el, "sub")); sub != "" && !mathOn(pr, "subHide") {
+			w.write("_" + latexGroup(sub))
+		}
+		if sup := ommlArgToLatex(mathChild(el, "sup")); sup != "" && !mathOn(pr, "supHide") {
+			w.write("^" + latexGroup(sup))
+		}
+		w.write(" ")
+		w.write(ommlArgToLatex(mathChild(el, "e")))
+		return w.sb.String()
+	case "d":
+		return mathDelimToLatex(el)
+	case "m":
+		return mathMatrixToLatex(el, "matrix")
+	case "eqArr":
+		var rows []string
+		for _, e := range el.ChildElements() {
+			if e.Space == "m" && e.Tag == "e" {
+				rows = append(rows, ommlArgToLatex(e))
+			}
+		}
+		return `\begin{aligned}` + strings.Join(rows, ` \\ `) + `\end{aligned}`
+	case "func":
+		var w latexWriter
+		w.write(ommlArgToLatex(mathChild(el, "fName")))
+		w.write(" ")
+		w.write(ommlArgToLatex(mathChild(el, "e")))
+		return w.sb.String()
+	case "limLow", "limUpp":
+		op := "_"
+		if el.Tag == "limUpp" {
+			op = "^"
+		}
+		return latexGroup(ommlArgToLatex(mathChild(el, "e"))) + op + latexGroup(ommlArgToLatex(mathChild(el, "lim")))
+	case "acc":
+		chr, _ := mathPropVal(mathChild(el, "accPr"), "chr", "̂")
+		cmd := map[string]string{"̂": "hat", "̄": "bar", "̅": "bar", "⃗": "vec",
+			"̇": "dot", "̈": "ddot", "̃": "tilde"}[chr]
+		if cmd == "" {
+			cmd = "hat"
+		}
+		return `\` + cmd + "{" + ommlArgToLatex(mathChild(el, "e")) + "}"
+	case "bar":
+		cmd := `\overline`
+		if pos, _ := mathPropVal(mathChild(el, "barPr"), "pos", "bot"); pos == "bot" {
+			cmd = `\underline`
+		}
+		return cmd + "{" + ommlArgToLatex(mathChild(el, "e")) + "}"
+	case "box", "borderBox", "phant", "groupChr":
+		return ommlArgToLatex(mathChild(el, "e"))
+	case "oMath":
+		return ommlToLatex(el.ChildElements())
+	}
+	if strings.HasSuffix(el.Tag, "Pr") {
+		return ""
+	}
+	// Unknown object: keep its text rather than dropping it.
+	return ommlToLatex(el.ChildElements())
+}
+
+// mathRunToLatex converts an <m:r>.
+func mathRunToLatex(r *etree.Element) string {
+	var text strings.Builder
+	for _, t := range r.SelectElements("m:t") {
+		text.WriteString(t.Text())
+	}
+	s := text.String()
+	rPr := mathChild(r, "rPr")
+	if mathOn(rPr, "nor") {
+		return `\text{` + latexTextEscape(s) + "}"
+	}
+	sty, _ := mathPropVal(rPr, "sty", "")
+	if sty == "p" && latexFunctions[s] {
+		return `\` + s
+	}
+	var w latexWriter
+	for _, c := range s {
+		if name, ok := latexSymbolNames[string(c)]; ok {
+			w.write(`\` + name)
+			continue
+		}
+		w.write(string(c))
+	}
+	out := w.sb.String()
+	switch sty {
+	case "p":
+		return `\mathrm{` + out + "}"
+	case "b", "bi":
+		return `\mathbf{` + out + "}"
+	}
+	return out
+}
+
+// latexDelim returns the LaTeX form of a delimiter character.
+func latexDelim(c string) string {
+	switch c {
+	case "":
+		return "."
+	case "{", "}":
+		return `\` + c
+	}
+	if name, ok := latexSymbolNames[c]; ok {
+		return `\` + name
+	}
+	return c
+}
+
+func mathDelimToLatex(d *etree.Element) string {
+	pr := mathChild(d, "dPr")
+	beg, _ := mathPropVal(pr, "begChr", "(")
+	end, _ := mathPropVal(pr, "endChr", ")")
+	sep, _ := mathPropVal(pr, "sepChr", "|")
+	var args []*etree.Element
+	for _, c := range d.ChildElements() {
+		if c.Space == "m" && c.Tag == "e" {
+			args = append(args, c)
+		}
+	}
+	// A delimited lone matrix is written as a matrix environment, and a
+	// parenthesized bar-less fraction as a binomial.
+	if len(args) == 1 && len(args[0].ChildElements()) == 1 {
+		if f := args[0].ChildElements()[0]; beg == "(" && end == ")" && f.Space == "m" && f.Tag == "f" {
+			if typ, _ := mathPropVal(mathChild(f, "fPr"), "type", "bar"); typ == "noBar" {
+				return ommlObjectToLatex(f)
+			}
+		}
+		if m := args[0].ChildElements()[0]; m.Space == "m" && m.Tag == "m" {
+			for env, delims := range latexMatrixDelims {
+				if delims[0] == beg && delims[1] == end && env != "matrix" {
+					return mathMatrixToLatex(m, env)
+				}
+			}
+		}
+	}
+	parts := make([]string, len(args))
+	for i, a := range args {
+		parts[i] = ommlArgToLatex(a)
+	}
+	return `\left` + latexDelim(beg) + strings.Join(parts, ` \middle`+latexDelim(sep)+" ") + `\right` + latexDelim(end)
+}
+
+func mathMatrixToLatex(m *etree.Element, env string) string {
+	var rows []string
+	for _, mr := range m.ChildElements() {
+		if mr.Space != "m" || mr.Tag != "mr" {
+			continue
+		}
+		var cells []string
+		for _, e := range mr.ChildElements() {
+			if e.Space == "m" && e.Tag == "e" {
+				cells = append(cells, ommlArgToLatex(e))
+			}
+		}
+		rows = append(rows, strings.Join(cells, " & "))
+	}
+	return `\begin{` + env + "}" + strings.Join(rows, ` \\ `) + `\end{` + env + "}"
+}
@@ -0,0 +1,114 @@
+package oxml
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ===========================================================================
+// CT_OMath — custom methods
+// ===========================================================================
+
+// NewOMath creates an <m:oMath> equation from LaTeX source. See latex.go
+// for the supported subset.
+func NewOMath(latex string) (*CT_OMath, error) {
+	toks, err := tokenizeLatex(latex)
+	if err != nil {
+		return nil, err
+	}
+	p := &latexParser{toks: toks}
+	seq, err := p.parseSeq(nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.peek(); ok {
+		return nil, fmt.Errorf("oxml: LaTeX: unexpected %q at token %d", p.toks[p.pos].text, p.pos)
+	}
+	el := OxmlElement("m:oMath")
+	for _, c := range seq {
+		el.AddChild(c)
+	}
+	return &CT_OMath{Element{E: el}}, nil
+}
+
+// LaTeX returns the equation as LaTeX. Objects outside the supported subset
+// are reduced to their text so that nothing is silently dropped.
+func (m *CT_OMath) LaTeX() string {
+	return ommlToLatex(m.E.ChildElements())
+}
+
+// LaTeX returns the equations of the math paragraph as LaTeX, one per line.
+func (mp *CT_OMathPara) LaTeX() string {
+	var lines []string
+	for _, m := range mp.OMathList() {
+		lines = append(lines, m.LaTeX())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ===========================================================================
+// CT_P — equation methods
+// ===========================================================================
+
+// AddEquation appends an inline equation built from LaTeX source to this
+// paragraph.
+func (p *CT_P) AddEquation(latex string) (*CT_OMath, error) {
+	m, err := NewOMath(latex)
+	if err != nil {
+		return nil, err
+	}
+	p.E.AddChild(m.E)
+	return m, nil
+}
+
+// AddDisplayEquation appends a display equation, an <m:oMathPara> on its
+// own line, built from LaTeX source to this paragraph.
+func (p *CT_P) AddDisplayEquation(latex string) (*CT_OMath, error) {
+	m, err := NewOMath(latex)
+	if err != nil {
+		return nil, err
+	}
+	para := OxmlElement("m:oMathPara")
+	para.AddChild(m.E)
+	p.E.AddChild(para)
+	return m, nil
+}
+
+// Equations returns the equations of this paragraph in document order,
+// both inline and inside <m:oMathPara>.
+func (p *CT_P) Equations() []*CT_OMath {
+	var result []*CT_OMath
+	for _, child := range p.E.ChildElements() {
+		if child.Space != "m" {
+			continue
+		}
+		switch child.Tag {
+		case "oMath":
+			result = append(result, &CT_OMath{Element{E: child}})
+		case "oMathPara":
+			result = append(result, (&CT_OMathPara{Element{E: child}}).OMathList()...)
+		}
+	}
+	return result
+}
+
+// ParagraphTextWithEquations is like ParagraphText but also includes each
+// equation, as LaTeX between $ signs, at its position in the paragraph.
+func (p *CT_P) ParagraphTextWithEquations() string {
+	var sb strings.Builder
+	for _, child := range p.E.ChildElements() {
+		switch {
+		case child.Space == "w" && child.Tag == "r":
+			sb.WriteString((&CT_R{Element{E: child}}).RunText())
+		case child.Space == "w" && child.Tag == "hyperlink":
+			sb.WriteString((&CT_Hyperlink{Element{E: child}}).HyperlinkText())
+		case child.Space == "m" && child.Tag == "oMath":
+			sb.WriteString("$" + (&CT_OMath{Element{E: child}}).LaTeX() + "$")
+		case child.Space == "m" && child.Tag == "oMathPara":
+			for _, m := range (&CT_OMathPara{Element{E: child}}).OMathList() {
+				sb.WriteString("$$" + m.LaTeX() + "$$")
+			}
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,173 @@
+package oxml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewOMath_Structure(t *testing.T) {
+	m, err := NewOMath(`x = \frac{-b \pm \sqrt{b^2 - 4ac}}{2a}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := m.E.FindElement("m:f")
+	if f == nil {
+		t.Fatalf("no fraction: %s", m.Xml())
+	}
+	frac := &CT_F{Element{E: f}}
+	rad := frac.Num().E.FindElement("m:rad")
+	if rad == nil || !mathOn(rad.SelectElement("m:radPr"), "degHide") {
+		t.Errorf("expected a square root in the numerator: %s", frac.Num().Xml())
+	}
+	if sup := rad.FindElement(".//m:sSup/m:sup/m:r/m:t"); sup == nil || sup.Text() != "2" {
+		t.Errorf("expected b^2 inside the root")
+	}
+	if got := frac.Den().E.FindElement("m:r/m:t").Text(); got != "2a" {
+		t.Errorf("denominator = %q, want adjacent runs merged", got)
+	}
+}
+
+func TestNewOMath_Nary(t *testing.T) {
+	m, err := NewOMath(`\sum_{i=1}^{n} i^2 = \frac{n(n+1)(2n+1)}{6}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nary := &CT_Nary{Element{E: m.E.FindElement("m:nary")}}
+	if chr := nary.NaryPr().Chr().Val(); chr != "∑" {
+		t.Errorf("nary chr = %q", chr)
+	}
+	if nary.Base().E.FindElement("m:sSup") == nil || nary.Base().E.FindElement("m:f") != nil {
+		t.Errorf("n-ary body should stop at '=': %s", nary.Xml())
+	}
+}
+
+func TestNewOMath_Errors(t *testing.T) {
+	for _, src := range []string{`\frac{a}`, `\unknown x`, `x}`, `\left( x`, `\begin{matrix} a \end{pmatrix}`} {
+		if _, err := NewOMath(src); err == nil {
+			t.Errorf("NewOMath(%q) should fail", src)
+		}
+	}
+	for src, want := range map[string]string{
+		`a_b_c`:      "double subscript",
+		`a^b_c^d`:    "double superscript",
+		`\sum_a_b x`: "double subscript",
+		`x + \`:      "trailing backslash",
+		`a \\ b`:     `row separator \\ outside a matrix`,
+	} {
+		if _, err := NewOMath(src); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("NewOMath(%q) error = %v, want %q", src, err, want)
+		}
+	}
+}
+
+func TestOMath_LaTeXRoundTrip(t *testing.T) {
+	tests := []string{
+		`x=\frac{-b\pm \sqrt{b^{2}-4ac}}{2a}`,
+		`\sqrt[3]{x}+\sqrt{y}`,
+		`x_{i}^{2}+y_{j}`,
+		`\sum_{i=1}^{n} i`,
+		`\int_{0}^{\infty } e^{-x}dx`,
+		`\left(a+b\right)`,
+		`\left[0,1\middle|2\right)`,
+		`\begin{pmatrix}a & b \\ c & d\end{pmatrix}`,
+		`\begin{matrix}1 & 0\end{matrix}`,
+		`\binom{n}{k}`,
+		`\alpha \leq \beta \to \infty `,
+		`\sin x+\text{if }y`,
+		`\mathbf{v}\cdot \mathrm{d}`,
+	}
+	for _, src := range tests {
+		m, err := NewOMath(src)
+		if err != nil {
+			t.Errorf("NewOMath(%q): %v", src, err)
+			continue
+		}
+		got := m.LaTeX()
+		m2, err := NewOMath(got)
+		if err != nil {
+			t.Errorf("LaTeX() of %q = %q does not parse: %v", src, got, err)
+			continue
+		}
+		if m2.Xml() != m.Xml() {
+			t.Errorf("round trip of %q via %q changed the equation:\n%s\n%s", src, got, m.Xml(), m2.Xml())
+		}
+	}
+}
+
+func TestOMath_LaTeXFromWord(t *testing.T) {
+	el, err := ParseXml([]byte(`<m:oMath xmlns:m="` + Nsmap["m"] + `" xmlns:w="` + Nsmap["w"] + `">` +
+		`<m:nary><m:naryPr><m:limLoc m:val="subSup"/><m:ctrlPr><w:rPr/></m:ctrlPr></m:naryPr>` +
+		`<m:sub><m:r><m:t>0</m:t></m:r></m:sub><m:sup><m:r><m:t>1</m:t></m:r></m:sup>` +
+		`<m:e><m:func><m:fName><m:r><m:rPr><m:sty m:val="p"/></m:rPr><m:t>sin</m:t></m:r></m:fName>` +
+		`<m:e><m:r><m:t>θ</m:t></m:r></m:e></m:func></m:e></m:nary>` +
+		`<m:acc><m:e><m:r><m:t>x</m:t></m:r></m:e></m:acc>` +
+		`<m:groupChr><m:e><m:r><m:t>z</m:t></m:r></m:e></m:groupChr>` +
+		`</m:oMath>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := (&CT_OMath{Element{E: el}}).LaTeX()
+	want := `\int_0^1 \sin \theta\hat{x}z`
+	if got != want {
+		t.Errorf("LaTeX() = %q, want %q", got, want)
+	}
+}
+
+func TestOMath_LaTeXTextEscapes(t *testing.T) {
+	tests := []struct{ src, want string }{
+		{`\text{a\{b}`, `\text{a\{b}`},
+		{`\text{50\%}`, `\text{50\%}`},
+		{`\text{\$1 \& \#2 a\_b \}}`, `\text{\$1 \& \#2 a\_b \}}`},
+		{`\text{\textbackslash{}n \textasciicircum{} \textasciitilde{}}`, `\text{\textbackslash{}n \textasciicircum{} \textasciitilde{}}`},
+	}
+	for _, tt := range tests {
+		m, err := NewOMath(tt.src)
+		if err != nil {
+			t.Errorf("NewOMath(%q): %v", tt.src, err)
+			continue
+		}
+		if got := m.LaTeX(); got != tt.want {
+			t.Errorf("LaTeX() of %q = %q, want %q", tt.src, got, tt.want)
+		}
+	}
+
+	// OMML to LaTeX and back keeps literal text intact.
+	text := `50% {a} & #1 $x_y^z~\`
+	el, err := ParseXml([]byte(`<m:oMath xmlns:m="` + Nsmap["m"] + `">` +
+		`<m:r><m:rPr><m:nor/></m:rPr><m:t xml:space="preserve">50% {a} &amp; #1 $x_y^z~\</m:t></m:r></m:oMath>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	latex := (&CT_OMath{Element{E: el}}).LaTeX()
+	m, err := NewOMath(latex)
+	if err != nil {
+		t.Fatalf("LaTeX() = %q does not parse: %v", latex, err)
+	}
+	if got := m.E.FindElement(".//m:t").Text(); got != text {
+		t.Errorf("text after %q = %q, want %q", latex, got, text)
+	}
+}
+
+func TestCT_P_AddEquation(t *testing.T) {
+	p := &CT_P{Element{E: OxmlElement("w:p")}}
+	p.AddR().AddTWithText("Energy: ")
+	if _, err := p.AddEquation(`E=mc^2`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.AddDisplayEquation(`a^2+b^2=c^2`); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(p.Equations()); n != 2 {
+		t.Fatalf("Equations() = %d, want 2", n)
+	}
+	if got := p.ParagraphText(); got != "Energy: " {
+		t.Errorf("ParagraphText() = %q", got)
+	}
+	got := p.ParagraphTextWithEquations()
+	if want := "Energy: $E=mc^2$$$a^2+b^2=c^2$$"; got != want {
+		t.Errorf("ParagraphTextWithEquations() = %q, want %q", got, want)
+	}
+	if !strings.Contains(p.Xml(), `<m:oMathPara xmlns:m=`) {
+		t.Error("display equation should be wrapped in m:oMathPara")
+	}
+}
@@ -0,0 +1,236 @@
+package oxml
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// MceProcessor applies ECMA-376 Part 3 markup compatibility rules to a
+// tree for a consumer that understands a given set of namespaces. It picks
+// the first <mc:Choice> of each <mc:AlternateContent> whose required
+// namespaces are all understood, or else its <mc:Fallback>, and strips
+// elements and attributes in namespaces that are declared mc:Ignorable but
+// not understood.
+//
+// Trees that are never passed to Process keep their markup compatibility
+// constructs untouched, so they round-trip as read.
+type MceProcessor struct {
+	understood map[string]bool
+}
+
+// NewMceProcessor returns a processor that understands the given namespace
+// URIs. With no arguments it understands every namespace in Nsmap.
+func NewMceProcessor(understood ...string) *MceProcessor {
+	if len(understood) == 0 {
+		for _, uri := range Nsmap {
+			understood = append(understood, uri)
+		}
+	}
+	m := &MceProcessor{understood: make(map[string]bool, len(understood))}
+	for _, uri := range understood {
+		m.understood[uri] = true
+	}
+	return m
+}
+
+// Understands reports whether uri is understood by this processor. The
+// markup compatibility and XML namespaces are always understood.
+func (m *MceProcessor) Understands(uri string) bool {
+	return m.understood[uri] || uri == Nsmap["mc"] || uri == Nsmap["xml"]
+}
+
+// SelectContent returns the <mc:Choice> or <mc:Fallback> child of the
+// <mc:AlternateContent> element ac that this processor would use, or nil
+// when no branch applies and the construct should be dropped.
+func (m *MceProcessor) SelectContent(ac *etree.Element) *etree.Element {
+	var fallback *etree.Element
+	for _, child := range ac.ChildElements() {
+		if lookupNamespace(child, child.Space) != Nsmap["mc"] {
+			continue
+		}
+		switch child.Tag {
+		case "Choice":
+			if m.satisfies(child, child.SelectAttrValue("Requires", "")) {
+				return child
+			}
+		case "Fallback":
+			if fallback == nil {
+				fallback = child
+			}
+		}
+	}
+	return fallback
+}
+
+// satisfies reports whether every prefix in the whitespace-separated
+// requires list resolves, from el, to an understood namespace.
+func (m *MceProcessor) satisfies(el *etree.Element, requires string) bool {
+	prefixes := strings.Fields(requires)
+	if len(prefixes) == 0 {
+		return false
+	}
+	for _, pfx := range prefixes {
+		uri := lookupNamespace(el, pfx)
+		if uri == "" || !m.Understands(uri) {
+			return false
+		}
+	}
+	return true
+}
+
+// Process rewrites el in place. Each <mc:AlternateContent> is replaced by
+// the content of its selected branch. Elements and attributes in ignorable
+// namespaces that are not understood are removed, except that elements
+// named by mc:ProcessContent are replaced by their children. An error is
+// returned when an mc:MustUnderstand namespace is not understood; el may
+// be partially processed in that case.
+func (m *MceProcessor) Process(el *etree.Element) error {
+	return m.process(el, mceContext{})
+}
+
+// mceContext holds the mc:Ignorable and mc:ProcessContent declarations in
+// scope for an element.
+type mceContext struct {
+	ignorable      map[string]bool // namespace URIs
+	processContent map[string]bool // "uri local", local may be "*"
+}
+
+func (c mceContext) processes(uri, local string) bool {
+	return c.processContent[uri+" "+local] || c.processContent[uri+" *"]
+}
+
+// extend returns the context for el, adding its own mc:Ignorable and
+// mc:ProcessContent declarations to the inherited ones.
+func (m *MceProcessor) extend(el *etree.Element, ctx mceContext) (mceContext, error) {
+	for _, attr := range el.Attr {
+		if attr.Space == "" || attr.Space == "xmlns" || lookupNamespace(el, attr.Space) != Nsmap["mc"] {
+			continue
+		}
+		switch attr.Key {
+		case "Ignorable":
+			ignorable := make(map[string]bool, len(ctx.ignorable))
+			for uri := range ctx.ignorable {
+				ignorable[uri] = true
+			}
+			for _, pfx := range strings.Fields(attr.Value) {
+				if uri := lookupNamespace(el, pfx); uri != "" {
+					ignorable[uri] = true
+				}
+			}
+			ctx.ignorable = ignorable
+		case "ProcessContent":
+			pc := make(map[string]bool, len(ctx.processContent))
+			for k := range ctx.processContent {
+				pc[k] = true
+			}
+			for _, qn := range strings.Fields(attr.Value) {
+				pfx, local, ok := strings.Cut(qn, ":")
+				if !ok {
+					continue
+				}
+				if uri := lookupNamespace(el, pfx); uri != "" {
+					pc[uri+" "+local] = true
+				}
+			}
+			ctx.processContent = pc
+		case "MustUnderstand":
+			for _, pfx := range strings.Fields(attr.Value) {
+				if uri := lookupNamespace(el, pfx); !m.Understands(uri) {
+					return ctx, fmt.Errorf("oxml: mc:MustUnderstand namespace %q is not understood", uri)
+				}
+			}
+		}
+	}
+	return ctx, nil
+}
+
+func (m *MceProcessor) process(el *etree.Element, ctx mceContext) error {
+	ctx, err := m.extend(el, ctx)
+	if err != nil {
+		return err
+	}
+
+	// Ignorable attributes.
+	for i := 0; i < len(el.Attr); {
+		attr := el.Attr[i]
+		if attr.Space != "" && attr.Space != "xmlns" {
+			if uri := lookupNamespace(el, attr.Space); ctx.ignorable[uri] && !m.Understands(uri) {
+				el.RemoveAttr(attr.FullKey())
+				continue
+			}
+		}
+		i++
+	}
+
+	for i := 0; i < len(el.Child); {
+		child, ok := el.Child[i].(*etree.Element)
+		if !ok {
+			i++
+			continue
+		}
+		uri := lookupNamespace(child, child.Space)
+		switch {
+		case uri == Nsmap["mc"] && child.Tag == "AlternateContent":
+			sel := m.SelectContent(child)
+			el.RemoveChildAt(i)
+			if sel != nil {
+				unwrapAt(el, i, sel, child)
+			}
+			// Re-examine the spliced-in content at the same index.
+		case ctx.ignorable[uri] && !m.Understands(uri):
+			el.RemoveChildAt(i)
+			if ctx.processes(uri, child.Tag) {
+				unwrapAt(el, i, child)
+			}
+		default:
+			if err := m.process(child, ctx); err != nil {
+				return err
+			}
+			i++
+		}
+	}
+	return nil
+}
+
+// unwrapAt inserts the children of wrappers[0] into parent at index i. The
+// namespace declarations of all wrappers are copied onto the moved
+// elements so their prefixes stay bound.
+func unwrapAt(parent *etree.Element, i int, wrappers ...*etree.Element) {
+	tokens := append([]etree.Token(nil), wrappers[0].Child...)
+	for j, t := range tokens {
+		parent.InsertChildAt(i+j, t)
+		moved, ok := t.(*etree.Element)
+		if !ok {
+			continue
+		}
+		for _, w := range wrappers {
+			for _, attr := range w.Attr {
+				if (attr.Space == "xmlns" || attr.Space == "" && attr.Key == "xmlns") &&
+					moved.SelectAttr(attr.FullKey()) == nil {
+					moved.CreateAttr(attr.FullKey(), attr.Value)
+				}
+			}
+		}
+	}
+}
+
+// lookupNamespace resolves prefix against the namespace declarations in
+// scope at el, falling back to Nsmap for undeclared prefixes. The empty
+// prefix resolves to the default namespace. It returns "" when prefix is
+// unknown.
+func lookupNamespace(el *etree.Element, prefix string) string {
+	for e := el; e != nil; e = e.Parent() {
+		for _, attr := range e.Attr {
+			if prefix == "" && attr.Space == "" && attr.Key == "xmlns" ||
+				prefix != "" && attr.Space == "xmlns" && attr.Key == prefix {
+				return attr.Value
+			}
+		}
+	}
+	if prefix == "" {
+		return ""
+	}
+	return Nsmap[prefix]
+}
@@ -0,0 +1,96 @@
+package oxml
+
+import (
+	"strings"
+	"testing"
+)
+
+const mceTestXml = `<w:body xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" ` +
+	`xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006" ` +
+	`xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml" ` +
+	`xmlns:x="urn:example:future" mc:Ignorable="w14 x" mc:ProcessContent="x:wrap">` +
+	`<w:p w14:paraId="1A2B3C4D" x:hint="1">` +
+	`<w:r><mc:AlternateContent><mc:Choice Requires="x"><x:shape/></mc:Choice>` +
+	`<mc:Choice Requires="w14"><w:t>choice</w:t></mc:Choice>` +
+	`<mc:Fallback><w:t>fallback</w:t></mc:Fallback></mc:AlternateContent></w:r>` +
+	`<x:ext><w:r><w:t>dropped</w:t></w:r></x:ext>` +
+	`<x:wrap><w:r><w:t>kept</w:t></w:r></x:wrap>` +
+	`</w:p></w:body>`
+
+func TestMceProcessor_Process(t *testing.T) {
+	t.Run("understood choice", func(t *testing.T) {
+		el, _ := ParseXml([]byte(mceTestXml))
+		if err := NewMceProcessor().Process(el); err != nil {
+			t.Fatal(err)
+		}
+		p := &CT_P{Element{E: el.ChildElements()[0]}}
+		if got := p.ParagraphText(); got != "choicekept" {
+			t.Errorf("ParagraphText() = %q, want %q", got, "choicekept")
+		}
+		if _, ok := p.GetAttr("w14:paraId"); !ok {
+			t.Error("understood ignorable attribute should be kept")
+		}
+		if p.E.SelectAttr("x:hint") != nil {
+			t.Error("ignorable attribute in an unknown namespace should be removed")
+		}
+	})
+	t.Run("fallback", func(t *testing.T) {
+		el, _ := ParseXml([]byte(mceTestXml))
+		m := NewMceProcessor(Nsmap["w"])
+		if m.Understands(Nsmap["w14"]) {
+			t.Fatal("w14 should not be understood")
+		}
+		if err := m.Process(el); err != nil {
+			t.Fatal(err)
+		}
+		p := &CT_P{Element{E: el.ChildElements()[0]}}
+		if got := p.ParagraphText(); got != "fallbackkept" {
+			t.Errorf("ParagraphText() = %q, want %q", got, "fallbackkept")
+		}
+		if p.E.SelectAttr("w14:paraId") != nil {
+			t.Error("ignorable w14 attribute should be removed")
+		}
+	})
+}
+
+func TestMceProcessor_SelectContentResolvesPrefixes(t *testing.T) {
+	el, err := ParseXml([]byte(`<root xmlns:mc="` + Nsmap["mc"] + `" xmlns:s="` + Nsmap["wps"] + `">` +
+		`<mc:AlternateContent><mc:Choice Requires="s"/><mc:Fallback/></mc:AlternateContent></root>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ac := el.ChildElements()[0]
+	if got := NewMceProcessor(Nsmap["wps"]).SelectContent(ac); got == nil || got.Tag != "Choice" {
+		t.Errorf("SelectContent should pick the choice for a non-standard prefix, got %v", got)
+	}
+	if got := NewMceProcessor(Nsmap["w"]).SelectContent(ac); got == nil || got.Tag != "Fallback" {
+		t.Errorf("SelectContent should pick the fallback, got %v", got)
+	}
+}
+
+func TestMceProcessor_MustUnderstand(t *testing.T) {
+	el, _ := ParseXml([]byte(`<w:document xmlns:w="` + Nsmap["w"] + `" xmlns:mc="` + Nsmap["mc"] + `" ` +
+		`xmlns:w15="` + Nsmap["w15"] + `" mc:MustUnderstand="w15"/>`))
+	if err := NewMceProcessor(Nsmap["w"]).Process(el); err == nil {
+		t.Error("expected an error for a namespace that must be understood")
+	}
+	if err := NewMceProcessor().Process(el); err != nil {
+		t.Errorf("Process: %v", err)
+	}
+}
+
+func TestMce_RoundTripUnmodified(t *testing.T) {
+	el, err := ParseXml([]byte(mceTestXml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := SerializeXml(el)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`mc:Ignorable="w14 x"`, `<mc:Choice Requires="w14">`, `<mc:Fallback>`, `<x:ext>`, `w14:paraId="1A2B3C4D"`} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("serialized XML missing %s", want)
+		}
+	}
+}
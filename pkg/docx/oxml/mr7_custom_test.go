@@ -1,8 +1,12 @@
 package oxml
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/user/go-docx/pkg/docx/enum"
 )
 
 // ===========================================================================
@@ -118,6 +122,90 @@ func TestCT_ShapeProperties_CxCy_NoXfrm(t *testing.T) {
 	}
 }
 
+func TestNewPicAnchor_Structure(t *testing.T) {
+	center := enum.WdShapePositionCenter
+	opts := AnchorOptions{
+		RelativeH:    enum.WdRelativeHorizontalPositionPage,
+		AlignH:       &center,
+		RelativeV:    enum.WdRelativeVerticalPositionPage,
+		OffsetY:      457200,
+		Wrap:         enum.WdWrapTypeTight,
+		DistL:        114300,
+		DistR:        114300,
+		ZOrder:       251658240,
+		AllowOverlap: true,
+		LayoutInCell: true,
+	}
+	anchor := NewPicAnchor(3, "rId7", "logo.png", 914400, 457200, opts)
+
+	var tags []string
+	for _, c := range anchor.E.ChildElements() {
+		tags = append(tags, c.Tag)
+	}
+	want := "simplePos positionH positionV extent effectExtent wrapTight docPr cNvGraphicFramePr graphic"
+	if got := strings.Join(tags, " "); got != want {
+		t.Errorf("anchor children = %q, want %q", got, want)
+	}
+	if got := anchor.Options(); !reflect.DeepEqual(got, opts) {
+		t.Errorf("Options() = %+v, want %+v", got, opts)
+	}
+	if anchor.ExtentCx() != 914400 || anchor.ExtentCy() != 457200 {
+		t.Errorf("extent = %dx%d", anchor.ExtentCx(), anchor.ExtentCy())
+	}
+	if n := len(anchor.WrapTight().WrapPolygon().LineToList()); n != 4 {
+		t.Errorf("wrap polygon has %d lineTo points, want 4", n)
+	}
+	if embed := anchor.Graphic().GraphicData().Pic().BlipFill().Blip().Embed(); embed != "rId7" {
+		t.Errorf("blip embed = %q, want rId7", embed)
+	}
+}
+
+func TestCT_Anchor_SetWrapType(t *testing.T) {
+	anchor := NewPicAnchor(1, "rId1", "a.png", 100, 100, AnchorOptions{})
+	for _, wrap := range []enum.WdWrapType{
+		enum.WdWrapTypeBehind, enum.WdWrapTypeFront, enum.WdWrapTypeTopBottom,
+		enum.WdWrapTypeThrough, enum.WdWrapTypeSquare,
+	} {
+		anchor.SetWrapType(wrap)
+		if got := anchor.WrapType(); got != wrap {
+			t.Errorf("WrapType() = %v, want %v", got, wrap)
+		}
+		n := 0
+		for _, c := range anchor.E.ChildElements() {
+			if strings.HasPrefix(c.Tag, "wrap") {
+				n++
+			}
+		}
+		if n != 1 {
+			t.Errorf("%d wrap elements after SetWrapType(%v), want 1", n, wrap)
+		}
+	}
+}
+
+func TestCT_Inline_ToAnchor(t *testing.T) {
+	r := &CT_R{Element{E: OxmlElement("w:r")}}
+	drawing := r.AddDrawingWithInline(NewPicInline(2, "rId4", "pic.png", 300, 200))
+	anchor := drawing.Inline().ToAnchor(AnchorOptions{Wrap: enum.WdWrapTypeSquare})
+
+	if drawing.Inline() != nil || drawing.Anchor() == nil {
+		t.Fatal("inline should be replaced by an anchor")
+	}
+	if anchor.ExtentCx() != 300 || anchor.ExtentCy() != 200 {
+		t.Errorf("extent = %dx%d, want 300x200", anchor.ExtentCx(), anchor.ExtentCy())
+	}
+	if id, _ := anchor.DocPr().Id(); id != 2 {
+		t.Errorf("docPr id = %d, want 2", id)
+	}
+	if anchor.Graphic().GraphicData().Pic() == nil {
+		t.Error("picture not moved to the anchor")
+	}
+
+	floating := r.AddFloatingPicture(5, "rId9", "b.png", 10, 20, AnchorOptions{})
+	if floating.E.Parent().Parent() != r.E {
+		t.Error("AddFloatingPicture should add a drawing to the run")
+	}
+}
+
 // ===========================================================================
 // Comments tests
 // ===========================================================================
@@ -15,13 +15,29 @@ var Nsmap = map[string]string{
 	"dcmitype": "http://purl.org/dc/dcmitype/",
 	"dcterms": "http://purl.org/dc/terms/",
 	"dgm":     "http://schemas.openxmlformats.org/drawingml/2006/diagram",
+	"ep":      "http://schemas.openxmlformats.org/officeDocument/2006/extended-properties",
 	"m":       "http://schemas.openxmlformats.org/officeDocument/2006/math",
+	"mc":      "http://schemas.openxmlformats.org/markup-compatibility/2006",
+	"o":       "urn:schemas-microsoft-com:office:office",
 	"pic":     "http://schemas.openxmlformats.org/drawingml/2006/picture",
 	"r":       "http://schemas.openxmlformats.org/officeDocument/2006/relationships",
 	"sl":      "http://schemas.openxmlformats.org/schemaLibrary/2006/main",
+	"v":       "urn:schemas-microsoft-com:vml",
+	"vt":      "http://schemas.openxmlformats.org/officeDocument/2006/docPropsVTypes",
 	"w":       "http://schemas.openxmlformats.org/wordprocessingml/2006/main",
+	"w10":     "urn:schemas-microsoft-com:office:word",
 	"w14":     "http://schemas.microsoft.com/office/word/2010/wordml",
+	"w15":     "http://schemas.microsoft.com/office/word/2012/wordml",
+	"w16":     "http://schemas.microsoft.com/office/word/2018/wordml",
+	"w16cex":  "http://schemas.microsoft.com/office/word/2018/wordml/cex",
+	"w16cid":  "http://schemas.microsoft.com/office/word/2016/wordml/cid",
+	"w16du":   "http://schemas.microsoft.com/office/word/2023/wordml/word16du",
+	"w16se":   "http://schemas.microsoft.com/office/word/2015/wordml/symex",
 	"wp":      "http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing",
+	"wp14":    "http://schemas.microsoft.com/office/word/2010/wordprocessingDrawing",
+	"wpc":     "http://schemas.microsoft.com/office/word/2010/wordprocessingCanvas",
+	"wpg":     "http://schemas.microsoft.com/office/word/2010/wordprocessingGroup",
+	"wps":     "http://schemas.microsoft.com/office/word/2010/wordprocessingShape",
 	"xml":     "http://www.w3.org/XML/1998/namespace",
 	"xsi":     "http://www.w3.org/2001/XMLSchema-instance",
 }
@@ -123,3 +123,36 @@ func splitPrefix(name string) (string, string, bool) {
 	}
 	return "", name, false
 }
+
+// normalizeDefaultNamespace rewrites every element of root that lives in the
+// default namespace bound to Nsmap[pfx] so that it uses pfx as its prefix
+// instead. Parts such as docProps/app.xml are written with an unprefixed
+// default namespace, which FindChild("pfx:tag") would otherwise not match.
+func normalizeDefaultNamespace(root *etree.Element, pfx string) {
+	uri := Nsmap[pfx]
+	var targets []*etree.Element
+	var collect func(e *etree.Element)
+	collect = func(e *etree.Element) {
+		if e.Space == "" && e.NamespaceURI() == uri {
+			targets = append(targets, e)
+		}
+		for _, child := range e.ChildElements() {
+			collect(child)
+		}
+	}
+	collect(root)
+
+	// Resolve everything before touching any xmlns declaration, since removing
+	// the default namespace from an ancestor changes how descendants resolve.
+	for _, e := range targets {
+		e.Space = pfx
+	}
+	for _, e := range targets {
+		if attr := e.SelectAttr("xmlns"); attr != nil && attr.Space == "" && attr.Value == uri {
+			e.RemoveAttr("xmlns")
+		}
+	}
+	if _, ok := HasNsDecl(root, pfx); !ok {
+		root.CreateAttr("xmlns:"+pfx, uri)
+	}
+}
@@ -32,58 +32,6 @@ func (sp *CT_SectPr) Clone() *CT_SectPr {
 	return &CT_SectPr{Element{E: copied}}
 }
 
-// --- Page size ---
-
-// PageWidth returns the page width in twips from pgSz/@w:w, or nil.
-func (sp *CT_SectPr) PageWidth() *int {
-	pgSz := sp.PgSz()
-	if pgSz == nil {
-		return nil
-	}
-	v := pgSz.W()
-	if v == 0 {
-		return nil
-	}
-	return &v
-}
-
-// SetPageWidth sets the page width in twips.
-func (sp *CT_SectPr) SetPageWidth(twips *int) {
-	if twips == nil {
-		pgSz := sp.PgSz()
-		if pgSz != nil {
-			pgSz.SetW(0)
-		}
-		return
-	}
-	sp.GetOrAddPgSz().SetW(*twips)
-}
-
-// PageHeight returns the page height in twips from pgSz/@w:h, or nil.
-func (sp *CT_SectPr) PageHeight() *int {
-	pgSz := sp.PgSz()
-	if pgSz == nil {
-		return nil
-	}
-	v := pgSz.H()
-	if v == 0 {
-		return nil
-	}
-	return &v
-}
-
-// SetPageHeight sets the page height in twips.
-func (sp *CT_SectPr) SetPageHeight(twips *int) {
-	if twips == nil {
-		pgSz := sp.PgSz()
-		if pgSz != nil {
-			pgSz.SetH(0)
-		}
-		return
-	}
-	sp.GetOrAddPgSz().SetH(*twips)
-}
-
 // --- Orientation ---
 
 // Orientation returns the page orientation. Defaults to PORTRAIT when not present.
@@ -158,169 +106,6 @@ func (sp *CT_SectPr) SetTitlePgVal(v bool) {
 	sp.GetOrAddTitlePg().SetVal(true)
 }
 
-// --- Margins ---
-
-// TopMargin returns the top margin in twips, or nil if not present.
-func (sp *CT_SectPr) TopMargin() *int {
-	pgMar := sp.PgMar()
-	if pgMar == nil {
-		return nil
-	}
-	v := pgMar.Top()
-	if v == 0 {
-		return nil
-	}
-	return &v
-}
-
-// SetTopMargin sets the top margin in twips. Passing nil removes the attribute.
-func (sp *CT_SectPr) SetTopMargin(twips *int) {
-	pgMar := sp.GetOrAddPgMar()
-	if twips == nil {
-		pgMar.SetTop(0)
-	} else {
-		pgMar.SetTop(*twips)
-	}
-}
-
-// BottomMargin returns the bottom margin in twips, or nil.
-func (sp *CT_SectPr) BottomMargin() *int {
-	pgMar := sp.PgMar()
-	if pgMar == nil {
-		return nil
-	}
-	v := pgMar.Bottom()
-	if v == 0 {
-		return nil
-	}
-	return &v
-}
-
-// SetBottomMargin sets the bottom margin in twips.
-func (sp *CT_SectPr) SetBottomMargin(twips *int) {
-	pgMar := sp.GetOrAddPgMar()
-	if twips == nil {
-		pgMar.SetBottom(0)
-	} else {
-		pgMar.SetBottom(*twips)
-	}
-}
-
-// LeftMargin returns the left margin in twips, or nil.
-func (sp *CT_SectPr) LeftMargin() *int {
-	pgMar := sp.PgMar()
-	if pgMar == nil {
-		return nil
-	}
-	v := pgMar.Left()
-	if v == 0 {
-		return nil
-	}
-	return &v
-}
-
-// SetLeftMargin sets the left margin in twips.
-func (sp *CT_SectPr) SetLeftMargin(twips *int) {
-	pgMar := sp.GetOrAddPgMar()
-	if twips == nil {
-		pgMar.SetLeft(0)
-	} else {
-		pgMar.SetLeft(*twips)
-	}
-}
-
-// RightMargin returns the right margin in twips, or nil.
-func (sp *CT_SectPr) RightMargin() *int {
-	pgMar := sp.PgMar()
-	if pgMar == nil {
-		return nil
-	}
-	v := pgMar.Right()
-	if v == 0 {
-		return nil
-	}
-	return &v
-}
-
-// SetRightMargin sets the right margin in twips.
-func (sp *CT_SectPr) SetRightMargin(twips *int) {
-	pgMar := sp.GetOrAddPgMar()
-	if twips == nil {
-		pgMar.SetRight(0)
-	} else {
-		pgMar.SetRight(*twips)
-	}
-}
-
-// HeaderMargin returns the header distance from top edge in twips, or nil.
-func (sp *CT_SectPr) HeaderMargin() *int {
-	pgMar := sp.PgMar()
-	if pgMar == nil {
-		return nil
-	}
-	v := pgMar.Header()
-	if v == 0 {
-		return nil
-	}
-	return &v
-}
-
-// SetHeaderMargin sets the header margin in twips.
-func (sp *CT_SectPr) SetHeaderMargin(twips *int) {
-	pgMar := sp.GetOrAddPgMar()
-	if twips == nil {
-		pgMar.SetHeader(0)
-	} else {
-		pgMar.SetHeader(*twips)
-	}
-}
-
-// FooterMargin returns the footer distance from bottom edge in twips, or nil.
-func (sp *CT_SectPr) FooterMargin() *int {
-	pgMar := sp.PgMar()
-	if pgMar == nil {
-		return nil
-	}
-	v := pgMar.Footer()
-	if v == 0 {
-		return nil
-	}
-	return &v
-}
-
-// SetFooterMargin sets the footer margin in twips.
-func (sp *CT_SectPr) SetFooterMargin(twips *int) {
-	pgMar := sp.GetOrAddPgMar()
-	if twips == nil {
-		pgMar.SetFooter(0)
-	} else {
-		pgMar.SetFooter(*twips)
-	}
-}
-
-// GutterMargin returns the gutter in twips, or nil.
-func (sp *CT_SectPr) GutterMargin() *int {
-	pgMar := sp.PgMar()
-	if pgMar == nil {
-		return nil
-	}
-	v := pgMar.Gutter()
-	if v == 0 {
-		return nil
-	}
-	return &v
-}
-
-// SetGutterMargin sets the gutter in twips.
-func (sp *CT_SectPr) SetGutterMargin(twips *int) {
-	pgMar := sp.GetOrAddPgMar()
-	if twips == nil {
-		pgMar.SetGutter(0)
-	} else {
-		pgMar.SetGutter(*twips)
-	}
-}
-
 // --- Header/Footer references ---
 
 // AddHeaderRef adds a headerReference with the given type and relationship ID.
@@ -446,14 +231,6 @@ func (sp *CT_SectPr) PrecedingSectPr() *CT_SectPr {
 
 // InnerContentElements returns all w:p and w:tbl direct children in document order.
 func (hf *CT_HdrFtr) InnerContentElements() []interface{} {
-	var result []interface{}
-	for _, child := range hf.E.ChildElements() {
-		if child.Space == "w" && child.Tag == "p" {
-			result = append(result, &CT_P{Element{E: child}})
-		} else if child.Space == "w" && child.Tag == "tbl" {
-			result = append(result, &CT_Tbl{Element{E: child}})
-		}
-	}
-	return result
+	return blockContent(hf.Children())
 }
 
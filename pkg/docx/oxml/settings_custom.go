@@ -1,5 +1,22 @@
 package oxml
 
+import (
+	"crypto"
+	"crypto/rand"
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/user/go-docx/pkg/docx/enum"
+)
+
 // ===========================================================================
 // CT_Settings — custom methods
 // ===========================================================================
@@ -23,3 +40,467 @@ func (s *CT_Settings) SetEvenAndOddHeadersVal(v *bool) {
 	}
 	s.GetOrAddEvenAndOddHeaders().SetVal(true)
 }
+
+// MirrorMarginsVal reports whether facing pages use mirrored margins.
+func (s *CT_Settings) MirrorMarginsVal() bool { return onOffVal(s.MirrorMargins()) }
+
+// SetMirrorMarginsVal sets the mirrorMargins flag. Passing false or nil
+// removes the element.
+func (s *CT_Settings) SetMirrorMarginsVal(v *bool) {
+	setOnOffVal(v, s.RemoveMirrorMargins, s.GetOrAddMirrorMargins)
+}
+
+// GutterAtTopVal reports whether the gutter is placed at the top of the
+// page instead of the side.
+func (s *CT_Settings) GutterAtTopVal() bool { return onOffVal(s.GutterAtTop()) }
+
+// SetGutterAtTopVal sets the gutterAtTop flag. Passing false or nil removes
+// the element.
+func (s *CT_Settings) SetGutterAtTopVal(v *bool) {
+	setOnOffVal(v, s.RemoveGutterAtTop, s.GetOrAddGutterAtTop)
+}
+
+// TrackRevisionsVal reports whether edits are recorded as tracked changes.
+func (s *CT_Settings) TrackRevisionsVal() bool { return onOffVal(s.TrackRevisions()) }
+
+// SetTrackRevisionsVal sets the trackRevisions flag. Passing false or nil
+// removes the element.
+func (s *CT_Settings) SetTrackRevisionsVal(v *bool) {
+	setOnOffVal(v, s.RemoveTrackRevisions, s.GetOrAddTrackRevisions)
+}
+
+// DoNotTrackMovesVal reports whether moves are tracked as a deletion and an
+// insertion rather than as moves.
+func (s *CT_Settings) DoNotTrackMovesVal() bool { return onOffVal(s.DoNotTrackMoves()) }
+
+// SetDoNotTrackMovesVal sets the doNotTrackMoves flag. Passing false or nil
+// removes the element.
+func (s *CT_Settings) SetDoNotTrackMovesVal(v *bool) {
+	setOnOffVal(v, s.RemoveDoNotTrackMoves, s.GetOrAddDoNotTrackMoves)
+}
+
+// AutoHyphenationVal reports whether automatic hyphenation is on.
+func (s *CT_Settings) AutoHyphenationVal() bool { return onOffVal(s.AutoHyphenation()) }
+
+// SetAutoHyphenationVal sets the autoHyphenation flag. Passing false or nil
+// removes the element.
+func (s *CT_Settings) SetAutoHyphenationVal(v *bool) {
+	setOnOffVal(v, s.RemoveAutoHyphenation, s.GetOrAddAutoHyphenation)
+}
+
+// UpdateFieldsVal reports whether Word is asked to update fields, such as
+// a table of contents, when the document is opened.
+func (s *CT_Settings) UpdateFieldsVal() bool { return onOffVal(s.UpdateFields()) }
+
+// SetUpdateFieldsVal sets the updateFields flag. Passing false or nil
+// removes the element.
+func (s *CT_Settings) SetUpdateFieldsVal(v *bool) {
+	setOnOffVal(v, s.RemoveUpdateFields, s.GetOrAddUpdateFields)
+}
+
+// onOffVal returns the value of an optional on/off child, false if absent.
+func onOffVal(el *CT_OnOff) bool {
+	return el != nil && el.Val()
+}
+
+// setOnOffVal sets an optional on/off child, removing it for false or nil
+// since absence means off.
+func setOnOffVal(v *bool, remove func(), getOrAdd func() *CT_OnOff) {
+	if v == nil || !*v {
+		remove()
+		return
+	}
+	getOrAdd().SetVal(true)
+}
+
+// ZoomPercent returns the zoom percentage of w:zoom, or 0 if not set.
+func (s *CT_Settings) ZoomPercent() int {
+	z := s.Zoom()
+	if z == nil {
+		return 0
+	}
+	return z.Percent()
+}
+
+// SetZoomPercent sets the zoom percentage. Passing 0 removes the
+// percentage, and w:zoom with it unless it also sets a page fit.
+func (s *CT_Settings) SetZoomPercent(percent int) {
+	if percent == 0 {
+		if z := s.Zoom(); z != nil {
+			z.SetPercent(0)
+			if z.Val() == nil {
+				s.RemoveZoom()
+			}
+		}
+		return
+	}
+	s.GetOrAddZoom().SetPercent(percent)
+}
+
+// CompatibilityMode returns the Word version whose layout rules the
+// document follows (e.g. 15 for Word 2013 and later), or 0 if not set.
+func (s *CT_Settings) CompatibilityMode() int {
+	c := s.Compat()
+	if c == nil {
+		return 0
+	}
+	v, ok := c.SettingVal(compatModeName)
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(v)
+}
+
+// SetCompatibilityMode sets the compatibilityMode compat setting. Passing 0
+// removes it.
+func (s *CT_Settings) SetCompatibilityMode(mode int) {
+	if mode == 0 {
+		if c := s.Compat(); c != nil {
+			c.RemoveSetting(compatModeName)
+		}
+		return
+	}
+	s.GetOrAddCompat().SetSetting(compatModeName, formatIntAttr(mode))
+}
+
+// NewRsid generates a revision save id not yet used in w:rsids, records it
+// (as the root id too if there is none) and returns it.
+func (s *CT_Settings) NewRsid() (string, error) {
+	rsids := s.GetOrAddRsids()
+	used := map[string]bool{}
+	for _, v := range rsids.Vals() {
+		used[v] = true
+	}
+	var b [4]byte
+	for {
+		if _, err := rand.Read(b[:]); err != nil {
+			return "", fmt.Errorf("oxml: generating rsid: %w", err)
+		}
+		// Word keeps the high byte zero.
+		b[0] = 0
+		v := strings.ToUpper(hex.EncodeToString(b[:]))
+		if !used[v] && v != "00000000" {
+			if rsids.RsidRoot() == nil {
+				rsids.GetOrAddRsidRoot().SetVal(v)
+			}
+			rsids.AddVal(v)
+			return v, nil
+		}
+	}
+}
+
+// ProtectionType returns the editing restriction the document enforces, or
+// enum.WdProtectionTypeNoProtection if w:documentProtection is absent or
+// not enforced.
+func (s *CT_Settings) ProtectionType() enum.WdProtectionType {
+	dp := s.DocumentProtection()
+	if dp == nil || !dp.Enforcement() || dp.Edit() == nil {
+		return enum.WdProtectionTypeNoProtection
+	}
+	return *dp.Edit()
+}
+
+// Protect enforces the editing restriction edit on the document. A
+// non-empty password is stored as a salted SHA-512 hash the way Word does,
+// so Word asks for it before lifting the restriction. Passing
+// enum.WdProtectionTypeNoProtection removes any protection.
+func (s *CT_Settings) Protect(edit enum.WdProtectionType, password string) error {
+	s.RemoveDocumentProtection()
+	if edit == enum.WdProtectionTypeNoProtection {
+		return nil
+	}
+	dp := s.GetOrAddDocumentProtection()
+	dp.SetEdit(&edit)
+	dp.SetEnforcement(true)
+	return setProtectionHash(&dp.Element, password)
+}
+
+// VerifyPassword reports whether password lifts this protection. When no
+// password hash is stored only the empty password verifies.
+func (dp *CT_DocProtect) VerifyPassword(password string) bool {
+	return verifyProtectionHash(&dp.Element, password)
+}
+
+// SetWriteProtection adds w:writeProtection, which makes Word ask for
+// password before opening the document for editing. When recommended is
+// true Word instead offers to open the document read-only. Any existing
+// write protection is replaced.
+func (s *CT_Settings) SetWriteProtection(recommended bool, password string) error {
+	s.RemoveWriteProtection()
+	wp := s.GetOrAddWriteProtection()
+	wp.SetRecommended(recommended)
+	return setProtectionHash(&wp.Element, password)
+}
+
+// VerifyPassword reports whether password lifts this write protection. When
+// no password hash is stored only the empty password verifies.
+func (wp *CT_WriteProtection) VerifyPassword(password string) bool {
+	return verifyProtectionHash(&wp.Element, password)
+}
+
+// ===========================================================================
+// Protection password hashing
+// ===========================================================================
+
+const (
+	protectionSaltSize  = 16
+	protectionSpinCount = 100000
+	maxProtectionSpins  = 10000000 // the spec maximum of w:cryptSpinCount and w:spinCount
+	protectionSidSHA512 = 14
+)
+
+// protectionSids and protectionAlgorithmNames map the w:cryptAlgorithmSid
+// and w:algorithmName values to hash functions.
+var (
+	protectionSids = map[int]crypto.Hash{
+		4: crypto.SHA1, 12: crypto.SHA256, 13: crypto.SHA384, 14: crypto.SHA512,
+	}
+	protectionAlgorithmNames = map[string]crypto.Hash{
+		"SHA-1": crypto.SHA1, "SHA-256": crypto.SHA256, "SHA-384": crypto.SHA384, "SHA-512": crypto.SHA512,
+	}
+)
+
+// setProtectionHash stores the hash of password on el in the form Word
+// 2007 and later write: the legacy 32-bit password key, as hex, hashed
+// with a random salt and spin count. An empty password stores no hash.
+func setProtectionHash(el *Element, password string) error {
+	if password == "" {
+		return nil
+	}
+	salt := make([]byte, protectionSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("oxml: generating protection salt: %w", err)
+	}
+	hash := iteratedProtectionHash(crypto.SHA512, legacyPasswordKey(password), salt, protectionSpinCount)
+	el.SetAttr("w:cryptProviderType", "rsaAES")
+	el.SetAttr("w:cryptAlgorithmClass", "hash")
+	el.SetAttr("w:cryptAlgorithmType", "typeAny")
+	el.SetAttr("w:cryptAlgorithmSid", formatIntAttr(protectionSidSHA512))
+	el.SetAttr("w:cryptSpinCount", formatIntAttr(protectionSpinCount))
+	el.SetAttr("w:hash", base64.StdEncoding.EncodeToString(hash))
+	el.SetAttr("w:salt", base64.StdEncoding.EncodeToString(salt))
+	return nil
+}
+
+// verifyProtectionHash checks password against the hash stored on el,
+// either in the Word form (w:hash, w:cryptAlgorithmSid) or the ISO form
+// (w:hashValue, w:algorithmName), which hashes the password itself.
+func verifyProtectionHash(el *Element, password string) bool {
+	if stored, ok := el.GetAttr("w:hash"); ok {
+		sid := crypto.SHA1
+		if v, ok := el.GetAttr("w:cryptAlgorithmSid"); ok {
+			if sid, ok = protectionSids[parseIntAttr(v)]; !ok {
+				return false
+			}
+		}
+		salt, _ := el.GetAttr("w:salt")
+		spin, _ := el.GetAttr("w:cryptSpinCount")
+		return checkProtectionHash(sid, legacyPasswordKey(password), salt, parseIntAttr(spin), stored)
+	}
+	if stored, ok := el.GetAttr("w:hashValue"); ok {
+		name, _ := el.GetAttr("w:algorithmName")
+		h, ok := protectionAlgorithmNames[strings.ToUpper(name)]
+		if !ok {
+			return false
+		}
+		salt, _ := el.GetAttr("w:saltValue")
+		spin, _ := el.GetAttr("w:spinCount")
+		return checkProtectionHash(h, password, salt, parseIntAttr(spin), stored)
+	}
+	return password == ""
+}
+
+// checkProtectionHash reports whether input hashes to stored64. A spin
+// count outside 0..10,000,000 fails verification without hashing, so a
+// hostile document cannot stall the check.
+func checkProtectionHash(h crypto.Hash, input, salt64 string, spinCount int, stored64 string) bool {
+	if spinCount < 0 || spinCount > maxProtectionSpins {
+		return false
+	}
+	salt, err := base64.StdEncoding.DecodeString(salt64)
+	if err != nil {
+		return false
+	}
+	stored, err := base64.StdEncoding.DecodeString(stored64)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(iteratedProtectionHash(h, input, salt, spinCount), stored) == 1
+}
+
+// iteratedProtectionHash computes H(salt + UTF-16LE(input)), then rehashes
+// the result spinCount times with the little-endian iteration number
+// appended.
+func iteratedProtectionHash(h crypto.Hash, input string, salt []byte, spinCount int) []byte {
+	hasher := h.New()
+	hasher.Write(salt)
+	for _, u := range utf16.Encode([]rune(input)) {
+		hasher.Write([]byte{byte(u), byte(u >> 8)})
+	}
+	sum := hasher.Sum(nil)
+	var iter [4]byte
+	for i := 0; i < spinCount; i++ {
+		binary.LittleEndian.PutUint32(iter[:], uint32(i))
+		hasher.Reset()
+		hasher.Write(sum)
+		hasher.Write(iter[:])
+		sum = hasher.Sum(sum[:0])
+	}
+	return sum
+}
+
+// legacyPasswordKey returns the 32-bit Word password key of password
+// (ECMA-376 Part 4, §14.7.1) as uppercase hex with its bytes reversed, which
+// is what Word feeds to the iterated hash.
+func legacyPasswordKey(password string) string {
+	key := legacyPasswordVerifier(password)
+	return fmt.Sprintf("%02X%02X%02X%02X", byte(key), byte(key>>8), byte(key>>16), byte(key>>24))
+}
+
+// legacyPasswordVerifier computes the Word 2003 password key: a high word
+// derived from the encryption matrix and a low word from the rotating XOR
+// verifier.
+func legacyPasswordVerifier(password string) uint32 {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return 0
+	}
+	if len(runes) > maxLegacyPasswordLen {
+		runes = runes[:maxLegacyPasswordLen]
+	}
+	chars := make([]byte, len(runes))
+	for i, r := range runes {
+		// Take the low byte of each UTF-16 code unit, or the high byte
+		// when the low byte is zero.
+		if lo := byte(r); lo != 0 {
+			chars[i] = lo
+		} else {
+			chars[i] = byte(r >> 8)
+		}
+	}
+
+	high := legacyInitialCode[len(chars)-1]
+	for i, c := range chars {
+		row := legacyEncryptionMatrix[maxLegacyPasswordLen-len(chars)+i]
+		for bit := 0; bit < 7; bit++ {
+			if c&(1<<bit) != 0 {
+				high ^= row[bit]
+			}
+		}
+	}
+
+	rotate := func(v uint16) uint16 { return (v>>14)&1 | (v<<1)&0x7FFF }
+	var low uint16
+	for i := len(chars) - 1; i >= 0; i-- {
+		low = rotate(low) ^ uint16(chars[i])
+	}
+	low = rotate(low) ^ uint16(len(chars)) ^ 0xCE4B
+	return uint32(high)<<16 | uint32(low)
+}
+
+const maxLegacyPasswordLen = 15
+
+var legacyInitialCode = [maxLegacyPasswordLen]uint16{
+	0xE1F0, 0x1D0F, 0xCC9C, 0x84C0, 0x110C, 0x0E10, 0xF1CE, 0x313E,
+	0x1872, 0xE139, 0xD40F, 0x84F9, 0x280C, 0xA96A, 0x4EC3,
+}
+
+var legacyEncryptionMatrix = [maxLegacyPasswordLen][7]uint16{
+	{0xAEFC, 0x4DD9, 0x9BB2, 0x2745, 0x4E8A, 0x9D14, 0x2A09},
+	{0x7B61, 0xF6C2, 0xFDA5, 0xEB6B, 0xC6F7, 0x9DCF, 0x2BBF},
+	{0x4563, 0x8AC6, 0x05AD, 0x0B5A, 0x16B4, 0x2D68, 0x5AD0},
+	{0x0375, 0x06EA, 0x0DD4, 0x1BA8, 0x3750, 0x6EA0, 0xDD40},
+	{0xD849, 0xA0B3, 0x5147, 0xA28E, 0x553D, 0xAA7A, 0x44D5},
+	{0x6F45, 0xDE8A, 0xAD35, 0x4A4B, 0x9496, 0x390D, 0x721A},
+	{0xEB23, 0xC667, 0x9CEF, 0x29FF, 0x53FE, 0xA7FC, 0x5FD9},
+	{0x47D3, 0x8FA6, 0x0F6D, 0x1EDA, 0x3DB4, 0x7B68, 0xF6D0},
+	{0xB861, 0x60E3, 0xC1C6, 0x93AD, 0x377B, 0x6EF6, 0xDDEC},
+	{0x45A0, 0x8B40, 0x06A1, 0x0D42, 0x1A84, 0x3508, 0x6A10},
+	{0xAA51, 0x4483, 0x8906, 0x022D, 0x045A, 0x08B4, 0x1168},
+	{0x76B4, 0xED68, 0xCAF1, 0x85C3, 0x1BA7, 0x374E, 0x6E9C},
+	{0x3730, 0x6E60, 0xDCC0, 0xA9A1, 0x4363, 0x86C6, 0x1DAD},
+	{0x3331, 0x6662, 0xCCC4, 0x89A9, 0x0373, 0x06E6, 0x0DCC},
+	{0x1021, 0x2042, 0x4084, 0x8108, 0x1231, 0x2462, 0x48C4},
+}
+
+// ===========================================================================
+// CT_Compat — custom methods
+// ===========================================================================
+
+const (
+	compatModeName = "compatibilityMode"
+	compatWordURI  = "http://schemas.microsoft.com/office/word"
+)
+
+// SettingVal returns the value of the Word compat setting name.
+func (c *CT_Compat) SettingVal(name string) (string, bool) {
+	if cs := c.setting(name); cs != nil {
+		v, err := cs.Val()
+		return v, err == nil
+	}
+	return "", false
+}
+
+// SetSetting sets the Word compat setting name to val, adding it if needed.
+func (c *CT_Compat) SetSetting(name, val string) {
+	cs := c.setting(name)
+	if cs == nil {
+		cs = c.AddCompatSetting()
+		cs.SetName(name)
+		cs.SetUri(compatWordURI)
+	}
+	cs.SetVal(val)
+}
+
+// RemoveSetting removes the Word compat setting name.
+func (c *CT_Compat) RemoveSetting(name string) {
+	if cs := c.setting(name); cs != nil {
+		c.E.RemoveChild(cs.E)
+	}
+}
+
+func (c *CT_Compat) setting(name string) *CT_CompatSetting {
+	for _, cs := range c.CompatSettingList() {
+		n, _ := cs.Name()
+		uri, _ := cs.Uri()
+		if n == name && uri == compatWordURI {
+			return cs
+		}
+	}
+	return nil
+}
+
+// ===========================================================================
+// CT_DocRsids — custom methods
+// ===========================================================================
+
+// Vals returns the revision save ids in w:rsid children, in document order.
+func (r *CT_DocRsids) Vals() []string {
+	var vals []string
+	for _, rsid := range r.RsidList() {
+		if v, err := rsid.Val(); err == nil {
+			vals = append(vals, v)
+		}
+	}
+	return vals
+}
+
+// AddVal adds the revision save id v, keeping the list sorted as Word does.
+// Adding an id already present is a no-op.
+func (r *CT_DocRsids) AddVal(v string) {
+	v = strings.ToUpper(v)
+	for _, rsid := range r.RsidList() {
+		cur, _ := rsid.Val()
+		cur = strings.ToUpper(cur)
+		if cur == v {
+			return
+		}
+		if cur > v {
+			el := r.newRsid()
+			el.SetVal(v)
+			insertBefore(r.E, el.E, rsid.E)
+			return
+		}
+	}
+	r.AddRsid().SetVal(v)
+}
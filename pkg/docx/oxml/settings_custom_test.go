@@ -0,0 +1,243 @@
+package oxml
+
+import (
+	"crypto"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
+)
+
+// --- Document protection tests ---
+
+func TestLegacyPasswordVerifier(t *testing.T) {
+	// Test vector from ECMA-376 Part 4, §14.7.1.
+	if got := legacyPasswordVerifier("Example"); got != 0x64CEED7E {
+		t.Errorf("legacyPasswordVerifier(Example) = %#08X, want 0x64CEED7E", got)
+	}
+	if got := legacyPasswordKey("Example"); got != "7EEDCE64" {
+		t.Errorf("legacyPasswordKey(Example) = %q, want 7EEDCE64", got)
+	}
+}
+
+func TestCT_Settings_Protect(t *testing.T) {
+	s := &CT_Settings{Element{E: OxmlElement("w:settings")}}
+	on := true
+	s.SetEvenAndOddHeadersVal(&on)
+	if got := s.ProtectionType(); got != enum.WdProtectionTypeNoProtection {
+		t.Errorf("ProtectionType() = %v, want NoProtection", got)
+	}
+
+	if err := s.Protect(enum.WdProtectionTypeAllowOnlyReading, "s3cret"); err != nil {
+		t.Fatalf("Protect: %v", err)
+	}
+	if got := s.ProtectionType(); got != enum.WdProtectionTypeAllowOnlyReading {
+		t.Errorf("ProtectionType() = %v, want AllowOnlyReading", got)
+	}
+	dp := s.DocumentProtection()
+	if dp.CryptAlgorithmSid() != 14 || dp.CryptSpinCount() != 100000 || dp.Hash() == "" || dp.Salt() == "" {
+		t.Errorf("unexpected hash attributes: %s", dp.Xml())
+	}
+	if !dp.VerifyPassword("s3cret") || dp.VerifyPassword("wrong") || dp.VerifyPassword("") {
+		t.Error("VerifyPassword does not match the stored hash")
+	}
+	if first := s.E.ChildElements()[0]; first.Tag != "documentProtection" {
+		t.Errorf("documentProtection should precede evenAndOddHeaders, got <%s> first", first.Tag)
+	}
+
+	if err := s.Protect(enum.WdProtectionTypeAllowOnlyComments, ""); err != nil {
+		t.Fatalf("Protect: %v", err)
+	}
+	dp = s.DocumentProtection()
+	if dp.Hash() != "" || !dp.VerifyPassword("") {
+		t.Error("protection without a password should store no hash")
+	}
+	if err := s.Protect(enum.WdProtectionTypeNoProtection, ""); err != nil {
+		t.Fatalf("Protect: %v", err)
+	}
+	if s.DocumentProtection() != nil {
+		t.Error("NoProtection should remove w:documentProtection")
+	}
+}
+
+func TestCT_DocProtect_VerifyIsoForm(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+	hash := iteratedProtectionHash(crypto.SHA256, "pw", salt, 10)
+	dp := &CT_DocProtect{Element{E: OxmlElement("w:documentProtection")}}
+	dp.SetAlgorithmName("SHA-256")
+	dp.SetSaltValue(base64.StdEncoding.EncodeToString(salt))
+	dp.SetHashValue(base64.StdEncoding.EncodeToString(hash))
+	dp.SetSpinCount(10)
+	if !dp.VerifyPassword("pw") || dp.VerifyPassword("Pw") {
+		t.Error("VerifyPassword does not match the ISO-form hash")
+	}
+}
+
+func TestCT_DocProtect_VerifySpinCountOutOfRange(t *testing.T) {
+	s := &CT_Settings{Element{E: OxmlElement("w:settings")}}
+	if err := s.Protect(enum.WdProtectionTypeAllowOnlyReading, "s3cret"); err != nil {
+		t.Fatal(err)
+	}
+	dp := s.DocumentProtection()
+	for _, spin := range []string{"-1", "10000001", "2147483647"} {
+		dp.E.CreateAttr("w:cryptSpinCount", spin)
+		if dp.VerifyPassword("s3cret") {
+			t.Errorf("w:cryptSpinCount=%s: VerifyPassword succeeded", spin)
+		}
+	}
+
+	iso := &CT_DocProtect{Element{E: OxmlElement("w:documentProtection")}}
+	iso.SetAlgorithmName("SHA-256")
+	iso.SetSaltValue(base64.StdEncoding.EncodeToString([]byte("salt")))
+	iso.SetHashValue(base64.StdEncoding.EncodeToString([]byte("hash")))
+	iso.E.CreateAttr("w:spinCount", "2147483647")
+	if iso.VerifyPassword("pw") {
+		t.Error("w:spinCount=2147483647: VerifyPassword succeeded")
+	}
+}
+
+func TestCT_Settings_SetWriteProtection(t *testing.T) {
+	s := &CT_Settings{Element{E: OxmlElement("w:settings")}}
+	if err := s.Protect(enum.WdProtectionTypeAllowOnlyFormFields, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetWriteProtection(true, "open-sesame"); err != nil {
+		t.Fatal(err)
+	}
+	wp := s.WriteProtection()
+	if wp == nil || !wp.Recommended() || !wp.VerifyPassword("open-sesame") {
+		t.Fatalf("unexpected write protection: %v", wp)
+	}
+	if first := s.E.ChildElements()[0]; first.Tag != "writeProtection" {
+		t.Errorf("writeProtection should be the first child, got <%s>", first.Tag)
+	}
+}
+
+// --- Permission range tests ---
+
+func TestCT_Body_AddPermRange(t *testing.T) {
+	doc := &CT_Document{Element{E: OxmlElement("w:document")}}
+	body := doc.GetOrAddBody()
+	p1, p2, p3 := body.AddP(), body.AddP(), body.AddP()
+	body.GetOrAddSectPr()
+
+	start, err := body.AddPermRange(p2.E, p3.E, enum.WdEditorTypeEveryone)
+	if err != nil {
+		t.Fatalf("AddPermRange: %v", err)
+	}
+	if id, _ := start.Id(); id != "0" || *start.EdGrp() != enum.WdEditorTypeEveryone {
+		t.Errorf("unexpected permStart: %s", start.Xml())
+	}
+	var tags []string
+	for _, c := range body.E.ChildElements() {
+		tags = append(tags, c.Tag)
+	}
+	want := "p permStart p p permEnd sectPr"
+	if got := strings.Join(tags, " "); got != want {
+		t.Errorf("body children = %q, want %q", got, want)
+	}
+
+	second := p1.AddPermRange(enum.WdEditorTypeEditors)
+	if id, _ := second.Id(); id != "1" {
+		t.Errorf("second range id = %q, want 1", id)
+	}
+	if _, err := body.AddPermRange(p3.E, p2.E, enum.WdEditorTypeEveryone); err == nil {
+		t.Error("expected an error for an inverted range")
+	}
+}
+
+// --- Settings accessor tests ---
+
+func TestCT_Settings_ChildOrder(t *testing.T) {
+	el, err := ParseXml([]byte(`<w:settings xmlns:w="` + Nsmap["w"] + `" xmlns:w14="` + Nsmap["w14"] + `">` +
+		`<w:proofState w:spelling="clean"/><w:characterSpacingControl w:val="doNotCompress"/>` +
+		`<w:decimalSymbol w:val="."/><w14:docId w14:val="1"/></w:settings>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &CT_Settings{Element{E: el}}
+	on, tab := true, docx.Inches(0.5)
+	s.GetOrAddRsids().AddVal("00A1B2C3")
+	s.SetCompatibilityMode(15)
+	s.SetUpdateFieldsVal(&on)
+	s.SetEvenAndOddHeadersVal(&on)
+	s.SetAutoHyphenationVal(&on)
+	s.SetDefaultTabStopVal(&tab)
+	s.SetDoNotTrackMovesVal(&on)
+	s.SetTrackRevisionsVal(&on)
+	s.SetGutterAtTopVal(&on)
+	s.SetMirrorMarginsVal(&on)
+	s.SetZoomPercent(120)
+
+	var tags []string
+	for _, c := range s.E.ChildElements() {
+		tags = append(tags, c.Tag)
+	}
+	want := "zoom mirrorMargins gutterAtTop proofState trackRevisions doNotTrackMoves defaultTabStop " +
+		"autoHyphenation evenAndOddHeaders characterSpacingControl updateFields compat rsids decimalSymbol docId"
+	if got := strings.Join(tags, " "); got != want {
+		t.Errorf("settings children =\n  %s\nwant\n  %s", got, want)
+	}
+	if v, _ := s.DefaultTabStop().GetAttr("w:val"); v != "720" {
+		t.Errorf("w:defaultTabStop/@w:val = %q, want 720 twips", v)
+	}
+	if s.CompatibilityMode() != 15 || !s.UpdateFieldsVal() || s.ZoomPercent() != 120 || *s.DefaultTabStopVal() != tab {
+		t.Errorf("accessors do not read back: %s", s.Xml())
+	}
+
+	off := false
+	s.SetMirrorMarginsVal(&off)
+	s.SetZoomPercent(0)
+	if s.MirrorMargins() != nil || s.Zoom() != nil {
+		t.Error("clearing a setting should remove its element")
+	}
+}
+
+func TestCT_Settings_CompatibilityMode(t *testing.T) {
+	s := &CT_Settings{Element{E: OxmlElement("w:settings")}}
+	if s.CompatibilityMode() != 0 {
+		t.Error("expected no compatibility mode")
+	}
+	s.SetCompatibilityMode(14)
+	s.GetOrAddCompat().SetSetting("enableOpenTypeFeatures", "1")
+	s.SetCompatibilityMode(15)
+	if got := len(s.Compat().CompatSettingList()); got != 2 {
+		t.Errorf("compat settings = %d, want 2", got)
+	}
+	if s.CompatibilityMode() != 15 {
+		t.Errorf("CompatibilityMode() = %d, want 15", s.CompatibilityMode())
+	}
+	s.SetCompatibilityMode(0)
+	if _, ok := s.Compat().SettingVal(compatModeName); ok {
+		t.Error("compatibilityMode should be removed")
+	}
+}
+
+func TestCT_Settings_Rsids(t *testing.T) {
+	s := &CT_Settings{Element{E: OxmlElement("w:settings")}}
+	rsids := s.GetOrAddRsids()
+	rsids.AddVal("00B47730")
+	rsids.AddVal("00034616")
+	rsids.AddVal("00b47730")
+	v, err := s.NewRsid()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(v) != 8 || !strings.HasPrefix(v, "00") {
+		t.Errorf("NewRsid() = %q", v)
+	}
+	vals := rsids.Vals()
+	if len(vals) != 3 {
+		t.Fatalf("rsids = %v, want 3 unique values", vals)
+	}
+	for i := 1; i < len(vals); i++ {
+		if vals[i-1] > vals[i] {
+			t.Errorf("rsids not sorted: %v", vals)
+		}
+	}
+	if root, _ := rsids.RsidRoot().Val(); root != v {
+		t.Errorf("rsidRoot = %q, want %q", root, v)
+	}
+}
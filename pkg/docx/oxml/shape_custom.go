@@ -1,6 +1,14 @@
 package oxml
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/beevik/etree"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
+)
 
 // ===========================================================================
 // CT_Inline — custom methods
@@ -17,6 +25,13 @@ func NewPicInline(shapeId int, rId, filename string, cx, cy int64) *CT_Inline {
 
 // newInline creates a <wp:inline> skeleton and fills it with the given values.
 func newInline(cx, cy int64, shapeId int, pic *CT_Picture) *CT_Inline {
+	return newGraphicInline(cx, cy, shapeId, fmt.Sprintf("Picture %d", shapeId),
+		"http://schemas.openxmlformats.org/drawingml/2006/picture", pic.E)
+}
+
+// newGraphicInline creates a <wp:inline> of cx by cy EMU whose graphic data
+// of type uri holds content.
+func newGraphicInline(cx, cy int64, shapeId int, name, uri string, content *etree.Element) *CT_Inline {
 	xml := fmt.Sprintf(
 		`<wp:inline `+
 			`xmlns:wp="http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing" `+
@@ -45,13 +60,12 @@ func newInline(cx, cy int64, shapeId int, pic *CT_Picture) *CT_Inline {
 
 	// Set docPr
 	inline.DocPr().SetId(shapeId)
-	inline.DocPr().SetName(fmt.Sprintf("Picture %d", shapeId))
+	inline.DocPr().SetName(name)
 
-	// Set graphic data URI and insert the picture element
+	// Set graphic data URI and insert the content element
 	gd := inline.Graphic().GraphicData()
-	gd.SetUri("http://schemas.openxmlformats.org/drawingml/2006/picture")
-	// Insert pic:pic into graphicData
-	gd.E.AddChild(pic.E)
+	gd.SetUri(uri)
+	gd.E.AddChild(content)
 
 	return inline
 }
@@ -120,6 +134,261 @@ func (i *CT_Inline) SetExtentCy(v int64) {
 	i.Extent().SetCy(v)
 }
 
+// ToAnchor converts this inline shape into a floating one positioned by
+// opts, replacing it in its parent <w:drawing>. The extent, docPr and
+// graphic are moved to the new <wp:anchor>.
+func (i *CT_Inline) ToAnchor(opts AnchorOptions) *CT_Anchor {
+	a := newAnchorSkeleton()
+	for _, tag := range []string{"wp:extent", "wp:docPr", "wp:cNvGraphicFramePr", "a:graphic"} {
+		src, dst := i.FindChild(tag), a.FindChild(tag)
+		if src == nil || dst == nil {
+			continue
+		}
+		i.E.RemoveChild(src)
+		insertBefore(a.E, src, dst)
+		a.E.RemoveChild(dst)
+	}
+	a.SetOptions(opts)
+	if parent := i.E.Parent(); parent != nil {
+		insertBefore(parent, a.E, i.E)
+		parent.RemoveChild(i.E)
+	}
+	return a
+}
+
+// ===========================================================================
+// CT_Anchor — custom methods
+// ===========================================================================
+
+// AnchorOptions describes where a floating shape is placed and how text
+// wraps around it. All distances are in EMU.
+type AnchorOptions struct {
+	// RelativeH is what the horizontal position is measured from. When
+	// AlignH is non-nil the shape is aligned within that area, otherwise it
+	// is placed OffsetX from its leading edge.
+	RelativeH enum.WdRelativeHorizontalPosition
+	AlignH    *enum.WdShapePosition
+	OffsetX   int64
+
+	// RelativeV, AlignV and OffsetY are the vertical counterparts.
+	RelativeV enum.WdRelativeVerticalPosition
+	AlignV    *enum.WdShapePosition
+	OffsetY   int64
+
+	// Wrap is how text flows around the shape.
+	Wrap enum.WdWrapType
+
+	// DistT, DistB, DistL and DistR are the minimum distances between the
+	// shape and the surrounding text.
+	DistT, DistB, DistL, DistR int64
+
+	// ZOrder is the shape's relative stacking order; shapes with higher
+	// values are drawn in front of those with lower ones.
+	ZOrder int64
+
+	// AllowOverlap lets the shape overlap other floating shapes.
+	AllowOverlap bool
+	// LayoutInCell positions a shape inside a table cell relative to the cell.
+	LayoutInCell bool
+	// Locked keeps the shape's anchor in its paragraph when it is moved.
+	Locked bool
+}
+
+// NewPicAnchor creates a new <wp:anchor> element containing a <pic:pic>
+// element for a floating image, positioned by opts. The other parameters
+// are as for NewPicInline.
+func NewPicAnchor(shapeId int, rId, filename string, cx, cy int64, opts AnchorOptions) *CT_Anchor {
+	a := newAnchorSkeleton()
+	a.Extent().SetCx(cx)
+	a.Extent().SetCy(cy)
+	a.DocPr().SetId(shapeId)
+	a.DocPr().SetName(fmt.Sprintf("Picture %d", shapeId))
+	gd := a.Graphic().GraphicData()
+	gd.SetUri("http://schemas.openxmlformats.org/drawingml/2006/picture")
+	gd.E.AddChild(newPicture(0, filename, rId, cx, cy).E)
+	a.SetOptions(opts)
+	return a
+}
+
+// newAnchorSkeleton creates a <wp:anchor> with every required child, in
+// the form Word writes.
+func newAnchorSkeleton() *CT_Anchor {
+	xml := `<wp:anchor ` +
+		`xmlns:wp="http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing" ` +
+		`xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" ` +
+		`xmlns:pic="http://schemas.openxmlformats.org/drawingml/2006/picture" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" ` +
+		`distT="0" distB="0" distL="0" distR="0" simplePos="0" relativeHeight="0" ` +
+		`behindDoc="0" locked="0" layoutInCell="0" allowOverlap="0">` +
+		`<wp:simplePos x="0" y="0"/>` +
+		`<wp:positionH relativeFrom="column"><wp:posOffset>0</wp:posOffset></wp:positionH>` +
+		`<wp:positionV relativeFrom="paragraph"><wp:posOffset>0</wp:posOffset></wp:positionV>` +
+		`<wp:extent cx="914400" cy="914400"/>` +
+		`<wp:effectExtent l="0" t="0" r="0" b="0"/>` +
+		`<wp:wrapSquare wrapText="bothSides"/>` +
+		`<wp:docPr id="666" name="unnamed"/>` +
+		`<wp:cNvGraphicFramePr>` +
+		`<a:graphicFrameLocks noChangeAspect="1"/>` +
+		`</wp:cNvGraphicFramePr>` +
+		`<a:graphic>` +
+		`<a:graphicData uri="URI not set"/>` +
+		`</a:graphic>` +
+		`</wp:anchor>`
+	el, err := ParseXml([]byte(xml))
+	if err != nil {
+		panic(fmt.Sprintf("shape_custom: failed to parse anchor XML: %v", err))
+	}
+	return &CT_Anchor{Element{E: el}}
+}
+
+// SetOptions positions the shape and sets its wrapping as described by opts.
+func (a *CT_Anchor) SetOptions(opts AnchorOptions) {
+	posH := a.PositionH()
+	posH.SetRelativeFrom(opts.RelativeH)
+	setPosition(&posH.Element, opts.AlignH, opts.OffsetX)
+	posV := a.PositionV()
+	posV.SetRelativeFrom(opts.RelativeV)
+	setPosition(&posV.Element, opts.AlignV, opts.OffsetY)
+
+	// Distances are attributes Word always writes, so they are set
+	// directly rather than through the setters that drop zero values.
+	a.SetAttr("distT", formatInt64Attr(opts.DistT))
+	a.SetAttr("distB", formatInt64Attr(opts.DistB))
+	a.SetAttr("distL", formatInt64Attr(opts.DistL))
+	a.SetAttr("distR", formatInt64Attr(opts.DistR))
+	a.SetRelativeHeight(opts.ZOrder)
+	a.SetAllowOverlap(opts.AllowOverlap)
+	a.SetLayoutInCell(opts.LayoutInCell)
+	a.SetLocked(opts.Locked)
+	a.SetWrapType(opts.Wrap)
+}
+
+// Options returns the position and wrapping of the shape.
+func (a *CT_Anchor) Options() AnchorOptions {
+	posH, posV := a.PositionH(), a.PositionV()
+	opts := AnchorOptions{
+		DistT: a.DistT(), DistB: a.DistB(), DistL: a.DistL(), DistR: a.DistR(),
+		Wrap: a.WrapType(),
+	}
+	opts.RelativeH, _ = posH.RelativeFrom()
+	opts.AlignH, opts.OffsetX = position(&posH.Element)
+	opts.RelativeV, _ = posV.RelativeFrom()
+	opts.AlignV, opts.OffsetY = position(&posV.Element)
+	opts.ZOrder, _ = a.RelativeHeight()
+	opts.AllowOverlap, _ = a.AllowOverlap()
+	opts.LayoutInCell, _ = a.LayoutInCell()
+	opts.Locked, _ = a.Locked()
+	return opts
+}
+
+// WrapType returns how text wraps around the shape. A missing wrap element
+// is treated as wrapNone.
+func (a *CT_Anchor) WrapType() enum.WdWrapType {
+	switch {
+	case a.WrapSquare() != nil:
+		return enum.WdWrapTypeSquare
+	case a.WrapTight() != nil:
+		return enum.WdWrapTypeTight
+	case a.WrapThrough() != nil:
+		return enum.WdWrapTypeThrough
+	case a.WrapTopAndBottom() != nil:
+		return enum.WdWrapTypeTopBottom
+	}
+	if behind, _ := a.BehindDoc(); behind {
+		return enum.WdWrapTypeBehind
+	}
+	return enum.WdWrapTypeFront
+}
+
+// SetWrapType replaces the wrap element of the shape. Front and Behind both
+// use <wp:wrapNone> and set behindDoc accordingly; tight and through
+// wrapping get a rectangular wrap polygon.
+func (a *CT_Anchor) SetWrapType(wrap enum.WdWrapType) {
+	a.SetBehindDoc(wrap == enum.WdWrapTypeBehind)
+	if a.WrapType() == wrap {
+		return
+	}
+	switch wrap {
+	case enum.WdWrapTypeSquare:
+		a.GetOrChangeToWrapSquare().SetWrapText("bothSides")
+	case enum.WdWrapTypeTight:
+		w := a.GetOrChangeToWrapTight()
+		w.SetWrapText("bothSides")
+		w.E.AddChild(newRectWrapPolygon().E)
+	case enum.WdWrapTypeThrough:
+		w := a.GetOrChangeToWrapThrough()
+		w.SetWrapText("bothSides")
+		w.E.AddChild(newRectWrapPolygon().E)
+	case enum.WdWrapTypeTopBottom:
+		a.GetOrChangeToWrapTopAndBottom()
+	default:
+		a.GetOrChangeToWrapNone()
+	}
+}
+
+// newRectWrapPolygon creates a <wp:wrapPolygon> following the shape's
+// bounding box.
+func newRectWrapPolygon() *CT_WrapPath {
+	el := OxmlElement("wp:wrapPolygon")
+	wp := &CT_WrapPath{Element{E: el}}
+	wp.SetEdited(false)
+	start := OxmlElement("wp:start")
+	el.AddChild(start)
+	wp.Start().SetX(0)
+	wp.Start().SetY(0)
+	for _, pt := range [][2]int64{{0, 21600}, {21600, 21600}, {21600, 0}, {0, 0}} {
+		lt := wp.AddLineTo()
+		lt.SetX(pt[0])
+		lt.SetY(pt[1])
+	}
+	return wp
+}
+
+// ExtentCx returns the width of the floating shape in EMU.
+func (a *CT_Anchor) ExtentCx() int64 {
+	v, _ := a.Extent().Cx()
+	return v
+}
+
+// ExtentCy returns the height of the floating shape in EMU.
+func (a *CT_Anchor) ExtentCy() int64 {
+	v, _ := a.Extent().Cy()
+	return v
+}
+
+// SetExtentCx sets the width of the floating shape in EMU.
+func (a *CT_Anchor) SetExtentCx(v int64) {
+	a.Extent().SetCx(v)
+}
+
+// SetExtentCy sets the height of the floating shape in EMU.
+func (a *CT_Anchor) SetExtentCy(v int64) {
+	a.Extent().SetCy(v)
+}
+
+// setPosition replaces the wp:align or wp:posOffset child of a positionH
+// or positionV element.
+func setPosition(pos *Element, align *enum.WdShapePosition, offset int64) {
+	pos.RemoveAll("wp:align", "wp:posOffset")
+	if align != nil {
+		pos.AddSubElement("wp:align").SetText(align.ToXml())
+		return
+	}
+	pos.AddSubElement("wp:posOffset").SetText(formatInt64Attr(offset))
+}
+
+// position reads the wp:align or wp:posOffset child of a positionH or
+// positionV element.
+func position(pos *Element) (*enum.WdShapePosition, int64) {
+	if align := pos.FindChild("wp:align"); align != nil {
+		return parseOptionalEnum(strings.TrimSpace(align.Text()), enum.WdShapePositionFromXml), 0
+	}
+	if offset := pos.FindChild("wp:posOffset"); offset != nil {
+		return nil, parseInt64Attr(offset.Text())
+	}
+	return nil, 0
+}
+
 // ===========================================================================
 // CT_ShapeProperties — custom methods
 // ===========================================================================
@@ -195,3 +464,139 @@ func (t *CT_Transform2D) SetCyVal(v int64) {
 	ext := t.GetOrAddExt()
 	ext.SetCy(v)
 }
+
+// PresetGeometry returns the preset shape name of <a:prstGeom>, e.g.
+// "rect" or "roundRect", or "" when the shape has no preset geometry.
+func (sp *CT_ShapeProperties) PresetGeometry() string {
+	pg := sp.PrstGeom()
+	if pg == nil {
+		return ""
+	}
+	v, _ := pg.Prst()
+	return v
+}
+
+// SetPresetGeometry sets the preset shape to prst, replacing any custom
+// geometry. An empty prst removes <a:prstGeom>. It fails when prst is not
+// an ST_ShapeType name.
+func (sp *CT_ShapeProperties) SetPresetGeometry(prst string) error {
+	if prst == "" {
+		sp.RemovePrstGeom()
+		return nil
+	}
+	if !IsPresetShapeType(prst) {
+		return fmt.Errorf("oxml: unknown preset shape type %q", prst)
+	}
+	if cust := sp.FindChild("a:custGeom"); cust != nil {
+		sp.E.RemoveChild(cust)
+	}
+	pg := sp.GetOrAddPrstGeom()
+	pg.SetPrst(prst)
+	pg.GetOrAddAvLst()
+	return nil
+}
+
+// FillColor returns the RGB fill color of the shape, or nil when it has no
+// solid RGB fill.
+func (sp *CT_ShapeProperties) FillColor() *docx.RGBColor {
+	return solidFillColor(sp.SolidFill())
+}
+
+// SetFillColor fills the shape with the RGB color v. A nil v sets
+// <a:noFill/>, making the shape transparent.
+func (sp *CT_ShapeProperties) SetFillColor(v *docx.RGBColor) {
+	if v == nil {
+		sp.GetOrChangeToNoFill()
+		return
+	}
+	sp.GetOrChangeToSolidFill().GetOrChangeToSrgbClr().SetVal(v.String())
+}
+
+// LineColor returns the RGB outline color of the shape, or nil when it has
+// no solid RGB outline.
+func (sp *CT_ShapeProperties) LineColor() *docx.RGBColor {
+	ln := sp.Ln()
+	if ln == nil {
+		return nil
+	}
+	return solidFillColor(ln.SolidFill())
+}
+
+// SetLineColor outlines the shape in the RGB color v with a line width of w
+// EMU; a zero w leaves the width to the consumer. A nil v sets
+// <a:ln><a:noFill/></a:ln>, removing the outline.
+func (sp *CT_ShapeProperties) SetLineColor(v *docx.RGBColor, w int64) {
+	ln := sp.GetOrAddLn()
+	if v == nil {
+		ln.SetW(0)
+		ln.GetOrChangeToNoFill()
+		return
+	}
+	ln.SetW(w)
+	ln.GetOrChangeToSolidFill().GetOrChangeToSrgbClr().SetVal(v.String())
+}
+
+// solidFillColor returns the RGB value of fill, or nil when fill is nil,
+// uses a theme color or holds a malformed value.
+func solidFillColor(fill *CT_SolidColorFillProperties) *docx.RGBColor {
+	if fill == nil || fill.SrgbClr() == nil {
+		return nil
+	}
+	v, err := fill.SrgbClr().Val()
+	if err != nil {
+		return nil
+	}
+	c, err := docx.RGBColorFromString(v)
+	if err != nil {
+		return nil
+	}
+	return &c
+}
+
+// presetShapeTypes holds the ST_ShapeType values, the preset geometries a
+// DrawingML consumer knows how to draw.
+var presetShapeTypes = func() map[string]bool {
+	m := map[string]bool{}
+	for _, name := range []string{
+		"line", "lineInv", "triangle", "rtTriangle", "rect", "diamond", "parallelogram", "trapezoid", "nonIsoscelesTrapezoid",
+		"pentagon", "hexagon", "heptagon", "octagon", "decagon", "dodecagon",
+		"star4", "star5", "star6", "star7", "star8", "star10", "star12", "star16", "star24", "star32",
+		"roundRect", "round1Rect", "round2SameRect", "round2DiagRect", "snipRoundRect", "snip1Rect", "snip2SameRect", "snip2DiagRect",
+		"plaque", "ellipse", "teardrop", "homePlate", "chevron", "pieWedge", "pie", "blockArc", "donut", "noSmoking",
+		"rightArrow", "leftArrow", "upArrow", "downArrow", "stripedRightArrow", "notchedRightArrow", "bentUpArrow",
+		"leftRightArrow", "upDownArrow", "leftUpArrow", "leftRightUpArrow", "quadArrow",
+		"leftArrowCallout", "rightArrowCallout", "upArrowCallout", "downArrowCallout", "leftRightArrowCallout", "upDownArrowCallout", "quadArrowCallout",
+		"bentArrow", "uturnArrow", "circularArrow", "leftCircularArrow", "leftRightCircularArrow",
+		"curvedRightArrow", "curvedLeftArrow", "curvedUpArrow", "curvedDownArrow", "swooshArrow",
+		"cube", "can", "lightningBolt", "heart", "sun", "moon", "smileyFace", "irregularSeal1", "irregularSeal2",
+		"foldedCorner", "bevel", "frame", "halfFrame", "corner", "diagStripe", "chord", "arc",
+		"leftBracket", "rightBracket", "leftBrace", "rightBrace", "bracketPair", "bracePair",
+		"straightConnector1", "bentConnector2", "bentConnector3", "bentConnector4", "bentConnector5",
+		"curvedConnector2", "curvedConnector3", "curvedConnector4", "curvedConnector5",
+		"callout1", "callout2", "callout3", "accentCallout1", "accentCallout2", "accentCallout3",
+		"borderCallout1", "borderCallout2", "borderCallout3", "accentBorderCallout1", "accentBorderCallout2", "accentBorderCallout3",
+		"wedgeRectCallout", "wedgeRoundRectCallout", "wedgeEllipseCallout", "cloudCallout", "cloud",
+		"ribbon", "ribbon2", "ellipseRibbon", "ellipseRibbon2", "leftRightRibbon", "verticalScroll", "horizontalScroll",
+		"wave", "doubleWave", "plus",
+		"flowChartProcess", "flowChartDecision", "flowChartInputOutput", "flowChartPredefinedProcess", "flowChartInternalStorage",
+		"flowChartDocument", "flowChartMultidocument", "flowChartTerminator", "flowChartPreparation", "flowChartManualInput",
+		"flowChartManualOperation", "flowChartConnector", "flowChartPunchedCard", "flowChartPunchedTape", "flowChartSummingJunction",
+		"flowChartOr", "flowChartCollate", "flowChartSort", "flowChartExtract", "flowChartMerge", "flowChartOfflineStorage",
+		"flowChartOnlineStorage", "flowChartMagneticTape", "flowChartMagneticDisk", "flowChartMagneticDrum", "flowChartDisplay",
+		"flowChartDelay", "flowChartAlternateProcess", "flowChartOffpageConnector",
+		"actionButtonBlank", "actionButtonHome", "actionButtonHelp", "actionButtonInformation", "actionButtonForwardNext",
+		"actionButtonBackPrevious", "actionButtonEnd", "actionButtonBeginning", "actionButtonReturn", "actionButtonDocument",
+		"actionButtonSound", "actionButtonMovie",
+		"gear6", "gear9", "funnel", "mathPlus", "mathMinus", "mathMultiply", "mathDivide", "mathEqual", "mathNotEqual",
+		"cornerTabs", "squareTabs", "plaqueTabs", "chartX", "chartStar", "chartPlus",
+	} {
+		m[name] = true
+	}
+	return m
+}()
+
+// IsPresetShapeType reports whether prst is an ST_ShapeType value such as
+// "rect" or "roundRect".
+func IsPresetShapeType(prst string) bool {
+	return presetShapeTypes[prst]
+}
@@ -520,15 +520,7 @@ func (tc *CT_Tc) SetVAlignVal(v *enum.WdCellVerticalAlignment) {
 
 // InnerContentElements returns all w:p and w:tbl direct children in document order.
 func (tc *CT_Tc) InnerContentElements() []interface{} {
-	var result []interface{}
-	for _, child := range tc.E.ChildElements() {
-		if child.Space == "w" && child.Tag == "p" {
-			result = append(result, &CT_P{Element{E: child}})
-		} else if child.Space == "w" && child.Tag == "tbl" {
-			result = append(result, &CT_Tbl{Element{E: child}})
-		}
-	}
-	return result
+	return blockContent(tc.Children())
 }
 
 // IterBlockItems generates all block-level content elements: w:p, w:tbl, w:sdt.
@@ -3,6 +3,7 @@ package oxml
 import (
 	"testing"
 
+	"github.com/user/go-docx/pkg/docx"
 	"github.com/user/go-docx/pkg/docx/enum"
 )
 
@@ -391,30 +392,28 @@ func TestCT_Tc_Merge_Horizontal(t *testing.T) {
 // Section tests
 // ===========================================================================
 
-func TestCT_SectPr_PageWidth_RoundTrip(t *testing.T) {
+func TestCT_SectPr_PageSize_RoundTrip(t *testing.T) {
 	sp := &CT_SectPr{Element{E: OxmlElement("w:sectPr")}}
-	if v := sp.PageWidth(); v != nil {
-		t.Errorf("expected nil, got %d", *v)
+	if sp.PgSz() != nil {
+		t.Error("expected no pgSz")
+	}
+	w, h := docx.Inches(8.5), docx.Inches(11)
+	sp.GetOrAddPgSz().SetW(&w)
+	sp.GetOrAddPgSz().SetH(&h)
+	if v, _ := sp.PgSz().GetAttr("w:w"); v != "12240" {
+		t.Errorf("w:w = %q, want twips", v)
 	}
-	w := 12240
-	sp.SetPageWidth(&w)
-	got := sp.PageWidth()
-	if got == nil || *got != 12240 {
-		t.Errorf("expected 12240, got %v", got)
+	if got := sp.PgSz().H(); got == nil || *got != h {
+		t.Errorf("H() = %v, want %v", got, h)
 	}
-	sp.SetPageWidth(nil)
-	if v := sp.PageWidth(); v != nil {
+	sp.PgSz().SetW(nil)
+	if v := sp.PgSz().W(); v != nil {
 		t.Errorf("expected nil after clear, got %v", *v)
 	}
-}
-
-func TestCT_SectPr_PageHeight_RoundTrip(t *testing.T) {
-	sp := &CT_SectPr{Element{E: OxmlElement("w:sectPr")}}
-	h := 15840
-	sp.SetPageHeight(&h)
-	got := sp.PageHeight()
-	if got == nil || *got != 15840 {
-		t.Errorf("expected 15840, got %v", got)
+	// Universal measures are read too.
+	sp.PgSz().SetAttr("w:w", "8.5in")
+	if got := sp.PgSz().W(); got == nil || *got != w {
+		t.Errorf("W() of 8.5in = %v, want %v", got, w)
 	}
 }
 
@@ -471,67 +470,59 @@ func TestCT_SectPr_TitlePg_RoundTrip(t *testing.T) {
 
 func TestCT_SectPr_Margins_RoundTrip(t *testing.T) {
 	sp := &CT_SectPr{Element{E: OxmlElement("w:sectPr")}}
-
-	top := 1440
-	sp.SetTopMargin(&top)
-	got := sp.TopMargin()
-	if got == nil || *got != 1440 {
-		t.Errorf("top: expected 1440, got %v", got)
-	}
-
-	bottom := 1440
-	sp.SetBottomMargin(&bottom)
-	if got := sp.BottomMargin(); got == nil || *got != 1440 {
-		t.Errorf("bottom: expected 1440, got %v", got)
-	}
-
-	left := 1800
-	sp.SetLeftMargin(&left)
-	if got := sp.LeftMargin(); got == nil || *got != 1800 {
-		t.Errorf("left: expected 1800, got %v", got)
-	}
-
-	right := 1800
-	sp.SetRightMargin(&right)
-	if got := sp.RightMargin(); got == nil || *got != 1800 {
-		t.Errorf("right: expected 1800, got %v", got)
-	}
-
-	hdr := 720
-	sp.SetHeaderMargin(&hdr)
-	if got := sp.HeaderMargin(); got == nil || *got != 720 {
-		t.Errorf("header: expected 720, got %v", got)
-	}
-
-	ftr := 720
-	sp.SetFooterMargin(&ftr)
-	if got := sp.FooterMargin(); got == nil || *got != 720 {
-		t.Errorf("footer: expected 720, got %v", got)
+	pgMar := sp.GetOrAddPgMar()
+	margins := []struct {
+		attr string
+		get  func() *docx.Length
+		set  func(*docx.Length)
+		v    docx.Length
+		twip string
+	}{
+		{"w:top", pgMar.Top, pgMar.SetTop, docx.Inches(1), "1440"},
+		{"w:bottom", pgMar.Bottom, pgMar.SetBottom, docx.Inches(1), "1440"},
+		{"w:left", pgMar.Left, pgMar.SetLeft, docx.Inches(1.25), "1800"},
+		{"w:right", pgMar.Right, pgMar.SetRight, docx.Inches(1.25), "1800"},
+		{"w:header", pgMar.Header, pgMar.SetHeader, docx.Inches(0.5), "720"},
+		{"w:footer", pgMar.Footer, pgMar.SetFooter, docx.Inches(0.5), "720"},
+		{"w:gutter", pgMar.Gutter, pgMar.SetGutter, 0, "0"},
+	}
+	for _, m := range margins {
+		if got := m.get(); got != nil {
+			t.Errorf("%s: expected nil, got %v", m.attr, *got)
+		}
+		m.set(&m.v)
+		if got := m.get(); got == nil || *got != m.v {
+			t.Errorf("%s: expected %v, got %v", m.attr, m.v, got)
+		}
+		if v, _ := pgMar.GetAttr(m.attr); v != m.twip {
+			t.Errorf("%s = %q, want %q", m.attr, v, m.twip)
+		}
+		m.set(nil)
+		if _, ok := pgMar.GetAttr(m.attr); ok {
+			t.Errorf("%s: expected attribute removed", m.attr)
+		}
 	}
-
-	gut := 0
-	sp.SetGutterMargin(&gut)
 }
 
 func TestCT_SectPr_Clone(t *testing.T) {
 	sp := &CT_SectPr{Element{E: OxmlElement("w:sectPr")}}
-	w := 12240
-	sp.SetPageWidth(&w)
+	w := docx.Twips(12240)
+	sp.GetOrAddPgSz().SetW(&w)
 	sp.E.CreateAttr("w:rsidR", "00A12345")
 
 	cloned := sp.Clone()
 	// Width should be preserved
-	if cw := cloned.PageWidth(); cw == nil || *cw != 12240 {
-		t.Errorf("expected cloned width 12240, got %v", cw)
+	if cw := cloned.PgSz().W(); cw == nil || *cw != w {
+		t.Errorf("expected cloned width %v, got %v", w, cw)
 	}
 	// rsid should be removed
 	if _, ok := cloned.GetAttr("w:rsidR"); ok {
 		t.Error("expected rsid attribute to be removed in clone")
 	}
 	// Modifying clone shouldn't affect original
-	w2 := 9999
-	cloned.SetPageWidth(&w2)
-	if orig := sp.PageWidth(); orig == nil || *orig != 12240 {
+	w2 := docx.Twips(9999)
+	cloned.PgSz().SetW(&w2)
+	if orig := sp.PgSz().W(); orig == nil || *orig != w {
 		t.Error("original should be unchanged")
 	}
 }
@@ -1,8 +1,10 @@
 package oxml
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/user/go-docx/pkg/docx"
 	"github.com/user/go-docx/pkg/docx/enum"
 )
 
@@ -363,11 +365,14 @@ func TestCT_RPr_SzVal(t *testing.T) {
 		t.Error("expected nil sz for new rPr")
 	}
 
-	var sz int64 = 24 // 12pt in half-points
+	sz := docx.Pt(12)
 	rPr.SetSzVal(&sz)
 	got := rPr.SzVal()
-	if got == nil || *got != 24 {
-		t.Errorf("expected 24, got %v", got)
+	if got == nil || *got != sz {
+		t.Errorf("expected 12pt, got %v", got)
+	}
+	if raw, _ := rPr.Sz().GetAttr("w:val"); raw != "24" {
+		t.Errorf("expected w:val=\"24\" half-points, got %q", raw)
 	}
 
 	rPr.SetSzVal(nil)
@@ -397,6 +402,205 @@ func TestCT_RPr_RFontsAscii(t *testing.T) {
 	}
 }
 
+func TestCT_RPr_RFontsScripts(t *testing.T) {
+	rPr := &CT_RPr{Element{E: OxmlElement("w:rPr")}}
+
+	ea, cs := "MS Mincho", "Arial"
+	rPr.SetRFontsEastAsia(&ea)
+	rPr.SetRFontsCs(&cs)
+	if got := rPr.RFontsEastAsia(); got == nil || *got != ea {
+		t.Errorf("expected %s, got %v", ea, got)
+	}
+	if got := rPr.RFontsCs(); got == nil || *got != cs {
+		t.Errorf("expected %s, got %v", cs, got)
+	}
+	if rPr.RFontsAscii() != nil {
+		t.Error("expected nil ascii font")
+	}
+
+	rPr.SetRFontsEastAsia(nil)
+	if rPr.RFontsEastAsia() != nil || rPr.RFonts() == nil {
+		t.Error("expected only the eastAsia attribute removed")
+	}
+	rPr.SetRFontsCs(nil)
+	if rPr.RFonts() != nil {
+		t.Error("expected rFonts removed with its last attribute")
+	}
+}
+
+func TestCT_RPr_RFontsTheme(t *testing.T) {
+	rPr := &CT_RPr{Element{E: OxmlElement("w:rPr")}}
+
+	if rPr.RFontsAsciiTheme() != nil {
+		t.Error("expected nil theme font for new rPr")
+	}
+	major, minor := enum.WdThemeFontMajorEastAsia, enum.WdThemeFontMinorBidi
+	rPr.SetRFontsEastAsiaTheme(&major)
+	rPr.SetRFontsCsTheme(&minor)
+	if got := rPr.RFontsEastAsiaTheme(); got == nil || *got != major {
+		t.Errorf("expected majorEastAsia, got %v", got)
+	}
+	if v, _ := rPr.RFonts().GetAttr("w:cstheme"); v != "minorBidi" {
+		t.Errorf("w:cstheme = %q, want minorBidi", v)
+	}
+
+	rPr.SetRFontsEastAsiaTheme(nil)
+	rPr.SetRFontsCsTheme(nil)
+	if rPr.RFonts() != nil {
+		t.Error("expected rFonts removed with its last attribute")
+	}
+}
+
+func TestCT_RPr_Lang(t *testing.T) {
+	rPr := &CT_RPr{Element{E: OxmlElement("w:rPr")}}
+
+	en, ja, ar := "en-US", "ja-JP", "ar-SA"
+	rPr.SetLangVal(&en)
+	rPr.SetLangEastAsia(&ja)
+	rPr.SetLangBidi(&ar)
+	if got := rPr.LangEastAsia(); got == nil || *got != ja {
+		t.Errorf("expected %s, got %v", ja, got)
+	}
+	if got := rPr.LangBidi(); got == nil || *got != ar {
+		t.Errorf("expected %s, got %v", ar, got)
+	}
+	if got := rPr.LangVal(); got == nil || *got != en {
+		t.Errorf("expected %s, got %v", en, got)
+	}
+
+	rPr.SetLangEastAsia(nil)
+	if rPr.LangEastAsia() != nil || rPr.LangBidi() == nil {
+		t.Error("expected only the eastAsia attribute removed")
+	}
+	rPr.SetLangVal(nil)
+	if rPr.Lang() != nil {
+		t.Error("expected lang removed")
+	}
+}
+
+func TestCT_RPr_ShdFill(t *testing.T) {
+	rPr := &CT_RPr{Element{E: OxmlElement("w:rPr")}}
+
+	if rPr.ShdFill() != nil {
+		t.Error("expected nil fill for new rPr")
+	}
+	fill := docx.NewRGBColor(0xFF, 0xFF, 0x00)
+	rPr.SetShdFill(&fill)
+	if got := rPr.ShdFill(); got == nil || *got != fill {
+		t.Errorf("expected %v, got %v", fill, got)
+	}
+	if v, _ := rPr.Shd().Val(); v != "clear" {
+		t.Errorf("w:shd/@w:val = %q, want clear", v)
+	}
+
+	rPr.SetShdFill(nil)
+	if rPr.Shd() != nil {
+		t.Error("expected shd removed")
+	}
+}
+
+func TestCT_RPr_SetBdrSingle(t *testing.T) {
+	rPr := &CT_RPr{Element{E: OxmlElement("w:rPr")}}
+
+	rPr.SetBdrSingle(docx.Pt(1), nil)
+	bdr := rPr.Bdr()
+	if v, _ := bdr.Val(); v != "single" {
+		t.Errorf("w:bdr/@w:val = %q, want single", v)
+	}
+	if v, _ := bdr.GetAttr("w:sz"); v != "8" {
+		t.Errorf("w:bdr/@w:sz = %q, want 8 eighth-points", v)
+	}
+	if v, _ := bdr.GetAttr("w:color"); v != "auto" {
+		t.Errorf("w:bdr/@w:color = %q, want auto", v)
+	}
+}
+
+func TestCT_RPr_ScaleVal_Percent(t *testing.T) {
+	rPr := &CT_RPr{Element{E: OxmlElement("w:rPr")}}
+	rPr.GetOrAddW().SetAttr("w:val", "150%")
+	if got := rPr.ScaleVal(); got == nil || *got != 150 {
+		t.Errorf("ScaleVal() of 150%% = %v, want 150", got)
+	}
+	if errs := Validate(rPr.E); len(errs) != 0 {
+		t.Errorf("Validate() = %v", errs)
+	}
+
+	scale := 80
+	rPr.SetScaleVal(&scale)
+	if raw, _ := rPr.W().GetAttr("w:val"); raw != "80" {
+		t.Errorf("w:w/@w:val = %q, want 80", raw)
+	}
+	rPr.W().SetAttr("w:val", "12.5%")
+	if errs := Validate(rPr.E); len(errs) != 1 {
+		t.Errorf("Validate() of a fractional scale = %v", errs)
+	}
+}
+
+func TestCT_RPr_FullCoverage_SchemaOrder(t *testing.T) {
+	rPr := &CT_RPr{Element{E: OxmlElement("w:rPr")}}
+
+	lang := "zh-CN"
+	on := true
+	scale := 150
+	szCs := docx.Pt(14)
+	em := enum.WdEmphasisMarkOverSolidCircle
+	effect := enum.WdAnimationShimmer
+	spacing, kern, position := docx.Pt(1.5), docx.Pt(12), docx.Pt(-3)
+
+	// Set in reverse schema order; each child must land in sequence.
+	rPr.SetLangVal(&lang)
+	rPr.SetEmphasisMarkVal(&em)
+	rPr.SetComplexScriptVal(&on)
+	rPr.SetRtlVal(&on)
+	rPr.GetOrAddFitText().SetVal(docx.Inches(1))
+	grey := docx.NewRGBColor(0xEE, 0xEE, 0xEE)
+	rPr.SetShdFill(&grey)
+	rPr.SetBdrSingle(docx.Pt(0.5), nil)
+	rPr.SetEffectVal(&effect)
+	rPr.SetSzCsVal(&szCs)
+	rPr.SetPositionVal(&position)
+	rPr.SetKernVal(&kern)
+	rPr.SetScaleVal(&scale)
+	rPr.SetCharSpacingVal(&spacing)
+	rPr.SetItalicCsVal(&on)
+	rPr.SetBoldCsVal(&on)
+	rPr.GetOrAddEastAsianLayout().SetVert(true)
+	ea := "SimSun"
+	rPr.SetRFontsEastAsia(&ea)
+
+	var tags []string
+	for _, child := range rPr.E.ChildElements() {
+		tags = append(tags, child.Tag)
+	}
+	want := []string{"rFonts", "bCs", "iCs", "spacing", "w", "kern", "position", "szCs", "effect",
+		"bdr", "shd", "fitText", "rtl", "cs", "em", "lang", "eastAsianLayout"}
+	if strings.Join(tags, " ") != strings.Join(want, " ") {
+		t.Errorf("children = %v, want %v", tags, want)
+	}
+	if errs := Validate(rPr.E); len(errs) != 0 {
+		t.Errorf("Validate: %v", errs)
+	}
+
+	if got := rPr.CharSpacingVal(); got == nil || *got != spacing {
+		t.Errorf("CharSpacingVal = %v, want %v", got, spacing)
+	}
+	if v, _ := rPr.Spacing().GetAttr("w:val"); v != "30" {
+		t.Errorf("w:spacing/@w:val = %q, want 30 twips", v)
+	}
+	if got := rPr.PositionVal(); got == nil || *got != position {
+		t.Errorf("PositionVal = %v, want %v", got, position)
+	}
+	if v, _ := rPr.Position().GetAttr("w:val"); v != "-6" {
+		t.Errorf("w:position/@w:val = %q, want -6 half-points", v)
+	}
+	if got := rPr.EmphasisMarkVal(); got == nil || *got != em {
+		t.Errorf("EmphasisMarkVal = %v, want %v", got, em)
+	}
+	if v, _ := rPr.Em().GetAttr("w:val"); v != "dot" {
+		t.Errorf("w:em/@w:val = %q, want dot", v)
+	}
+}
+
 func TestCT_RPr_StyleVal(t *testing.T) {
 	rPrEl := OxmlElement("w:rPr")
 	rPr := &CT_RPr{Element{E: rPrEl}}
@@ -464,15 +668,18 @@ func TestCT_PPr_SpacingBefore_RoundTrip(t *testing.T) {
 	pPrEl := OxmlElement("w:pPr")
 	pPr := &CT_PPr{Element{E: pPrEl}}
 
-	if pPr.SpacingBefore() != nil {
-		t.Error("expected nil spacing before for new pPr")
+	if pPr.Spacing() != nil {
+		t.Error("expected nil spacing for new pPr")
 	}
 
-	v := 240 // 240 twips
-	pPr.SetSpacingBefore(&v)
-	got := pPr.SpacingBefore()
-	if got == nil || *got != 240 {
-		t.Errorf("expected 240, got %v", got)
+	v := docx.Twips(240)
+	pPr.GetOrAddSpacing().SetBefore(&v)
+	got := pPr.Spacing().Before()
+	if got == nil || *got != v {
+		t.Errorf("expected %d, got %v", v, got)
+	}
+	if raw := pPr.Spacing().E.SelectAttrValue("w:before", ""); raw != "240" {
+		t.Errorf("expected w:before=\"240\", got %q", raw)
 	}
 }
 
@@ -480,11 +687,11 @@ func TestCT_PPr_SpacingAfter_RoundTrip(t *testing.T) {
 	pPrEl := OxmlElement("w:pPr")
 	pPr := &CT_PPr{Element{E: pPrEl}}
 
-	v := 120
-	pPr.SetSpacingAfter(&v)
-	got := pPr.SpacingAfter()
-	if got == nil || *got != 120 {
-		t.Errorf("expected 120, got %v", got)
+	v := docx.Twips(120)
+	pPr.GetOrAddSpacing().SetAfter(&v)
+	got := pPr.Spacing().After()
+	if got == nil || *got != v {
+		t.Errorf("expected %d, got %v", v, got)
 	}
 }
 
@@ -505,15 +712,15 @@ func TestCT_PPr_IndLeft_RoundTrip(t *testing.T) {
 	pPrEl := OxmlElement("w:pPr")
 	pPr := &CT_PPr{Element{E: pPrEl}}
 
-	if pPr.IndLeft() != nil {
+	if pPr.Ind() != nil {
 		t.Error("expected nil indent for new pPr")
 	}
 
-	v := 720 // 720 twips = 0.5 inch
-	pPr.SetIndLeft(&v)
-	got := pPr.IndLeft()
-	if got == nil || *got != 720 {
-		t.Errorf("expected 720, got %v", got)
+	v := docx.Twips(720) // 0.5 inch
+	pPr.GetOrAddInd().SetLeft(&v)
+	got := pPr.Ind().Left()
+	if got == nil || *got != docx.Inches(0.5) {
+		t.Errorf("expected 0.5in, got %v", got)
 	}
 }
 
@@ -522,19 +729,22 @@ func TestCT_PPr_FirstLineIndent(t *testing.T) {
 	pPr := &CT_PPr{Element{E: pPrEl}}
 
 	// Positive first-line indent
-	v := 360
+	v := docx.Twips(360)
 	pPr.SetFirstLineIndent(&v)
 	got := pPr.FirstLineIndent()
-	if got == nil || *got != 360 {
-		t.Errorf("expected 360, got %v", got)
+	if got == nil || *got != v {
+		t.Errorf("expected %d, got %v", v, got)
 	}
 
 	// Negative (hanging) indent
-	neg := -720
+	neg := docx.Twips(-720)
 	pPr.SetFirstLineIndent(&neg)
 	got = pPr.FirstLineIndent()
-	if got == nil || *got != -720 {
-		t.Errorf("expected -720 (hanging), got %v", got)
+	if got == nil || *got != neg {
+		t.Errorf("expected %d (hanging), got %v", neg, got)
+	}
+	if raw := pPr.Ind().E.SelectAttrValue("w:hanging", ""); raw != "720" {
+		t.Errorf("expected w:hanging=\"720\", got %q", raw)
 	}
 
 	// Nil clears both
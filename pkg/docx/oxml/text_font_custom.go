@@ -1,257 +1,15 @@
 package oxml
 
 import (
+	"github.com/beevik/etree"
+	"github.com/user/go-docx/pkg/docx"
 	"github.com/user/go-docx/pkg/docx/enum"
 )
 
 // --- CT_RPr custom methods ---
 
-// getBoolVal reads a tri-state boolean value from a CT_OnOff child element.
-//   - nil: element not present (inherit)
-//   - *true: element present with val=true or val absent (e.g. <w:b/>)
-//   - *false: element present with val=false (e.g. <w:b w:val="false"/>)
-func (rPr *CT_RPr) getBoolVal(tag string) *bool {
-	child := rPr.FindChild(tag)
-	if child == nil {
-		return nil
-	}
-	onOff := &CT_OnOff{Element{E: child}}
-	v := onOff.Val()
-	return &v
-}
-
-// setBoolVal sets a tri-state boolean for a CT_OnOff child element.
-//   - nil: remove the element
-//   - *true: add element without val attr (e.g. <w:b/>)
-//   - *false: add element with val="false" (e.g. <w:b w:val="false"/>)
-//
-// getOrAdd and remove are function params to handle the correct child tag.
-func (rPr *CT_RPr) setBoolValWith(val *bool, getOrAdd func() *CT_OnOff, remove func()) {
-	if val == nil {
-		remove()
-		return
-	}
-	el := getOrAdd()
-	el.SetVal(*val)
-}
-
-// --- Bold ---
-
-// BoldVal returns the tri-state bold value: nil (inherit), *true, or *false.
-func (rPr *CT_RPr) BoldVal() *bool {
-	return rPr.getBoolVal("w:b")
-}
-
-// SetBoldVal sets the bold tri-state.
-func (rPr *CT_RPr) SetBoldVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddB, rPr.RemoveB)
-}
-
-// --- Italic ---
-
-// ItalicVal returns the tri-state italic value.
-func (rPr *CT_RPr) ItalicVal() *bool {
-	return rPr.getBoolVal("w:i")
-}
-
-// SetItalicVal sets the italic tri-state.
-func (rPr *CT_RPr) SetItalicVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddI, rPr.RemoveI)
-}
-
-// --- Caps ---
-
-// CapsVal returns the tri-state all-caps value.
-func (rPr *CT_RPr) CapsVal() *bool {
-	return rPr.getBoolVal("w:caps")
-}
-
-// SetCapsVal sets the all-caps tri-state.
-func (rPr *CT_RPr) SetCapsVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddCaps, rPr.RemoveCaps)
-}
-
-// --- SmallCaps ---
-
-// SmallCapsVal returns the tri-state small-caps value.
-func (rPr *CT_RPr) SmallCapsVal() *bool {
-	return rPr.getBoolVal("w:smallCaps")
-}
-
-// SetSmallCapsVal sets the small-caps tri-state.
-func (rPr *CT_RPr) SetSmallCapsVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddSmallCaps, rPr.RemoveSmallCaps)
-}
-
-// --- Strike ---
-
-// StrikeVal returns the tri-state strikethrough value.
-func (rPr *CT_RPr) StrikeVal() *bool {
-	return rPr.getBoolVal("w:strike")
-}
-
-// SetStrikeVal sets the strikethrough tri-state.
-func (rPr *CT_RPr) SetStrikeVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddStrike, rPr.RemoveStrike)
-}
-
-// --- Dstrike (double strikethrough) ---
-
-// DstrikeVal returns the tri-state double-strikethrough value.
-func (rPr *CT_RPr) DstrikeVal() *bool {
-	return rPr.getBoolVal("w:dstrike")
-}
-
-// SetDstrikeVal sets the double-strikethrough tri-state.
-func (rPr *CT_RPr) SetDstrikeVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddDstrike, rPr.RemoveDstrike)
-}
-
-// --- Outline ---
-
-// OutlineVal returns the tri-state outline value.
-func (rPr *CT_RPr) OutlineVal() *bool {
-	return rPr.getBoolVal("w:outline")
-}
-
-// SetOutlineVal sets the outline tri-state.
-func (rPr *CT_RPr) SetOutlineVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddOutline, rPr.RemoveOutline)
-}
-
-// --- Shadow ---
-
-// ShadowVal returns the tri-state shadow value.
-func (rPr *CT_RPr) ShadowVal() *bool {
-	return rPr.getBoolVal("w:shadow")
-}
-
-// SetShadowVal sets the shadow tri-state.
-func (rPr *CT_RPr) SetShadowVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddShadow, rPr.RemoveShadow)
-}
-
-// --- Emboss ---
-
-// EmbossVal returns the tri-state emboss value.
-func (rPr *CT_RPr) EmbossVal() *bool {
-	return rPr.getBoolVal("w:emboss")
-}
-
-// SetEmbossVal sets the emboss tri-state.
-func (rPr *CT_RPr) SetEmbossVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddEmboss, rPr.RemoveEmboss)
-}
-
-// --- Imprint ---
-
-// ImprintVal returns the tri-state imprint value.
-func (rPr *CT_RPr) ImprintVal() *bool {
-	return rPr.getBoolVal("w:imprint")
-}
-
-// SetImprintVal sets the imprint tri-state.
-func (rPr *CT_RPr) SetImprintVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddImprint, rPr.RemoveImprint)
-}
-
-// --- NoProof ---
-
-// NoProofVal returns the tri-state noProof value.
-func (rPr *CT_RPr) NoProofVal() *bool {
-	return rPr.getBoolVal("w:noProof")
-}
-
-// SetNoProofVal sets the noProof tri-state.
-func (rPr *CT_RPr) SetNoProofVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddNoProof, rPr.RemoveNoProof)
-}
-
-// --- SnapToGrid ---
-
-// SnapToGridVal returns the tri-state snapToGrid value.
-func (rPr *CT_RPr) SnapToGridVal() *bool {
-	return rPr.getBoolVal("w:snapToGrid")
-}
-
-// SetSnapToGridVal sets the snapToGrid tri-state.
-func (rPr *CT_RPr) SetSnapToGridVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddSnapToGrid, rPr.RemoveSnapToGrid)
-}
-
-// --- Vanish ---
-
-// VanishVal returns the tri-state vanish value.
-func (rPr *CT_RPr) VanishVal() *bool {
-	return rPr.getBoolVal("w:vanish")
-}
-
-// SetVanishVal sets the vanish tri-state.
-func (rPr *CT_RPr) SetVanishVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddVanish, rPr.RemoveVanish)
-}
-
-// --- WebHidden ---
-
-// WebHiddenVal returns the tri-state webHidden value.
-func (rPr *CT_RPr) WebHiddenVal() *bool {
-	return rPr.getBoolVal("w:webHidden")
-}
-
-// SetWebHiddenVal sets the webHidden tri-state.
-func (rPr *CT_RPr) SetWebHiddenVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddWebHidden, rPr.RemoveWebHidden)
-}
-
-// --- SpecVanish ---
-
-// SpecVanishVal returns the tri-state specVanish value.
-func (rPr *CT_RPr) SpecVanishVal() *bool {
-	return rPr.getBoolVal("w:specVanish")
-}
-
-// SetSpecVanishVal sets the specVanish tri-state.
-func (rPr *CT_RPr) SetSpecVanishVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddSpecVanish, rPr.RemoveSpecVanish)
-}
-
-// --- OMath ---
-
-// OMathVal returns the tri-state oMath value.
-func (rPr *CT_RPr) OMathVal() *bool {
-	return rPr.getBoolVal("w:oMath")
-}
-
-// SetOMathVal sets the oMath tri-state.
-func (rPr *CT_RPr) SetOMathVal(v *bool) {
-	rPr.setBoolValWith(v, rPr.GetOrAddOMath, rPr.RemoveOMath)
-}
-
 // --- Color ---
 
-// ColorVal returns the hex color string from w:color/@w:val, or nil if not present.
-func (rPr *CT_RPr) ColorVal() *string {
-	color := rPr.Color()
-	if color == nil {
-		return nil
-	}
-	val, err := color.Val()
-	if err != nil {
-		return nil
-	}
-	return &val
-}
-
-// SetColorVal sets the color hex value. Passing nil removes the color element.
-func (rPr *CT_RPr) SetColorVal(v *string) {
-	if v == nil {
-		rPr.RemoveColor()
-		return
-	}
-	color := rPr.GetOrAddColor()
-	color.SetVal(*v)
-}
-
 // ColorTheme returns the theme color from w:color/@w:themeColor, or nil if not present.
 func (rPr *CT_RPr) ColorTheme() *enum.MsoThemeColorIndex {
 	color := rPr.Color()
@@ -285,31 +43,6 @@ func (rPr *CT_RPr) SetColorTheme(v *enum.MsoThemeColorIndex) {
 	}
 }
 
-// --- Size ---
-
-// SzVal returns the font size from w:sz/@w:val as half-points, or nil if not present.
-func (rPr *CT_RPr) SzVal() *int64 {
-	sz := rPr.Sz()
-	if sz == nil {
-		return nil
-	}
-	val, err := sz.Val()
-	if err != nil {
-		return nil
-	}
-	return &val
-}
-
-// SetSzVal sets the font size in half-points. Passing nil removes the sz element.
-func (rPr *CT_RPr) SetSzVal(v *int64) {
-	if v == nil {
-		rPr.RemoveSz()
-		return
-	}
-	sz := rPr.GetOrAddSz()
-	sz.SetVal(*v)
-}
-
 // --- Fonts ---
 
 // RFontsAscii returns the ascii font name, or nil if not present.
@@ -361,82 +94,252 @@ func (rPr *CT_RPr) SetRFontsHAnsi(v *string) {
 	}
 }
 
-// --- Underline ---
+// RFontsEastAsia returns the East Asian font name, or nil if not present.
+func (rPr *CT_RPr) RFontsEastAsia() *string {
+	return rPr.rFontsAttr((*CT_Fonts).EastAsia)
+}
 
-// UVal returns the underline style from w:u/@w:val, or nil if not present.
-func (rPr *CT_RPr) UVal() *string {
-	u := rPr.U()
-	if u == nil {
+// SetRFontsEastAsia sets the East Asian font name. Passing nil removes the
+// eastAsia attribute.
+func (rPr *CT_RPr) SetRFontsEastAsia(v *string) {
+	rPr.setRFontsAttr((*CT_Fonts).SetEastAsia, v)
+}
+
+// RFontsCs returns the complex script font name, or nil if not present.
+func (rPr *CT_RPr) RFontsCs() *string {
+	return rPr.rFontsAttr((*CT_Fonts).Cs)
+}
+
+// SetRFontsCs sets the complex script font name. Passing nil removes the cs
+// attribute.
+func (rPr *CT_RPr) SetRFontsCs(v *string) {
+	rPr.setRFontsAttr((*CT_Fonts).SetCs, v)
+}
+
+// RFontsAsciiTheme returns the theme font used for ASCII characters, or nil
+// if not present. A theme font takes precedence over the ascii font name.
+func (rPr *CT_RPr) RFontsAsciiTheme() *enum.WdThemeFont {
+	return rPr.rFontsTheme((*CT_Fonts).AsciiTheme)
+}
+
+// SetRFontsAsciiTheme sets the theme font used for ASCII characters.
+// Passing nil removes the asciiTheme attribute.
+func (rPr *CT_RPr) SetRFontsAsciiTheme(v *enum.WdThemeFont) {
+	rPr.setRFontsTheme((*CT_Fonts).SetAsciiTheme, v)
+}
+
+// RFontsHAnsiTheme returns the theme font used for high ANSI characters,
+// or nil if not present.
+func (rPr *CT_RPr) RFontsHAnsiTheme() *enum.WdThemeFont {
+	return rPr.rFontsTheme((*CT_Fonts).HAnsiTheme)
+}
+
+// SetRFontsHAnsiTheme sets the theme font used for high ANSI characters.
+// Passing nil removes the hAnsiTheme attribute.
+func (rPr *CT_RPr) SetRFontsHAnsiTheme(v *enum.WdThemeFont) {
+	rPr.setRFontsTheme((*CT_Fonts).SetHAnsiTheme, v)
+}
+
+// RFontsEastAsiaTheme returns the theme font used for East Asian
+// characters, or nil if not present.
+func (rPr *CT_RPr) RFontsEastAsiaTheme() *enum.WdThemeFont {
+	return rPr.rFontsTheme((*CT_Fonts).EastAsiaTheme)
+}
+
+// SetRFontsEastAsiaTheme sets the theme font used for East Asian
+// characters. Passing nil removes the eastAsiaTheme attribute.
+func (rPr *CT_RPr) SetRFontsEastAsiaTheme(v *enum.WdThemeFont) {
+	rPr.setRFontsTheme((*CT_Fonts).SetEastAsiaTheme, v)
+}
+
+// RFontsCsTheme returns the theme font used for complex script characters,
+// or nil if not present.
+func (rPr *CT_RPr) RFontsCsTheme() *enum.WdThemeFont {
+	return rPr.rFontsTheme((*CT_Fonts).CsTheme)
+}
+
+// SetRFontsCsTheme sets the theme font used for complex script characters.
+// Passing nil removes the cstheme attribute.
+func (rPr *CT_RPr) SetRFontsCsTheme(v *enum.WdThemeFont) {
+	rPr.setRFontsTheme((*CT_Fonts).SetCsTheme, v)
+}
+
+// rFontsAttr returns the rFonts attribute get reads, or nil if rFonts or the
+// attribute is not present.
+func (rPr *CT_RPr) rFontsAttr(get func(*CT_Fonts) string) *string {
+	rFonts := rPr.RFonts()
+	if rFonts == nil {
 		return nil
 	}
-	v := u.Val()
+	v := get(rFonts)
 	if v == "" {
 		return nil
 	}
 	return &v
 }
 
-// SetUVal sets the underline style. Passing nil removes the u element.
-func (rPr *CT_RPr) SetUVal(v *string) {
-	rPr.RemoveU()
-	if v != nil {
-		u := rPr.addU()
-		u.SetVal(*v)
+// setRFontsAttr sets an rFonts attribute through set. Passing nil removes
+// the attribute, and rFonts too once it has no attributes left.
+func (rPr *CT_RPr) setRFontsAttr(set func(*CT_Fonts, string), v *string) {
+	if v == nil {
+		if rFonts := rPr.RFonts(); rFonts != nil {
+			set(rFonts, "")
+			rPr.removeRFontsIfEmpty()
+		}
+		return
 	}
+	set(rPr.GetOrAddRFonts(), *v)
 }
 
-// --- Highlight ---
-
-// HighlightVal returns the highlight color string, or nil if not present.
-func (rPr *CT_RPr) HighlightVal() *string {
-	h := rPr.Highlight()
-	if h == nil {
-		return nil
-	}
-	v, err := h.Val()
-	if err != nil {
+func (rPr *CT_RPr) rFontsTheme(get func(*CT_Fonts) *enum.WdThemeFont) *enum.WdThemeFont {
+	rFonts := rPr.RFonts()
+	if rFonts == nil {
 		return nil
 	}
-	return &v
+	return get(rFonts)
 }
 
-// SetHighlightVal sets the highlight color. Passing nil removes the highlight element.
-func (rPr *CT_RPr) SetHighlightVal(v *string) {
+func (rPr *CT_RPr) setRFontsTheme(set func(*CT_Fonts, *enum.WdThemeFont), v *enum.WdThemeFont) {
 	if v == nil {
-		rPr.RemoveHighlight()
+		if rFonts := rPr.RFonts(); rFonts != nil {
+			set(rFonts, nil)
+			rPr.removeRFontsIfEmpty()
+		}
 		return
 	}
-	h := rPr.GetOrAddHighlight()
-	h.SetVal(*v)
+	set(rPr.GetOrAddRFonts(), v)
+}
+
+func (rPr *CT_RPr) removeRFontsIfEmpty() {
+	if rFonts := rPr.RFonts(); rFonts != nil && !hasValueAttrs(rFonts.E) {
+		rPr.RemoveRFonts()
+	}
+}
+
+// hasValueAttrs reports whether e has attributes other than namespace
+// declarations.
+func hasValueAttrs(e *etree.Element) bool {
+	for _, attr := range e.Attr {
+		if attr.Space != "xmlns" && attr.FullKey() != "xmlns" {
+			return true
+		}
+	}
+	return false
+}
+
+// --- Language ---
+
+// LangEastAsia returns the East Asian language from w:lang/@w:eastAsia, or
+// nil if not present.
+func (rPr *CT_RPr) LangEastAsia() *string {
+	return rPr.langAttr((*CT_Language).EastAsia)
+}
+
+// SetLangEastAsia sets the East Asian language, such as "ja-JP". Passing
+// nil removes the eastAsia attribute.
+func (rPr *CT_RPr) SetLangEastAsia(v *string) {
+	rPr.setLangAttr((*CT_Language).SetEastAsia, v)
 }
 
-// --- Style ---
+// LangBidi returns the complex script language from w:lang/@w:bidi, or nil
+// if not present.
+func (rPr *CT_RPr) LangBidi() *string {
+	return rPr.langAttr((*CT_Language).Bidi)
+}
 
-// StyleVal returns the run style string from w:rStyle/@w:val, or nil if not present.
-func (rPr *CT_RPr) StyleVal() *string {
-	rStyle := rPr.RStyle()
-	if rStyle == nil {
+// SetLangBidi sets the complex script language, such as "ar-SA". Passing
+// nil removes the bidi attribute.
+func (rPr *CT_RPr) SetLangBidi(v *string) {
+	rPr.setLangAttr((*CT_Language).SetBidi, v)
+}
+
+func (rPr *CT_RPr) langAttr(get func(*CT_Language) string) *string {
+	lang := rPr.Lang()
+	if lang == nil {
 		return nil
 	}
-	v, err := rStyle.Val()
-	if err != nil {
+	v := get(lang)
+	if v == "" {
 		return nil
 	}
 	return &v
 }
 
-// SetStyleVal sets the run style. Passing nil removes the rStyle element.
-func (rPr *CT_RPr) SetStyleVal(v *string) {
-	if v == nil {
-		rPr.RemoveRStyle()
+func (rPr *CT_RPr) setLangAttr(set func(*CT_Language, string), v *string) {
+	if v != nil {
+		set(rPr.GetOrAddLang(), *v)
 		return
 	}
-	rStyle := rPr.RStyle()
-	if rStyle == nil {
-		s := rPr.addRStyle()
-		s.SetVal(*v)
+	if lang := rPr.Lang(); lang != nil {
+		set(lang, "")
+		if !hasValueAttrs(lang.E) {
+			rPr.RemoveLang()
+		}
+	}
+}
+
+// --- Shading ---
+
+// ShdFill returns the fill color of the run shading, or nil if the run is
+// not shaded or the fill is "auto".
+func (rPr *CT_RPr) ShdFill() *docx.RGBColor {
+	shd := rPr.Shd()
+	if shd == nil {
+		return nil
+	}
+	return shd.Fill()
+}
+
+// SetShdFill shades the run with a solid fill color, replacing any shading
+// pattern. Passing nil removes the shd element.
+func (rPr *CT_RPr) SetShdFill(v *docx.RGBColor) {
+	rPr.RemoveShd()
+	if v != nil {
+		shd := rPr.addShd()
+		shd.SetVal("clear")
+		shd.SetAttr("w:color", "auto")
+		shd.SetFill(v)
+	}
+}
+
+// --- Border ---
+
+// SetBdrSingle draws a single-line border of width sz around the run.
+// Passing nil for color uses the automatic color. Use GetOrAddBdr for other
+// border styles.
+func (rPr *CT_RPr) SetBdrSingle(sz docx.Length, color *docx.RGBColor) {
+	rPr.RemoveBdr()
+	bdr := rPr.addBdr()
+	bdr.SetVal("single")
+	bdr.SetSz(&sz)
+	if color == nil {
+		bdr.SetAttr("w:color", "auto")
 	} else {
-		rStyle.SetVal(*v)
+		bdr.SetColor(color)
+	}
+}
+
+// --- Underline ---
+
+// UVal returns the underline style from w:u/@w:val, or nil if not present.
+func (rPr *CT_RPr) UVal() *string {
+	u := rPr.U()
+	if u == nil {
+		return nil
+	}
+	v := u.Val()
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+// SetUVal sets the underline style. Passing nil removes the u element.
+func (rPr *CT_RPr) SetUVal(v *string) {
+	rPr.RemoveU()
+	if v != nil {
+		u := rPr.addU()
+		u.SetVal(*v)
 	}
 }
 
@@ -53,11 +53,10 @@ func (p *CT_P) ClearContent() {
 // in document order.
 func (p *CT_P) InnerContentElements() []interface{} {
 	var result []interface{}
-	for _, child := range p.E.ChildElements() {
-		if child.Space == "w" && child.Tag == "r" {
-			result = append(result, &CT_R{Element{E: child}})
-		} else if child.Space == "w" && child.Tag == "hyperlink" {
-			result = append(result, &CT_Hyperlink{Element{E: child}})
+	for _, n := range p.Children() {
+		switch n.(type) {
+		case *CT_R, *CT_Hyperlink:
+			result = append(result, n)
 		}
 	}
 	return result
@@ -127,3 +126,17 @@ func (p *CT_P) ParagraphText() string {
 	}
 	return sb.String()
 }
+
+// AddPermRange makes the content of this paragraph an editable exception in
+// a protected document, placing w:permStart after the paragraph properties
+// and w:permEnd after the last run. See CT_Body.AddPermRange.
+func (p *CT_P) AddPermRange(editors enum.WdEditorType) *CT_PermStart {
+	start, end := newPermRange(p.E, editors)
+	idx := 0
+	if pPr := p.PPr(); pPr != nil {
+		idx = pPr.E.Index() + 1
+	}
+	p.E.InsertChildAt(idx, start.E)
+	p.E.AddChild(end.E)
+	return start
+}
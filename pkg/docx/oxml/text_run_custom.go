@@ -26,6 +26,22 @@ func (r *CT_R) AddDrawingWithInline(inline *CT_Inline) *CT_Drawing {
 	return drawing
 }
 
+// AddDrawingWithAnchor adds a <w:drawing> element containing the given
+// floating shape.
+func (r *CT_R) AddDrawingWithAnchor(anchor *CT_Anchor) *CT_Drawing {
+	drawing := r.addDrawing()
+	drawing.E.AddChild(anchor.E)
+	return drawing
+}
+
+// AddFloatingPicture adds a floating picture positioned by opts to this
+// run. The parameters are as for NewPicAnchor.
+func (r *CT_R) AddFloatingPicture(shapeId int, rId, filename string, cx, cy int64, opts AnchorOptions) *CT_Anchor {
+	anchor := NewPicAnchor(shapeId, rId, filename, cx, cy, opts)
+	r.AddDrawingWithAnchor(anchor)
+	return anchor
+}
+
 // ClearContent removes all child elements except <w:rPr>.
 func (r *CT_R) ClearContent() {
 	var toRemove []*etree.Element
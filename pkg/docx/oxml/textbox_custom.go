@@ -0,0 +1,349 @@
+package oxml
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/beevik/etree"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
+)
+
+// ===========================================================================
+// Text boxes — custom methods
+// ===========================================================================
+
+// TextBox is a text box found in, or added to, a run. Word writes a text
+// box as an <mc:AlternateContent> whose <mc:Choice> holds a DrawingML
+// <wps:wsp> shape and whose <mc:Fallback> holds the same text in a VML
+// <w:pict> for older consumers.
+type TextBox struct {
+	// Shape is the DrawingML shape, or nil for a VML-only text box.
+	Shape *CT_WordprocessingShape
+	// Content is the block-level content of the text box. It belongs to
+	// Shape when present, otherwise to the VML shape.
+	Content *CT_TxbxContent
+	// Fallback is the VML copy of Content, or nil when there is none.
+	Fallback *CT_TxbxContent
+}
+
+// SyncFallback replaces the content of the VML fallback with a copy of
+// Content. Call it after editing Content so both renderings agree.
+func (tb *TextBox) SyncFallback() {
+	if tb.Fallback == nil || tb.Content == nil || tb.Fallback.E == tb.Content.E {
+		return
+	}
+	for _, child := range tb.Fallback.E.ChildElements() {
+		tb.Fallback.E.RemoveChild(child)
+	}
+	for _, child := range tb.Content.E.ChildElements() {
+		tb.Fallback.E.AddChild(child.Copy())
+	}
+}
+
+// ShapeOptions describes the appearance of a DrawingML shape.
+type ShapeOptions struct {
+	// Preset is the ST_ShapeType preset geometry name, e.g. "rect",
+	// "roundRect" or "ellipse". Empty means "rect".
+	Preset string
+	// FillColor is the fill color; nil leaves the shape unfilled.
+	FillColor *docx.RGBColor
+	// LineColor is the outline color; nil draws no outline.
+	LineColor *docx.RGBColor
+	// LineWidth is the outline width in EMU; zero leaves it to the consumer.
+	LineWidth int64
+}
+
+// TextBoxes returns the text boxes in this run in document order, whether
+// written as <mc:AlternateContent>, a bare <w:drawing> or a bare VML
+// <w:pict>.
+func (r *CT_R) TextBoxes() []*TextBox {
+	var result []*TextBox
+	for _, child := range r.E.ChildElements() {
+		switch {
+		case child.Space == "mc" && child.Tag == "AlternateContent":
+			result = append(result, alternateContentTextBoxes(child)...)
+		case child.Space == "w" && child.Tag == "drawing":
+			result = append(result, drawingTextBoxes(child)...)
+		case child.Space == "w" && child.Tag == "pict":
+			for _, c := range vmlTxbxContents(child) {
+				result = append(result, &TextBox{Content: c})
+			}
+		}
+	}
+	return result
+}
+
+// TextBoxes returns the text boxes anchored in the runs of this paragraph,
+// including runs inside hyperlinks.
+func (p *CT_P) TextBoxes() []*TextBox {
+	var result []*TextBox
+	for _, child := range p.E.ChildElements() {
+		if child.Space != "w" {
+			continue
+		}
+		switch child.Tag {
+		case "r":
+			result = append(result, (&CT_R{Element{E: child}}).TextBoxes()...)
+		case "hyperlink":
+			for _, r := range child.ChildElements() {
+				if r.Space == "w" && r.Tag == "r" {
+					result = append(result, (&CT_R{Element{E: r}}).TextBoxes()...)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// alternateContentTextBoxes reads the text boxes of an <mc:AlternateContent>,
+// pairing each DrawingML shape in the choice with the VML text box at the
+// same position in the fallback.
+func alternateContentTextBoxes(ac *etree.Element) []*TextBox {
+	var boxes []*TextBox
+	var fallbacks []*CT_TxbxContent
+	for _, child := range ac.ChildElements() {
+		if child.Space != "mc" {
+			continue
+		}
+		switch child.Tag {
+		case "Choice":
+			if boxes == nil {
+				for _, d := range child.ChildElements() {
+					if d.Space == "w" && d.Tag == "drawing" {
+						boxes = append(boxes, drawingTextBoxes(d)...)
+					}
+				}
+			}
+		case "Fallback":
+			for _, pict := range child.ChildElements() {
+				if pict.Space == "w" && pict.Tag == "pict" {
+					fallbacks = append(fallbacks, vmlTxbxContents(pict)...)
+				}
+			}
+		}
+	}
+	if boxes == nil {
+		for _, c := range fallbacks {
+			boxes = append(boxes, &TextBox{Content: c})
+		}
+		return boxes
+	}
+	for i, tb := range boxes {
+		if i < len(fallbacks) {
+			tb.Fallback = fallbacks[i]
+		}
+	}
+	return boxes
+}
+
+// drawingTextBoxes returns a TextBox for each <wps:wsp> with a text box
+// inside the given <w:drawing>, including shapes nested in groups.
+func drawingTextBoxes(drawing *etree.Element) []*TextBox {
+	var result []*TextBox
+	for _, el := range drawing.FindElements(".//wps:wsp") {
+		wsp := &CT_WordprocessingShape{Element{E: el}}
+		if txbx := wsp.Txbx(); txbx != nil {
+			result = append(result, &TextBox{Shape: wsp, Content: txbx.TxbxContent()})
+		}
+	}
+	return result
+}
+
+// vmlTxbxContents returns the <w:txbxContent> elements of the VML text
+// boxes inside the given <w:pict>.
+func vmlTxbxContents(pict *etree.Element) []*CT_TxbxContent {
+	var result []*CT_TxbxContent
+	for _, el := range pict.FindElements(".//v:textbox/w:txbxContent") {
+		result = append(result, &CT_TxbxContent{Element{E: el}})
+	}
+	return result
+}
+
+// AddTextBox adds a floating text box of cx by cy EMU, positioned by opts
+// and drawn as described by shape, to this run. The text box is written as
+// <mc:AlternateContent> with a VML fallback and starts with one empty
+// paragraph. It fails when shape.Preset is not an ST_ShapeType name.
+func (r *CT_R) AddTextBox(shapeId int, cx, cy int64, opts AnchorOptions, shape ShapeOptions) (*TextBox, error) {
+	wsp, err := newTextBoxShape(cx, cy, shape)
+	if err != nil {
+		return nil, err
+	}
+	anchor := newAnchorSkeleton()
+	anchor.Extent().SetCx(cx)
+	anchor.Extent().SetCy(cy)
+	anchor.DocPr().SetId(shapeId)
+	anchor.DocPr().SetName(fmt.Sprintf("Text Box %d", shapeId))
+	if frPr := anchor.FindChild("wp:cNvGraphicFramePr"); frPr != nil {
+		for _, c := range frPr.ChildElements() {
+			frPr.RemoveChild(c)
+		}
+	}
+	anchor.SetOptions(opts)
+	gd := anchor.Graphic().GraphicData()
+	gd.SetUri("http://schemas.microsoft.com/office/word/2010/wordprocessingShape")
+	gd.E.AddChild(wsp.E)
+
+	drawing := OxmlElement("w:drawing")
+	drawing.AddChild(anchor.E)
+	choice := OxmlElement("mc:Choice", "wps")
+	choice.CreateAttr("Requires", "wps")
+	choice.AddChild(drawing)
+
+	pict := newVmlTextBox(shapeId, cx, cy, opts, shape)
+	fallback := OxmlElement("mc:Fallback")
+	fallback.AddChild(pict)
+
+	ac := OxmlElement("mc:AlternateContent")
+	ac.AddChild(choice)
+	ac.AddChild(fallback)
+	r.E.AddChild(ac)
+
+	return &TextBox{
+		Shape:    wsp,
+		Content:  wsp.Txbx().TxbxContent(),
+		Fallback: vmlTxbxContents(pict)[0],
+	}, nil
+}
+
+// newTextBoxShape creates a <wps:wsp> text box shape of cx by cy EMU.
+func newTextBoxShape(cx, cy int64, shape ShapeOptions) (*CT_WordprocessingShape, error) {
+	xml := `<wps:wsp ` +
+		`xmlns:wps="http://schemas.microsoft.com/office/word/2010/wordprocessingShape" ` +
+		`xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" ` +
+		`xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<wps:cNvSpPr txBox="1"/>` +
+		`<wps:spPr>` +
+		`<a:xfrm><a:off x="0" y="0"/><a:ext cx="914400" cy="914400"/></a:xfrm>` +
+		`</wps:spPr>` +
+		`<wps:txbx><w:txbxContent><w:p/></w:txbxContent></wps:txbx>` +
+		`<wps:bodyPr rot="0" vert="horz" wrap="square" lIns="91440" tIns="45720" ` +
+		`rIns="91440" bIns="45720" anchor="t" anchorCtr="0"/>` +
+		`</wps:wsp>`
+	el, err := ParseXml([]byte(xml))
+	if err != nil {
+		panic(fmt.Sprintf("textbox_custom: failed to parse wsp XML: %v", err))
+	}
+	wsp := &CT_WordprocessingShape{Element{E: el}}
+	spPr := wsp.SpPr()
+	spPr.SetCx(cx)
+	spPr.SetCy(cy)
+	preset := shape.Preset
+	if preset == "" {
+		preset = "rect"
+	}
+	if err := spPr.SetPresetGeometry(preset); err != nil {
+		return nil, err
+	}
+	spPr.SetFillColor(shape.FillColor)
+	spPr.SetLineColor(shape.LineColor, shape.LineWidth)
+	return wsp, nil
+}
+
+// vmlShapeTags maps DrawingML preset names to the VML element that draws the
+// same outline. Other presets fall back to a rectangle.
+var vmlShapeTags = map[string]string{
+	"rect":      "rect",
+	"roundRect": "roundrect",
+	"ellipse":   "oval",
+}
+
+// vmlRelativeH and vmlRelativeV map the areas a floating shape is positioned
+// in to their VML mso-position-*-relative names.
+var (
+	vmlRelativeH = map[enum.WdRelativeHorizontalPosition]string{
+		enum.WdRelativeHorizontalPositionMargin:          "margin",
+		enum.WdRelativeHorizontalPositionPage:            "page",
+		enum.WdRelativeHorizontalPositionColumn:          "text",
+		enum.WdRelativeHorizontalPositionCharacter:       "char",
+		enum.WdRelativeHorizontalPositionLeftMarginArea:  "left-margin-area",
+		enum.WdRelativeHorizontalPositionRightMarginArea: "right-margin-area",
+		enum.WdRelativeHorizontalPositionInnerMarginArea: "inner-margin-area",
+		enum.WdRelativeHorizontalPositionOuterMarginArea: "outer-margin-area",
+	}
+	vmlRelativeV = map[enum.WdRelativeVerticalPosition]string{
+		enum.WdRelativeVerticalPositionMargin:           "margin",
+		enum.WdRelativeVerticalPositionPage:             "page",
+		enum.WdRelativeVerticalPositionParagraph:        "text",
+		enum.WdRelativeVerticalPositionLine:             "line",
+		enum.WdRelativeVerticalPositionTopMarginArea:    "top-margin-area",
+		enum.WdRelativeVerticalPositionBottomMarginArea: "bottom-margin-area",
+		enum.WdRelativeVerticalPositionInnerMarginArea:  "inner-margin-area",
+		enum.WdRelativeVerticalPositionOuterMarginArea:  "outer-margin-area",
+	}
+)
+
+// newVmlTextBox creates the <w:pict> VML rendering of a text box, used as
+// the <mc:Fallback> of AddTextBox.
+func newVmlTextBox(shapeId int, cx, cy int64, opts AnchorOptions, shape ShapeOptions) *etree.Element {
+	tag, ok := vmlShapeTags[shape.Preset]
+	if !ok {
+		tag = "rect"
+	}
+	style := []string{
+		"position:absolute",
+		fmt.Sprintf("margin-left:%spt", emuToPt(opts.OffsetX)),
+		fmt.Sprintf("margin-top:%spt", emuToPt(opts.OffsetY)),
+		fmt.Sprintf("width:%spt", emuToPt(cx)),
+		fmt.Sprintf("height:%spt", emuToPt(cy)),
+		fmt.Sprintf("z-index:%d", opts.ZOrder),
+	}
+	if opts.AlignH != nil {
+		style = append(style, "mso-position-horizontal:"+opts.AlignH.ToXml())
+	}
+	if rel, ok := vmlRelativeH[opts.RelativeH]; ok {
+		style = append(style, "mso-position-horizontal-relative:"+rel)
+	}
+	if opts.AlignV != nil {
+		style = append(style, "mso-position-vertical:"+opts.AlignV.ToXml())
+	}
+	if rel, ok := vmlRelativeV[opts.RelativeV]; ok {
+		style = append(style, "mso-position-vertical-relative:"+rel)
+	}
+
+	pict := OxmlElement("w:pict", "v", "o")
+	vs := pict.CreateElement("v:" + tag)
+	vs.CreateAttr("id", fmt.Sprintf("Text Box %d", shapeId))
+	vs.CreateAttr("o:spid", fmt.Sprintf("_x0000_s%d", 1024+shapeId))
+	vs.CreateAttr("style", strings.Join(style, ";"))
+	if shape.FillColor != nil {
+		vs.CreateAttr("fillcolor", "#"+shape.FillColor.String())
+	} else {
+		vs.CreateAttr("filled", "f")
+	}
+	if shape.LineColor != nil {
+		vs.CreateAttr("strokecolor", "#"+shape.LineColor.String())
+		if shape.LineWidth > 0 {
+			vs.CreateAttr("strokeweight", emuToPt(shape.LineWidth)+"pt")
+		}
+	} else {
+		vs.CreateAttr("stroked", "f")
+	}
+	vs.CreateElement("v:textbox").CreateElement("w:txbxContent").CreateElement("w:p")
+	return pict
+}
+
+// emuToPt formats an EMU distance in points, the unit of VML styles.
+func emuToPt(emu int64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", float64(emu)/12700), "0"), ".")
+}
+
+// ===========================================================================
+// CT_TxbxContent — custom methods
+// ===========================================================================
+
+// InnerContentElements returns all <w:p> and <w:tbl> direct children in document order.
+func (c *CT_TxbxContent) InnerContentElements() []interface{} {
+	return blockContent(c.Children())
+}
+
+// Text returns the text of the paragraphs in this text box, one line per
+// paragraph.
+func (c *CT_TxbxContent) Text() string {
+	var lines []string
+	for _, p := range c.PList() {
+		lines = append(lines, p.ParagraphText())
+	}
+	return strings.Join(lines, "\n")
+}
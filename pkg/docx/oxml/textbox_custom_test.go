@@ -0,0 +1,166 @@
+package oxml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
+)
+
+func TestCT_R_AddTextBox(t *testing.T) {
+	r := &CT_R{Element{E: OxmlElement("w:r")}}
+	fill, line := docx.NewRGBColor(0xFF, 0xFF, 0), docx.NewRGBColor(0xFF, 0, 0)
+	tb, err := r.AddTextBox(7, 1828800, 914400, AnchorOptions{OffsetX: 12700}, ShapeOptions{
+		Preset:    "roundRect",
+		FillColor: &fill,
+		LineColor: &line,
+		LineWidth: 12700,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tb.Shape == nil || tb.Content == nil || tb.Fallback == nil {
+		t.Fatalf("incomplete text box: %+v", tb)
+	}
+	if got := len(tb.Content.PList()); got != 1 {
+		t.Errorf("new text box has %d paragraphs, want 1", got)
+	}
+	spPr := tb.Shape.SpPr()
+	if spPr.PresetGeometry() != "roundRect" || *spPr.FillColor() != fill || *spPr.LineColor() != line {
+		t.Errorf("unexpected shape properties: %s", spPr.Xml())
+	}
+	if !tb.Shape.CNvSpPr().TxBox() {
+		t.Error("expected txBox=\"1\"")
+	}
+	var tags []string
+	for _, c := range spPr.E.ChildElements() {
+		tags = append(tags, c.Tag)
+	}
+	if got := strings.Join(tags, " "); got != "xfrm prstGeom solidFill ln" {
+		t.Errorf("spPr children = %q", got)
+	}
+	xml := r.Xml()
+	for _, want := range []string{
+		`Requires="wps"`, `xmlns:wps="` + Nsmap["wps"] + `"`,
+		`uri="http://schemas.microsoft.com/office/word/2010/wordprocessingShape"`,
+		`<wp:docPr id="7" name="Text Box 7"/>`,
+		`<v:roundrect id="Text Box 7"`, `width:144pt;height:72pt`, `fillcolor="#FFFF00"`, `strokeweight="1pt"`,
+	} {
+		if !strings.Contains(xml, want) {
+			t.Errorf("text box XML missing %s", want)
+		}
+	}
+
+	tb.Content.PList()[0].AddR().AddTWithText("Callout")
+	tb.Content.AddP().AddR().AddTWithText("second")
+	tb.SyncFallback()
+	if got := tb.Fallback.Text(); got != "Callout\nsecond" {
+		t.Errorf("fallback text = %q", got)
+	}
+
+	boxes := r.TextBoxes()
+	if len(boxes) != 1 || boxes[0].Shape.E != tb.Shape.E || boxes[0].Fallback.E != tb.Fallback.E {
+		t.Fatalf("TextBoxes() did not find the added text box")
+	}
+	if got := boxes[0].Content.Text(); got != "Callout\nsecond" {
+		t.Errorf("text = %q", got)
+	}
+}
+
+func TestCT_R_AddTextBoxInvalidPreset(t *testing.T) {
+	r := &CT_R{Element{E: OxmlElement("w:r")}}
+	if _, err := r.AddTextBox(1, 914400, 914400, AnchorOptions{}, ShapeOptions{Preset: "foo"}); err == nil {
+		t.Error("expected an error for an unknown preset")
+	}
+	if len(r.E.ChildElements()) != 0 {
+		t.Errorf("failed AddTextBox changed the run: %s", r.Xml())
+	}
+}
+
+func TestCT_R_AddTextBoxAlignedFallback(t *testing.T) {
+	r := &CT_R{Element{E: OxmlElement("w:r")}}
+	center := enum.WdShapePositionCenter
+	_, err := r.AddTextBox(3, 914400, 914400, AnchorOptions{
+		RelativeH: enum.WdRelativeHorizontalPositionPage, AlignH: &center,
+		RelativeV: enum.WdRelativeVerticalPositionParagraph, OffsetY: 25400,
+	}, ShapeOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	xml := r.Xml()
+	for _, want := range []string{
+		`mso-position-horizontal:center;mso-position-horizontal-relative:page`,
+		`mso-position-vertical-relative:text`, `margin-top:2pt`, `filled="f"`, `stroked="f"`,
+	} {
+		if !strings.Contains(xml, want) {
+			t.Errorf("VML fallback missing %s", want)
+		}
+	}
+	if strings.Contains(xml, "mso-position-vertical:") {
+		t.Error("offset vertical position should not be aligned")
+	}
+}
+
+func TestCT_P_TextBoxes(t *testing.T) {
+	el, err := ParseXml([]byte(`<w:p xmlns:w="` + Nsmap["w"] + `" xmlns:v="` + Nsmap["v"] + `" xmlns:mc="` + Nsmap["mc"] + `">` +
+		`<w:r><w:t>before</w:t></w:r>` +
+		`<w:r><mc:AlternateContent><mc:Choice Requires="wpc"><w:drawing/></mc:Choice>` +
+		`<mc:Fallback><w:pict><v:shape><v:textbox><w:txbxContent><w:p><w:r><w:t>legacy</w:t></w:r></w:p>` +
+		`<w:tbl/></w:txbxContent></v:textbox></v:shape></w:pict></mc:Fallback></mc:AlternateContent></w:r>` +
+		`<w:hyperlink><w:r><w:pict><v:rect><v:textbox><w:txbxContent><w:p><w:r><w:t>linked</w:t></w:r></w:p>` +
+		`</w:txbxContent></v:textbox></v:rect></w:pict></w:r></w:hyperlink>` +
+		`</w:p>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &CT_P{Element{E: el}}
+	boxes := p.TextBoxes()
+	if len(boxes) != 2 {
+		t.Fatalf("TextBoxes() = %d, want 2", len(boxes))
+	}
+	if boxes[0].Shape != nil || boxes[0].Fallback != nil || boxes[0].Content.Text() != "legacy" {
+		t.Errorf("unexpected VML text box: %+v", boxes[0])
+	}
+	if got := len(boxes[0].Content.InnerContentElements()); got != 2 {
+		t.Errorf("InnerContentElements() = %d, want 2", got)
+	}
+	if boxes[1].Content.Text() != "linked" {
+		t.Errorf("hyperlink text box text = %q", boxes[1].Content.Text())
+	}
+	if p.ParagraphText() != "before" {
+		t.Errorf("ParagraphText() = %q, text box text should not leak in", p.ParagraphText())
+	}
+}
+
+func TestCT_ShapeProperties_Fill(t *testing.T) {
+	sp := &CT_ShapeProperties{Element{E: OxmlElement("pic:spPr")}}
+	sp.SetLineColor(nil, 0)
+	sp.SetFillColor(nil)
+	if err := sp.SetPresetGeometry("ellipse"); err != nil {
+		t.Fatal(err)
+	}
+	var tags []string
+	for _, c := range sp.E.ChildElements() {
+		tags = append(tags, c.Tag)
+	}
+	if got := strings.Join(tags, " "); got != "prstGeom noFill ln" {
+		t.Errorf("spPr children = %q", got)
+	}
+	if sp.FillColor() != nil || sp.LineColor() != nil {
+		t.Error("expected no fill and no outline colors")
+	}
+	c := docx.NewRGBColor(0, 0xFF, 0)
+	sp.SetFillColor(&c)
+	if sp.NoFill() != nil || *sp.FillColor() != c {
+		t.Errorf("SetFillColor should replace noFill: %s", sp.Xml())
+	}
+	if err := sp.SetPresetGeometry("foo"); err == nil || sp.PresetGeometry() != "ellipse" {
+		t.Errorf("SetPresetGeometry(%q) should fail and keep the geometry", "foo")
+	}
+	sp.SetPresetGeometry("")
+	if sp.PresetGeometry() != "" {
+		t.Error("empty preset should remove prstGeom")
+	}
+}
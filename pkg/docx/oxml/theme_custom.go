@@ -0,0 +1,357 @@
+package oxml
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/beevik/etree"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
+)
+
+// ===========================================================================
+// CT_OfficeStyleSheet — custom methods
+// ===========================================================================
+
+// ParseTheme parses the XML of a theme part.
+func ParseTheme(blob []byte) (*CT_OfficeStyleSheet, error) {
+	el, err := ParseXml(blob)
+	if err != nil {
+		return nil, err
+	}
+	normalizeDefaultNamespace(el, "a")
+	if el.Space != "a" || el.Tag != "theme" {
+		return nil, fmt.Errorf("oxml: expected <a:theme> root in theme, got <%s>", el.FullTag())
+	}
+	return &CT_OfficeStyleSheet{Element{E: el}}, nil
+}
+
+// ColorScheme returns the <a:clrScheme> of the theme, or nil if the theme
+// has no <a:themeElements> or it has no color scheme.
+func (th *CT_OfficeStyleSheet) ColorScheme() *CT_ColorScheme {
+	el := th.themeElement("a:clrScheme")
+	if el == nil {
+		return nil
+	}
+	return &CT_ColorScheme{Element{E: el}}
+}
+
+// FontScheme returns the <a:fontScheme> of the theme, or nil if the theme
+// has no <a:themeElements> or it has no font scheme.
+func (th *CT_OfficeStyleSheet) FontScheme() *CT_FontScheme {
+	el := th.themeElement("a:fontScheme")
+	if el == nil {
+		return nil
+	}
+	return &CT_FontScheme{Element{E: el}}
+}
+
+// themeElement returns the tag child of <a:themeElements>, or nil. The
+// generated accessors panic on the missing required children of a
+// malformed theme part.
+func (th *CT_OfficeStyleSheet) themeElement(tag string) *etree.Element {
+	elements := th.FindChild("a:themeElements")
+	if elements == nil {
+		return nil
+	}
+	return (&Element{E: elements}).FindChild(tag)
+}
+
+// ResolveColor returns the RGB value of color, the <w:color> of a run or
+// paragraph mark. A theme color reference is looked up in this theme with
+// w:themeTint or w:themeShade applied; otherwise w:val is used. It returns
+// nil for the "auto" color.
+func (th *CT_OfficeStyleSheet) ResolveColor(color *CT_Color) (*docx.RGBColor, error) {
+	if tc := color.ThemeColor(); tc != "" {
+		idx, err := enum.MsoThemeColorIndexFromXml(tc)
+		if err != nil {
+			return nil, err
+		}
+		cs := th.ColorScheme()
+		if cs == nil {
+			return nil, fmt.Errorf("oxml: theme has no color scheme for theme color %q", tc)
+		}
+		rgb, err := cs.Color(idx)
+		if err != nil {
+			return nil, err
+		}
+		rgb, err = ApplyTintShade(rgb, color.ThemeTint(), color.ThemeShade())
+		if err != nil {
+			return nil, err
+		}
+		return &rgb, nil
+	}
+	if _, err := color.Val(); err != nil {
+		return nil, err
+	}
+	val, _ := color.GetAttr("w:val")
+	return parseHexColor(val)
+}
+
+// ===========================================================================
+// CT_ColorScheme — custom methods
+// ===========================================================================
+
+// Slot returns the scheme slot idx refers to, or nil if it is absent. The
+// text and background indices alias the dark and light slots, so Text1 is
+// <a:dk1> and Background2 is <a:lt2>. An error is returned for
+// MsoThemeColorIndexNotThemeColor and unknown indices.
+func (cs *CT_ColorScheme) Slot(idx enum.MsoThemeColorIndex) (*CT_ThemeColor, error) {
+	switch idx {
+	case enum.MsoThemeColorIndexDark1, enum.MsoThemeColorIndexText1:
+		return cs.Dk1(), nil
+	case enum.MsoThemeColorIndexLight1, enum.MsoThemeColorIndexBackground1:
+		return cs.Lt1(), nil
+	case enum.MsoThemeColorIndexDark2, enum.MsoThemeColorIndexText2:
+		return cs.Dk2(), nil
+	case enum.MsoThemeColorIndexLight2, enum.MsoThemeColorIndexBackground2:
+		return cs.Lt2(), nil
+	case enum.MsoThemeColorIndexAccent1:
+		return cs.Accent1(), nil
+	case enum.MsoThemeColorIndexAccent2:
+		return cs.Accent2(), nil
+	case enum.MsoThemeColorIndexAccent3:
+		return cs.Accent3(), nil
+	case enum.MsoThemeColorIndexAccent4:
+		return cs.Accent4(), nil
+	case enum.MsoThemeColorIndexAccent5:
+		return cs.Accent5(), nil
+	case enum.MsoThemeColorIndexAccent6:
+		return cs.Accent6(), nil
+	case enum.MsoThemeColorIndexHyperlink:
+		return cs.Hlink(), nil
+	case enum.MsoThemeColorIndexFollowedHyperlink:
+		return cs.FolHlink(), nil
+	}
+	return nil, fmt.Errorf("oxml: %d is not a theme color", idx)
+}
+
+// getOrAddSlot is Slot for a slot that is added when absent.
+func (cs *CT_ColorScheme) getOrAddSlot(idx enum.MsoThemeColorIndex) (*CT_ThemeColor, error) {
+	switch idx {
+	case enum.MsoThemeColorIndexDark1, enum.MsoThemeColorIndexText1:
+		return cs.GetOrAddDk1(), nil
+	case enum.MsoThemeColorIndexLight1, enum.MsoThemeColorIndexBackground1:
+		return cs.GetOrAddLt1(), nil
+	case enum.MsoThemeColorIndexDark2, enum.MsoThemeColorIndexText2:
+		return cs.GetOrAddDk2(), nil
+	case enum.MsoThemeColorIndexLight2, enum.MsoThemeColorIndexBackground2:
+		return cs.GetOrAddLt2(), nil
+	case enum.MsoThemeColorIndexAccent1:
+		return cs.GetOrAddAccent1(), nil
+	case enum.MsoThemeColorIndexAccent2:
+		return cs.GetOrAddAccent2(), nil
+	case enum.MsoThemeColorIndexAccent3:
+		return cs.GetOrAddAccent3(), nil
+	case enum.MsoThemeColorIndexAccent4:
+		return cs.GetOrAddAccent4(), nil
+	case enum.MsoThemeColorIndexAccent5:
+		return cs.GetOrAddAccent5(), nil
+	case enum.MsoThemeColorIndexAccent6:
+		return cs.GetOrAddAccent6(), nil
+	case enum.MsoThemeColorIndexHyperlink:
+		return cs.GetOrAddHlink(), nil
+	case enum.MsoThemeColorIndexFollowedHyperlink:
+		return cs.GetOrAddFolHlink(), nil
+	}
+	return nil, fmt.Errorf("oxml: %d is not a theme color", idx)
+}
+
+// Color returns the RGB value of the scheme slot idx refers to.
+func (cs *CT_ColorScheme) Color(idx enum.MsoThemeColorIndex) (docx.RGBColor, error) {
+	slot, err := cs.Slot(idx)
+	if err != nil {
+		return docx.RGBColor{}, err
+	}
+	if slot == nil {
+		return docx.RGBColor{}, fmt.Errorf("oxml: color scheme has no slot for theme color %d", idx)
+	}
+	return slot.RGB()
+}
+
+// SetColor sets the scheme slot idx refers to to an explicit RGB value,
+// replacing a system color.
+func (cs *CT_ColorScheme) SetColor(idx enum.MsoThemeColorIndex, c docx.RGBColor) error {
+	slot, err := cs.getOrAddSlot(idx)
+	if err != nil {
+		return err
+	}
+	slot.SetRGB(c)
+	return nil
+}
+
+// ===========================================================================
+// CT_ThemeColor — custom methods
+// ===========================================================================
+
+// RGB returns the value of the slot: the <a:srgbClr> value, or the last
+// computed value of an <a:sysClr>.
+func (tc *CT_ThemeColor) RGB() (docx.RGBColor, error) {
+	if srgb := tc.SrgbClr(); srgb != nil {
+		val, err := srgb.Val()
+		if err != nil {
+			return docx.RGBColor{}, err
+		}
+		return docx.RGBColorFromString(val)
+	}
+	if sys := tc.SysClr(); sys != nil {
+		if last := sys.LastClr(); last != "" {
+			return docx.RGBColorFromString(last)
+		}
+		val, _ := sys.Val()
+		return docx.RGBColor{}, fmt.Errorf("oxml: system color %q has no lastClr", val)
+	}
+	return docx.RGBColor{}, fmt.Errorf("oxml: <%s> has no color", tc.E.FullTag())
+}
+
+// SetRGB sets the slot to the explicit color c.
+func (tc *CT_ThemeColor) SetRGB(c docx.RGBColor) {
+	tc.GetOrChangeToSrgbClr().SetVal(c.String())
+}
+
+// ===========================================================================
+// CT_FontCollection — custom methods
+// ===========================================================================
+
+// LatinTypeface returns the typeface of <a:latin>, or "" if absent.
+func (fc *CT_FontCollection) LatinTypeface() string {
+	return typefaceOf(fc.Latin())
+}
+
+// SetLatinTypeface sets the typeface of <a:latin>.
+func (fc *CT_FontCollection) SetLatinTypeface(v string) {
+	fc.GetOrAddLatin().SetTypeface(v)
+}
+
+// EaTypeface returns the East Asian typeface of <a:ea>, or "" if absent.
+func (fc *CT_FontCollection) EaTypeface() string {
+	return typefaceOf(fc.Ea())
+}
+
+// SetEaTypeface sets the typeface of <a:ea>.
+func (fc *CT_FontCollection) SetEaTypeface(v string) {
+	fc.GetOrAddEa().SetTypeface(v)
+}
+
+// CsTypeface returns the complex script typeface of <a:cs>, or "" if absent.
+func (fc *CT_FontCollection) CsTypeface() string {
+	return typefaceOf(fc.Cs())
+}
+
+// SetCsTypeface sets the typeface of <a:cs>.
+func (fc *CT_FontCollection) SetCsTypeface(v string) {
+	fc.GetOrAddCs().SetTypeface(v)
+}
+
+// ScriptTypeface returns the typeface of the <a:font> for script, such as
+// "Jpan" or "Arab", or "" if there is none.
+func (fc *CT_FontCollection) ScriptTypeface(script string) string {
+	for _, f := range fc.FontList() {
+		if s, _ := f.Script(); s == script {
+			v, _ := f.Typeface()
+			return v
+		}
+	}
+	return ""
+}
+
+func typefaceOf(f *CT_TextFont) string {
+	if f == nil {
+		return ""
+	}
+	return f.Typeface()
+}
+
+// ===========================================================================
+// Tint and shade
+// ===========================================================================
+
+// ApplyTintShade applies the w:themeTint and w:themeShade values of a
+// theme color reference to c. Both are hexadecimal bytes and may be empty.
+// A tint moves the color's HSL luminance toward white, keeping tint/255 of
+// it; a shade scales the luminance by shade/255.
+func ApplyTintShade(c docx.RGBColor, tint, shade string) (docx.RGBColor, error) {
+	if tint == "" && shade == "" {
+		return c, nil
+	}
+	h, s, l := rgbToHsl(c)
+	if tint != "" {
+		t, err := strconv.ParseUint(tint, 16, 8)
+		if err != nil {
+			return c, fmt.Errorf("oxml: invalid theme tint %q", tint)
+		}
+		f := float64(t) / 255
+		l = l*f + (1 - f)
+	}
+	if shade != "" {
+		v, err := strconv.ParseUint(shade, 16, 8)
+		if err != nil {
+			return c, fmt.Errorf("oxml: invalid theme shade %q", shade)
+		}
+		l *= float64(v) / 255
+	}
+	return hslToRgb(h, s, l), nil
+}
+
+func rgbToHsl(c docx.RGBColor) (h, s, l float64) {
+	r, g, b := float64(c.R())/255, float64(c.G())/255, float64(c.B())/255
+	hi, lo := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l = (hi + lo) / 2
+	d := hi - lo
+	if d == 0 {
+		return 0, 0, l
+	}
+	if l > 0.5 {
+		s = d / (2 - hi - lo)
+	} else {
+		s = d / (hi + lo)
+	}
+	switch hi {
+	case r:
+		h = math.Mod((g-b)/d+6, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	return h / 6, s, l
+}
+
+func hslToRgb(h, s, l float64) docx.RGBColor {
+	if s == 0 {
+		v := channel(l)
+		return docx.NewRGBColor(v, v, v)
+	}
+	var q float64
+	if l < 0.5 {
+		q = l * (1 + s)
+	} else {
+		q = l + s - l*s
+	}
+	p := 2*l - q
+	return docx.NewRGBColor(channel(hueToRgb(p, q, h+1.0/3)), channel(hueToRgb(p, q, h)), channel(hueToRgb(p, q, h-1.0/3)))
+}
+
+func hueToRgb(p, q, t float64) float64 {
+	switch {
+	case t < 0:
+		t++
+	case t > 1:
+		t--
+	}
+	switch {
+	case t < 1.0/6:
+		return p + (q-p)*6*t
+	case t < 0.5:
+		return q
+	case t < 2.0/3:
+		return p + (q-p)*(2.0/3-t)*6
+	}
+	return p
+}
+
+func channel(v float64) byte {
+	return byte(math.Round(math.Max(0, math.Min(1, v)) * 255))
+}
@@ -0,0 +1,163 @@
+package oxml
+
+import (
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
+)
+
+const themeTestXml = `<a:theme xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" name="Office Theme">` +
+	`<a:themeElements><a:clrScheme name="Office">` +
+	`<a:dk1><a:sysClr val="windowText" lastClr="000000"/></a:dk1>` +
+	`<a:lt1><a:sysClr val="window" lastClr="FFFFFF"/></a:lt1>` +
+	`<a:dk2><a:srgbClr val="1F497D"/></a:dk2><a:lt2><a:srgbClr val="EEECE1"/></a:lt2>` +
+	`<a:accent1><a:srgbClr val="4F81BD"/></a:accent1><a:accent2><a:srgbClr val="C0504D"/></a:accent2>` +
+	`<a:hlink><a:srgbClr val="0000FF"/></a:hlink>` +
+	`</a:clrScheme><a:fontScheme name="Office">` +
+	`<a:majorFont><a:latin typeface="Calibri Light"/><a:ea typeface=""/><a:cs typeface=""/>` +
+	`<a:font script="Jpan" typeface="MS Gothic"/></a:majorFont>` +
+	`<a:minorFont><a:latin typeface="Calibri"/><a:ea typeface=""/><a:cs typeface=""/></a:minorFont>` +
+	`</a:fontScheme></a:themeElements></a:theme>`
+
+func TestCT_ColorScheme_Color(t *testing.T) {
+	th, err := ParseTheme([]byte(themeTestXml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := th.ColorScheme()
+	tests := []struct {
+		idx  enum.MsoThemeColorIndex
+		want string
+	}{
+		{enum.MsoThemeColorIndexText1, "000000"},
+		{enum.MsoThemeColorIndexBackground1, "FFFFFF"},
+		{enum.MsoThemeColorIndexText2, "1F497D"},
+		{enum.MsoThemeColorIndexLight2, "EEECE1"},
+		{enum.MsoThemeColorIndexAccent1, "4F81BD"},
+		{enum.MsoThemeColorIndexHyperlink, "0000FF"},
+	}
+	for _, tt := range tests {
+		got, err := cs.Color(tt.idx)
+		if err != nil || got.String() != tt.want {
+			t.Errorf("Color(%d) = %s, %v; want %s", tt.idx, got, err, tt.want)
+		}
+	}
+	if _, err := cs.Color(enum.MsoThemeColorIndexAccent6); err == nil {
+		t.Error("expected an error for a missing slot")
+	}
+	if _, err := cs.Color(enum.MsoThemeColorIndexNotThemeColor); err == nil {
+		t.Error("expected an error for NotThemeColor")
+	}
+
+	if err := cs.SetColor(enum.MsoThemeColorIndexText1, docx.NewRGBColor(0x11, 0x22, 0x33)); err != nil {
+		t.Fatal(err)
+	}
+	if err := cs.SetColor(enum.MsoThemeColorIndexAccent6, docx.NewRGBColor(0xF7, 0x96, 0x46)); err != nil {
+		t.Fatal(err)
+	}
+	if cs.Dk1().SysClr() != nil {
+		t.Error("SetColor should replace the system color")
+	}
+	if got, _ := cs.Color(enum.MsoThemeColorIndexDark1); got.String() != "112233" {
+		t.Errorf("dk1 = %s", got)
+	}
+	if got, _ := cs.Color(enum.MsoThemeColorIndexAccent6); got.String() != "F79646" {
+		t.Errorf("accent6 = %s", got)
+	}
+	var tags []string
+	for _, c := range cs.E.ChildElements() {
+		tags = append(tags, c.Tag)
+	}
+	if tags[len(tags)-2] != "accent6" || tags[len(tags)-1] != "hlink" {
+		t.Errorf("accent6 inserted out of order: %v", tags)
+	}
+}
+
+func TestCT_FontCollection_Typefaces(t *testing.T) {
+	th, err := ParseTheme([]byte(themeTestXml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs := th.FontScheme()
+	if got := fs.MajorFont().LatinTypeface(); got != "Calibri Light" {
+		t.Errorf("major latin = %q", got)
+	}
+	if got := fs.MajorFont().ScriptTypeface("Jpan"); got != "MS Gothic" {
+		t.Errorf("major Jpan = %q", got)
+	}
+	minor := fs.MinorFont()
+	minor.SetLatinTypeface("Georgia")
+	minor.SetEaTypeface("SimSun")
+	minor.SetCsTypeface("Arial")
+	if minor.LatinTypeface() != "Georgia" || minor.EaTypeface() != "SimSun" || minor.CsTypeface() != "Arial" {
+		t.Errorf("minor font = %s", minor.Xml())
+	}
+}
+
+func TestApplyTintShade(t *testing.T) {
+	accent1 := docx.NewRGBColor(0x4F, 0x81, 0xBD)
+	tests := []struct {
+		tint, shade, want string
+	}{
+		{"", "", "4F81BD"},
+		{"99", "", "95B3D7"}, // Lighter 40%
+		{"33", "", "DCE6F2"}, // Lighter 80%
+		{"", "BF", "376092"}, // Darker 25%
+		{"FF", "", "4F81BD"},
+		{"00", "", "FFFFFF"},
+	}
+	for _, tt := range tests {
+		got, err := ApplyTintShade(accent1, tt.tint, tt.shade)
+		if err != nil || got.String() != tt.want {
+			t.Errorf("ApplyTintShade(%q, %q) = %s, %v; want %s", tt.tint, tt.shade, got, err, tt.want)
+		}
+	}
+	if _, err := ApplyTintShade(accent1, "1FF", ""); err == nil {
+		t.Error("expected an error for an invalid tint")
+	}
+}
+
+func TestCT_OfficeStyleSheet_ResolveColor(t *testing.T) {
+	th, _ := ParseTheme([]byte(themeTestXml))
+	rPr := &CT_RPr{Element{E: OxmlElement("w:rPr")}}
+	color := rPr.GetOrAddColor()
+	color.SetVal(nil)
+	if got, err := th.ResolveColor(color); got != nil || err != nil {
+		t.Errorf("auto color = %v, %v", got, err)
+	}
+	accent2 := enum.MsoThemeColorIndexAccent2
+	rPr.SetColorTheme(&accent2)
+	color.SetThemeShade("80")
+	got, err := th.ResolveColor(color)
+	if err != nil || got.String() != "642523" {
+		t.Errorf("accent2 shade 80 = %v, %v", got, err)
+	}
+	color.SetThemeColor("")
+	color.SetVal(&docx.RGBColor{0xAB, 0xCD, 0xEF})
+	if got, _ := th.ResolveColor(color); got == nil || got.String() != "ABCDEF" {
+		t.Errorf("explicit color = %v", got)
+	}
+}
+
+func TestCT_OfficeStyleSheet_NoThemeElements(t *testing.T) {
+	th, err := ParseTheme([]byte(`<a:theme xmlns:a="` + Nsmap["a"] + `" name="Bare"/>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.ColorScheme() != nil || th.FontScheme() != nil {
+		t.Error("expected nil schemes for a theme without <a:themeElements>")
+	}
+	rPr := &CT_RPr{Element{E: OxmlElement("w:rPr")}}
+	accent1 := enum.MsoThemeColorIndexAccent1
+	rPr.SetColorTheme(&accent1)
+	if _, err := th.ResolveColor(rPr.Color()); err == nil {
+		t.Error("expected an error resolving a theme color without a color scheme")
+	}
+}
+
+func TestParseTheme_WrongRoot(t *testing.T) {
+	if _, err := ParseTheme([]byte(`<a:clrScheme xmlns:a="` + Nsmap["a"] + `"/>`)); err == nil {
+		t.Error("expected an error for a non-theme root")
+	}
+}
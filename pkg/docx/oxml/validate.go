@@ -0,0 +1,295 @@
+package oxml
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// --- Schema metadata registered by generated code ---
+
+type cardinality int
+
+const (
+	cardZeroOrOne cardinality = iota
+	cardZeroOrMore
+	cardOneAndOnlyOne
+	cardOneOrMore
+)
+
+// elementMeta describes a CT_* type as declared in the codegen schema.
+type elementMeta struct {
+	name       string
+	tag        string
+	wrap       func(Element) Node // returns the *CT_* wrapper of the element
+	unordered  bool               // children may appear in any order
+	children   []childMeta
+	attributes []attrMeta
+}
+
+// childMeta describes a child element of a CT_* type. Members of a choice
+// group share a nonzero group number and are each zero_or_one. Members of a
+// repeating choice share a choice name; a required member of one is
+// satisfied by any member.
+type childMeta struct {
+	tag        string
+	typ        string
+	card       cardinality
+	group      int
+	choice     string
+	successors []string
+}
+
+// attrMeta describes an attribute whose values are constrained.
+type attrMeta struct {
+	name  string
+	check func(string) error
+}
+
+var (
+	metaByName = make(map[string]*elementMeta)
+	metaByTag  = make(map[string]*elementMeta) // nil for tags shared by several types
+)
+
+func registerElementMeta(m *elementMeta) {
+	metaByName[m.name] = m
+	if _, dup := metaByTag[m.tag]; dup {
+		metaByTag[m.tag] = nil
+		return
+	}
+	metaByTag[m.tag] = m
+}
+
+// child returns the declared child with tag, or nil. m may be nil.
+func (m *elementMeta) child(tag string) *childMeta {
+	if m == nil {
+		return nil
+	}
+	for i := range m.children {
+		if m.children[i].tag == tag {
+			return &m.children[i]
+		}
+	}
+	return nil
+}
+
+// choiceMembers returns the tags of the members of the repeating choice
+// named choice, and whether counts has any of them.
+func (m *elementMeta) choiceMembers(choice string, counts map[string]int) ([]string, bool) {
+	var tags []string
+	found := false
+	for _, cm := range m.children {
+		if cm.choice == choice {
+			tags = append(tags, cm.tag)
+			found = found || counts[cm.tag] > 0
+		}
+	}
+	return tags, found
+}
+
+// --- Attribute value checks used by generated code ---
+
+func checkIntAttr(s string) error {
+	if _, err := strconv.Atoi(strings.TrimSpace(s)); err != nil {
+		return fmt.Errorf("%q is not an integer", s)
+	}
+	return nil
+}
+
+func checkInt64Attr(s string) error {
+	if _, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err != nil {
+		return fmt.Errorf("%q is not an integer", s)
+	}
+	return nil
+}
+
+func checkBoolAttr(s string) error {
+	switch strings.TrimSpace(s) {
+	case "true", "false", "1", "0", "on", "off":
+		return nil
+	}
+	return fmt.Errorf("%q is not a boolean", s)
+}
+
+func checkEnumAttr[T any](fromXml func(string) (T, error)) func(string) error {
+	return func(s string) error {
+		if _, err := fromXml(s); err != nil {
+			return fmt.Errorf("%q is not an allowed value", s)
+		}
+		return nil
+	}
+}
+
+// checkValuesAttr returns a check that accepts only the listed values, the
+// xsd:enumeration of a string attribute without an enum type.
+func checkValuesAttr(values ...string) func(string) error {
+	return func(s string) error {
+		if !slices.Contains(values, s) {
+			return fmt.Errorf("%q is not an allowed value", s)
+		}
+		return nil
+	}
+}
+
+// checkValueAttr returns a check that reports the error of parse.
+func checkValueAttr[T any](parse func(string) (T, error)) func(string) error {
+	return func(s string) error {
+		_, err := parse(s)
+		return err
+	}
+}
+
+// --- Validation ---
+
+// ValidationError is a schema violation found by Validate.
+type ValidationError struct {
+	Path    string // XPath-like location, e.g. "/w:document/w:body/w:p[2]/w:pPr"
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// ValidateOption configures Validate.
+type ValidateOption func(*validator)
+
+// WithRelationshipIds makes Validate report attributes in the
+// relationships namespace, such as r:id and r:embed, whose value is not one
+// of ids. Pass the ids of the relationships of the part el belongs to.
+func WithRelationshipIds(ids ...string) ValidateOption {
+	return func(v *validator) {
+		v.relIds = make(map[string]bool, len(ids))
+		for _, id := range ids {
+			v.relIds[id] = true
+		}
+	}
+}
+
+type validator struct {
+	relIds map[string]bool
+	errs   []ValidationError
+}
+
+// Validate checks the tree rooted at el against the codegen schema and
+// returns the violations in document order. It reports out-of-order
+// children, repeated zero_or_one children and choice group members,
+// missing one_and_only_one and one_or_more children, for which any member
+// of a repeating choice such as a cell's block content will do, and
+// attribute values that are not valid for an integer, boolean or enumerated
+// attribute.
+//
+// Elements are matched to schema types through their parent's declared
+// children, starting from the type whose tag is el's. Elements the schema
+// does not describe are not checked themselves, but their descendants are
+// when their tags identify a single type.
+func Validate(el *etree.Element, opts ...ValidateOption) []ValidationError {
+	v := &validator{}
+	for _, opt := range opts {
+		opt(v)
+	}
+	tag := schemaTag(el)
+	v.walk(el, metaByTag[tag], "/"+tag)
+	return v.errs
+}
+
+func (v *validator) report(path, format string, args ...any) {
+	v.errs = append(v.errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) walk(el *etree.Element, meta *elementMeta, path string) {
+	if meta != nil {
+		for _, a := range meta.attributes {
+			if val, ok := (&Element{E: el}).GetAttr(a.name); ok {
+				if err := a.check(val); err != nil {
+					v.report(path, "attribute %s: %v", a.name, err)
+				}
+			}
+		}
+	}
+	if v.relIds != nil {
+		for _, attr := range el.Attr {
+			if attr.Space != "" && attr.Space != "xmlns" && lookupNamespace(el, attr.Space) == Nsmap["r"] && !v.relIds[attr.Value] {
+				v.report(path, "attribute %s: no relationship with id %q", attr.FullKey(), attr.Value)
+			}
+		}
+	}
+
+	children := el.ChildElements()
+	tags := make([]string, len(children))
+	counts := make(map[string]int)
+	for i, c := range children {
+		tags[i] = schemaTag(c)
+		counts[tags[i]]++
+	}
+
+	seen := make(map[string]int)
+	for i, c := range children {
+		tag := tags[i]
+		seen[tag]++
+		childPath := path + "/" + tag
+		if counts[tag] > 1 {
+			childPath += "[" + strconv.Itoa(seen[tag]) + "]"
+		}
+
+		var childMetaType *elementMeta
+		if cm := meta.child(tag); cm != nil {
+			for _, succ := range cm.successors {
+				if !meta.unordered && seen[succ] > 0 && succ != tag {
+					v.report(childPath, "<%s> must come before <%s>", tag, succ)
+					break
+				}
+			}
+			if seen[tag] == 2 && (cm.card == cardZeroOrOne || cm.card == cardOneAndOnlyOne) {
+				v.report(childPath, "duplicate <%s>, at most one is allowed", tag)
+			}
+			childMetaType = metaByName[cm.typ]
+		} else if meta == nil {
+			childMetaType = metaByTag[tag]
+		}
+		v.walk(c, childMetaType, childPath)
+	}
+
+	if meta == nil {
+		return
+	}
+	groups := make(map[int][]string)
+	nGroups := 0
+	for _, cm := range meta.children {
+		switch {
+		case cm.group != 0:
+			nGroups = max(nGroups, cm.group)
+			if counts[cm.tag] > 0 {
+				groups[cm.group] = append(groups[cm.group], cm.tag)
+			}
+		case cm.card != cardOneAndOnlyOne && cm.card != cardOneOrMore:
+		case cm.choice != "":
+			if members, found := meta.choiceMembers(cm.choice, counts); !found && members[0] == cm.tag {
+				v.report(path, "missing required <%s>", strings.Join(members, "> or <"))
+			}
+		case counts[cm.tag] == 0:
+			v.report(path, "missing required <%s>", cm.tag)
+		}
+	}
+	for g := 1; g <= nGroups; g++ {
+		if present := groups[g]; len(present) > 1 {
+			v.report(path, "only one of <%s> is allowed", strings.Join(present, ">, <"))
+		}
+	}
+}
+
+// schemaTag returns the tag of el with the prefix the schema uses for its
+// namespace, which may differ from the prefix in the document.
+func schemaTag(el *etree.Element) string {
+	uri := lookupNamespace(el, el.Space)
+	if uri == "" || Nsmap[el.Space] == uri {
+		return el.FullTag()
+	}
+	if pfx, ok := Pfxmap[uri]; ok {
+		return pfx + ":" + el.Tag
+	}
+	return el.FullTag()
+}
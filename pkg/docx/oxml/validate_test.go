@@ -0,0 +1,123 @@
+package oxml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/opc"
+	"github.com/user/go-docx/pkg/docx/templates"
+)
+
+func TestValidate_DefaultDocumentIsValid(t *testing.T) {
+	data, err := templates.FS.ReadFile("default.docx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := opc.OpenBytes(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, part := range pkg.Parts() {
+		if !strings.HasSuffix(part.ContentType(), "xml") {
+			continue
+		}
+		el, err := ParseXml(part.Blob())
+		if err != nil {
+			t.Fatalf("%s: %v", part.PartName(), err)
+		}
+		var ids []string
+		for _, rel := range part.Rels().All() {
+			ids = append(ids, rel.RID)
+		}
+		for _, e := range Validate(el, WithRelationshipIds(ids...)) {
+			t.Errorf("%s: %v", part.PartName(), e)
+		}
+	}
+}
+
+func TestValidate_Violations(t *testing.T) {
+	el, err := ParseXml([]byte(`<w:body xmlns:w="` + Nsmap["w"] + `" xmlns:r="` + Nsmap["r"] + `" ` +
+		`xmlns:wp="` + Nsmap["wp"] + `" xmlns:a="` + Nsmap["a"] + `">` +
+		`<w:p><w:r><w:t>x</w:t></w:r><w:pPr><w:jc w:val="middle"/></w:pPr><w:pPr/></w:p>` +
+		`<w:p><w:r><w:rPr><w:sz w:val="big"/></w:rPr>` +
+		`<w:drawing><wp:inline><wp:docPr id="1" name="x"/><a:graphic/></wp:inline></w:drawing></w:r>` +
+		`<w:hyperlink r:id="rId9"/></w:p>` +
+		`</w:body>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range Validate(el, WithRelationshipIds("rId1")) {
+		got = append(got, e.Error())
+	}
+	want := []string{
+		`/w:body/w:p[1]/w:pPr[1]: <w:pPr> must come before <w:r>`,
+		`/w:body/w:p[1]/w:pPr[1]/w:jc: attribute w:val: "middle" is not an allowed value`,
+		`/w:body/w:p[1]/w:pPr[2]: <w:pPr> must come before <w:r>`,
+		`/w:body/w:p[1]/w:pPr[2]: duplicate <w:pPr>, at most one is allowed`,
+		`/w:body/w:p[2]/w:r/w:rPr/w:sz: attribute w:val: "big" is not a measurement`,
+		`/w:body/w:p[2]/w:r/w:drawing/wp:inline/a:graphic: missing required <a:graphicData>`,
+		`/w:body/w:p[2]/w:r/w:drawing/wp:inline: missing required <wp:extent>`,
+		`/w:body/w:p[2]/w:hyperlink: attribute r:id: no relationship with id "rId9"`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Validate() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestValidate_UniversalMeasures(t *testing.T) {
+	el, err := ParseXml([]byte(`<w:rPr xmlns:w="` + Nsmap["w"] + `">` +
+		`<w:color w:val="auto"/><w:kern w:val="1in"/><w:sz w:val="12pt"/></w:rPr>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errs := Validate(el); len(errs) != 0 {
+		t.Errorf("Validate() = %v", errs)
+	}
+	kern := &CT_HpsMeasure{Element{E: el.ChildElements()[1]}}
+	if got, err := kern.Val(); err != nil || got != docx.Inches(1) {
+		t.Errorf("kern Val() = %v, %v; want 1in", got, err)
+	}
+}
+
+func TestValidate_ChoiceGroupAndPrefixes(t *testing.T) {
+	el, err := ParseXml([]byte(`<d:solidFill xmlns:d="` + Nsmap["a"] + `">` +
+		`<d:srgbClr val="FF0000"/><d:schemeClr val="accent1"/></d:solidFill>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	errs := Validate(el)
+	if len(errs) != 1 || errs[0].Path != "/a:solidFill" || !strings.Contains(errs[0].Message, "only one of <a:srgbClr>, <a:schemeClr>") {
+		t.Errorf("Validate() = %v", errs)
+	}
+	if errs := Validate(el.ChildElements()[0]); len(errs) != 0 {
+		t.Errorf("valid element reported: %v", errs)
+	}
+}
+
+func TestValidate_TableCells(t *testing.T) {
+	body := &CT_Body{Element{E: OxmlElement("w:body")}}
+	tbl := NewTbl(2, 2, 9360)
+	body.E.AddChild(tbl.E)
+	// A cell holding a nested table and no paragraph is valid too.
+	cell := tbl.TrList()[1].TcList()[1]
+	for _, p := range cell.PList() {
+		cell.E.RemoveChild(p.E)
+	}
+	cell.E.AddChild(NewTbl(1, 1, 4680).E)
+	if errs := Validate(body.E); len(errs) != 0 {
+		t.Errorf("Validate() = %v", errs)
+	}
+
+	// A cell with no block content is not.
+	for _, e := range cell.E.ChildElements() {
+		if e.Tag != "tcPr" {
+			cell.E.RemoveChild(e)
+		}
+	}
+	errs := Validate(body.E)
+	if len(errs) != 1 || errs[0].Path != "/w:body/w:tbl/w:tr[2]/w:tc[2]" || errs[0].Message != "missing required <w:p> or <w:tbl>" {
+		t.Errorf("Validate() of an empty cell = %v", errs)
+	}
+}
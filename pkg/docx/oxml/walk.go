@@ -0,0 +1,214 @@
+package oxml
+
+import (
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// --- Walking ---
+
+// WalkAction tells Walk how to go on after a Visitor callback.
+type WalkAction int
+
+const (
+	// WalkContinue visits the node's content, then its following siblings.
+	WalkContinue WalkAction = iota
+	// WalkSkipChildren goes on with the node's following siblings.
+	WalkSkipChildren
+	// WalkStop ends the walk.
+	WalkStop
+)
+
+// Visitor receives the content Walk meets, typed by wrapper. Embed
+// BaseVisitor to implement only the callbacks of interest.
+//
+// Footers, which the schema does not type apart from headers, are passed to
+// VisitHeaderFooter as a *CT_HdrFtr too. Footnotes and endnotes are passed to
+// VisitNote and content controls (<w:sdt>) to VisitContentControl as
+// *Element.
+type Visitor interface {
+	VisitBody(*CT_Body) WalkAction
+	VisitHeaderFooter(*CT_HdrFtr) WalkAction
+	VisitNote(*Element) WalkAction
+	VisitComment(*CT_Comment) WalkAction
+	VisitParagraph(*CT_P) WalkAction
+	VisitTable(*CT_Tbl) WalkAction
+	VisitRow(*CT_Row) WalkAction
+	VisitCell(*CT_Tc) WalkAction
+	VisitContentControl(*Element) WalkAction
+	VisitHyperlink(*CT_Hyperlink) WalkAction
+	VisitRun(*CT_R) WalkAction
+	VisitText(*CT_Text) WalkAction
+	VisitBreak(*CT_Br) WalkAction
+	VisitDrawing(*CT_Drawing) WalkAction
+	VisitTextbox(*CT_TxbxContent) WalkAction
+
+	// Leave is called after the content of each node passed to a Visit
+	// callback that returned WalkContinue.
+	Leave(Node)
+}
+
+// BaseVisitor implements Visitor with callbacks that continue into all
+// content.
+type BaseVisitor struct{}
+
+func (BaseVisitor) VisitBody(*CT_Body) WalkAction           { return WalkContinue }
+func (BaseVisitor) VisitHeaderFooter(*CT_HdrFtr) WalkAction { return WalkContinue }
+func (BaseVisitor) VisitNote(*Element) WalkAction           { return WalkContinue }
+func (BaseVisitor) VisitComment(*CT_Comment) WalkAction     { return WalkContinue }
+func (BaseVisitor) VisitParagraph(*CT_P) WalkAction         { return WalkContinue }
+func (BaseVisitor) VisitTable(*CT_Tbl) WalkAction           { return WalkContinue }
+func (BaseVisitor) VisitRow(*CT_Row) WalkAction             { return WalkContinue }
+func (BaseVisitor) VisitCell(*CT_Tc) WalkAction             { return WalkContinue }
+func (BaseVisitor) VisitContentControl(*Element) WalkAction { return WalkContinue }
+func (BaseVisitor) VisitHyperlink(*CT_Hyperlink) WalkAction { return WalkContinue }
+func (BaseVisitor) VisitRun(*CT_R) WalkAction               { return WalkContinue }
+func (BaseVisitor) VisitText(*CT_Text) WalkAction           { return WalkContinue }
+func (BaseVisitor) VisitBreak(*CT_Br) WalkAction            { return WalkContinue }
+func (BaseVisitor) VisitDrawing(*CT_Drawing) WalkAction     { return WalkContinue }
+func (BaseVisitor) VisitTextbox(*CT_TxbxContent) WalkAction { return WalkContinue }
+func (BaseVisitor) Leave(Node)                              {}
+
+// Walk traverses the tree under root depth-first in reading order, passing
+// each content node to its Visitor callback before the node's content. It
+// descends through every element, so content nested in elements without a
+// callback of their own, such as revision marks, smart tags and the shapes of
+// a drawing, is still reached; property elements like <w:pPr> are not
+// entered. Of an <mc:AlternateContent>, only the branch an MceProcessor
+// understanding every known namespace selects is walked. Walk reports
+// whether the walk ran to the end rather than being stopped.
+//
+// Callbacks may modify the tree. The siblings of a node are collected before
+// its first sibling is visited and its children after it is visited, so a
+// callback may remove or replace the node it is passed, remove its siblings
+// or change its content: removed nodes are not visited, siblings inserted
+// during the walk are not visited and content inserted into the node is.
+func Walk(root Node, v Visitor) bool {
+	if root == nil {
+		return true
+	}
+	w := &walker{v: v, mce: NewMceProcessor()}
+	e := root.Etree()
+	return w.walk(walkNode(e), e.Parent()) != WalkStop
+}
+
+// WalkAll walks each root in turn, as Walk does, until a callback stops
+// the walk. document.Walk uses it to walk the story parts of a whole
+// document in reading order.
+func WalkAll(roots []Node, v Visitor) bool {
+	for _, root := range roots {
+		if !Walk(root, v) {
+			return false
+		}
+	}
+	return true
+}
+
+type walker struct {
+	v   Visitor
+	mce *MceProcessor
+}
+
+// walk visits n, whose element was a child of parent when the walk reached
+// it, and its content.
+func (w *walker) walk(n Node, parent *etree.Element) WalkAction {
+	e := n.Etree()
+	action, visited := w.visit(n)
+	if action == WalkStop {
+		return WalkStop
+	}
+	// A node the callback removed or replaced has no content left to walk.
+	if action == WalkContinue && e.Parent() == parent {
+		for _, child := range w.children(e) {
+			if child.e.Parent() != child.parent {
+				continue
+			}
+			if w.walk(walkNode(child.e), child.parent) == WalkStop {
+				return WalkStop
+			}
+		}
+	}
+	if visited && action == WalkContinue {
+		w.v.Leave(n)
+	}
+	return WalkContinue
+}
+
+// walkItem is an element to walk and its parent when it was collected.
+type walkItem struct {
+	e, parent *etree.Element
+}
+
+// children returns the elements to walk under e, skipping property
+// elements and resolving markup compatibility alternatives.
+func (w *walker) children(e *etree.Element) []walkItem {
+	var result []walkItem
+	for _, child := range e.ChildElements() {
+		switch {
+		case isPropertyElement(child):
+		case lookupNamespace(child, child.Space) == Nsmap["mc"] && child.Tag == "AlternateContent":
+			if branch := w.mce.SelectContent(child); branch != nil {
+				result = append(result, w.children(branch)...)
+			}
+		default:
+			result = append(result, walkItem{child, e})
+		}
+	}
+	return result
+}
+
+// visit passes n to its Visitor callback, reporting whether it has one.
+func (w *walker) visit(n Node) (WalkAction, bool) {
+	switch n := n.(type) {
+	case *CT_Body:
+		return w.v.VisitBody(n), true
+	case *CT_HdrFtr:
+		return w.v.VisitHeaderFooter(n), true
+	case *CT_Comment:
+		return w.v.VisitComment(n), true
+	case *CT_P:
+		return w.v.VisitParagraph(n), true
+	case *CT_Tbl:
+		return w.v.VisitTable(n), true
+	case *CT_Row:
+		return w.v.VisitRow(n), true
+	case *CT_Tc:
+		return w.v.VisitCell(n), true
+	case *CT_Hyperlink:
+		return w.v.VisitHyperlink(n), true
+	case *CT_R:
+		return w.v.VisitRun(n), true
+	case *CT_Text:
+		return w.v.VisitText(n), true
+	case *CT_Br:
+		return w.v.VisitBreak(n), true
+	case *CT_Drawing:
+		return w.v.VisitDrawing(n), true
+	case *CT_TxbxContent:
+		return w.v.VisitTextbox(n), true
+	case *Element:
+		switch schemaTag(n.E) {
+		case "w:footnote", "w:endnote":
+			return w.v.VisitNote(n), true
+		case "w:sdt":
+			return w.v.VisitContentControl(n), true
+		}
+	}
+	return WalkContinue, false
+}
+
+// walkNode wraps e as WrapElement does, but wraps footers as headers, which
+// share their type.
+func walkNode(e *etree.Element) Node {
+	if schemaTag(e) == "w:ftr" {
+		return &CT_HdrFtr{Element{E: e}}
+	}
+	return WrapElement(e)
+}
+
+// isPropertyElement reports whether e holds properties, such as <w:rPr>,
+// <w:tblPrEx> or <w:sdtPr>, rather than content.
+func isPropertyElement(e *etree.Element) bool {
+	return strings.HasSuffix(e.Tag, "Pr") || strings.HasSuffix(e.Tag, "PrEx")
+}
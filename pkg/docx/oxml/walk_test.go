@@ -0,0 +1,235 @@
+package oxml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const walkTestXml = `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" ` +
+	`xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006" ` +
+	`xmlns:wp="http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing" ` +
+	`xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" ` +
+	`xmlns:wps="http://schemas.microsoft.com/office/word/2010/wordprocessingShape" ` +
+	`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<w:body>` +
+	`<w:p><w:pPr><w:rPr><w:b/></w:rPr></w:pPr><w:r><w:t>one</w:t><w:br/></w:r>` +
+	`<w:hyperlink r:id="rId1"><w:r><w:t>link</w:t></w:r></w:hyperlink>` +
+	`<w:ins w:id="1" w:author="a"><w:r><w:t>ins</w:t></w:r></w:ins></w:p>` +
+	`<w:tbl><w:tblPr/><w:tr><w:tc><w:p><w:r><w:t>cell</w:t></w:r></w:p></w:tc></w:tr></w:tbl>` +
+	`<w:sdt><w:sdtPr><w:alias w:val="x"/></w:sdtPr><w:sdtContent><w:p><w:r><w:t>sdt</w:t></w:r></w:p></w:sdtContent></w:sdt>` +
+	`<w:p><w:r><mc:AlternateContent>` +
+	`<mc:Choice Requires="wps"><w:drawing><wp:anchor><a:graphic><a:graphicData><wps:wsp><wps:txbx>` +
+	`<w:txbxContent><w:p><w:r><w:t>box</w:t></w:r></w:p></w:txbxContent>` +
+	`</wps:txbx></wps:wsp></a:graphicData></a:graphic></wp:anchor></w:drawing></mc:Choice>` +
+	`<mc:Fallback><w:pict><w:txbxContent><w:p><w:r><w:t>fallback</w:t></w:r></w:p></w:txbxContent></w:pict></mc:Fallback>` +
+	`</mc:AlternateContent></w:r></w:p>` +
+	`<w:sectPr/>` +
+	`</w:body></w:document>`
+
+func parseWalkTestXml(t *testing.T) *CT_Document {
+	t.Helper()
+	el, err := ParseXml([]byte(walkTestXml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &CT_Document{Element{E: el}}
+}
+
+// recorder records the nodes it visits and leaves. Its act func, if set,
+// decides the action for each visited node.
+type recorder struct {
+	BaseVisitor
+	events []string
+	act    func(Node) WalkAction
+}
+
+func (r *recorder) record(kind string, n Node) WalkAction {
+	event := kind
+	if kind == "t" {
+		event += ":" + n.Etree().Text()
+	}
+	r.events = append(r.events, event)
+	if r.act != nil {
+		return r.act(n)
+	}
+	return WalkContinue
+}
+
+func (r *recorder) VisitBody(n *CT_Body) WalkAction           { return r.record("body", n) }
+func (r *recorder) VisitHeaderFooter(n *CT_HdrFtr) WalkAction { return r.record(n.E.Tag, n) }
+func (r *recorder) VisitNote(n *Element) WalkAction           { return r.record(n.E.Tag, n) }
+func (r *recorder) VisitComment(n *CT_Comment) WalkAction     { return r.record("comment", n) }
+func (r *recorder) VisitParagraph(n *CT_P) WalkAction         { return r.record("p", n) }
+func (r *recorder) VisitTable(n *CT_Tbl) WalkAction           { return r.record("tbl", n) }
+func (r *recorder) VisitRow(n *CT_Row) WalkAction             { return r.record("tr", n) }
+func (r *recorder) VisitCell(n *CT_Tc) WalkAction             { return r.record("tc", n) }
+func (r *recorder) VisitContentControl(n *Element) WalkAction { return r.record("sdt", n) }
+func (r *recorder) VisitHyperlink(n *CT_Hyperlink) WalkAction { return r.record("hyperlink", n) }
+func (r *recorder) VisitRun(n *CT_R) WalkAction               { return r.record("r", n) }
+func (r *recorder) VisitText(n *CT_Text) WalkAction           { return r.record("t", n) }
+func (r *recorder) VisitBreak(n *CT_Br) WalkAction            { return r.record("br", n) }
+func (r *recorder) VisitDrawing(n *CT_Drawing) WalkAction     { return r.record("drawing", n) }
+func (r *recorder) VisitTextbox(n *CT_TxbxContent) WalkAction { return r.record("txbx", n) }
+func (r *recorder) Leave(n Node)                              { r.events = append(r.events, "/"+n.Etree().Tag) }
+
+// texts returns the recorded text events, joined.
+func (r *recorder) texts() string {
+	var result []string
+	for _, e := range r.events {
+		if t, ok := strings.CutPrefix(e, "t:"); ok {
+			result = append(result, t)
+		}
+	}
+	return strings.Join(result, " ")
+}
+
+func TestWalk_ReadingOrder(t *testing.T) {
+	t.Parallel()
+	doc := parseWalkTestXml(t)
+	r := &recorder{}
+	if !Walk(doc, r) {
+		t.Fatal("Walk reported a stop")
+	}
+	want := []string{
+		"body",
+		"p", "r", "t:one", "/t", "br", "/br", "/r",
+		"hyperlink", "r", "t:link", "/t", "/r", "/hyperlink",
+		"r", "t:ins", "/t", "/r", "/p",
+		"tbl", "tr", "tc", "p", "r", "t:cell", "/t", "/r", "/p", "/tc", "/tr", "/tbl",
+		"sdt", "p", "r", "t:sdt", "/t", "/r", "/p", "/sdt",
+		"p", "r", "drawing", "txbx", "p", "r", "t:box", "/t", "/r", "/p", "/txbxContent", "/drawing", "/r", "/p",
+		"/body",
+	}
+	if !reflect.DeepEqual(r.events, want) {
+		t.Errorf("events:\n got %q\nwant %q", r.events, want)
+	}
+}
+
+func TestWalk_SkipChildrenAndStop(t *testing.T) {
+	t.Parallel()
+	doc := parseWalkTestXml(t)
+
+	skip := &recorder{act: func(n Node) WalkAction {
+		switch n.(type) {
+		case *CT_Tbl, *CT_Hyperlink, *CT_Drawing:
+			return WalkSkipChildren
+		}
+		return WalkContinue
+	}}
+	Walk(doc, skip)
+	if got := skip.texts(); got != "one ins sdt" {
+		t.Errorf("texts skipping tables, hyperlinks and drawings = %q", got)
+	}
+	for _, e := range skip.events {
+		if e == "/tbl" || e == "/hyperlink" {
+			t.Errorf("Leave called for a node whose children were skipped")
+		}
+	}
+
+	stop := &recorder{act: func(n Node) WalkAction {
+		if tc, ok := n.(*CT_Text); ok && tc.E.Text() == "cell" {
+			return WalkStop
+		}
+		return WalkContinue
+	}}
+	if Walk(doc, stop) {
+		t.Error("Walk should report the stop")
+	}
+	if got := stop.texts(); got != "one link ins cell" {
+		t.Errorf("texts until stop = %q", got)
+	}
+	if last := stop.events[len(stop.events)-1]; last != "t:cell" {
+		t.Errorf("last event = %q, want the stopping node", last)
+	}
+}
+
+func TestWalk_Mutation(t *testing.T) {
+	t.Parallel()
+	doc := parseWalkTestXml(t)
+
+	// Redact: remove every run containing "i", and the paragraph after the
+	// table as the table is visited.
+	r := &recorder{act: func(n Node) WalkAction {
+		switch n := n.(type) {
+		case *CT_R:
+			if strings.Contains(textContent(n.E), "i") {
+				n.E.Parent().RemoveChild(n.E)
+			}
+		case *CT_Tbl:
+			sdt := n.E.Parent().ChildElements()[2]
+			sdt.Parent().RemoveChild(sdt)
+		}
+		return WalkContinue
+	}}
+	Walk(doc, r)
+	if got := r.texts(); got != "one cell box" {
+		t.Errorf("texts while redacting = %q", got)
+	}
+	if got := MustCompileQuery("//w:t").Strings(doc.E); !reflect.DeepEqual(got, []string{"one", "cell", "box", "fallback"}) {
+		t.Errorf("texts after redacting = %q", got)
+	}
+
+	// Content added to a visited node is walked; siblings added are not.
+	body := doc.Body()
+	body.E.RemoveChild(body.E.ChildElements()[0])
+	r = &recorder{act: func(n Node) WalkAction {
+		if p, ok := n.(*CT_P); ok && p.E.Parent() == body.E {
+			p.AddR().AddTWithText("child")
+			body.InsertElementBefore(OxmlElement("w:p"), "w:sectPr")
+		}
+		return WalkContinue
+	}}
+	Walk(doc, r)
+	if got := r.texts(); got != "cell box child" {
+		t.Errorf("texts with insertions = %q", got)
+	}
+}
+
+func TestWalkAll_Stories(t *testing.T) {
+	t.Parallel()
+	parse := func(xml string) Node {
+		el, err := ParseXml([]byte(`<w:` + xml[:strings.Index(xml, ">")] +
+			` xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"` + xml[strings.Index(xml, ">"):]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return WrapElement(el)
+	}
+	roots := []Node{
+		parseWalkTestXml(t),
+		parse(`hdr><w:p><w:r><w:t>header</w:t></w:r></w:p></w:hdr>`),
+		parse(`ftr><w:p><w:r><w:t>footer</w:t></w:r></w:p></w:ftr>`),
+		parse(`footnotes><w:footnote w:id="1"><w:p><w:r><w:t>note</w:t></w:r></w:p></w:footnote></w:footnotes>`),
+		parse(`comments><w:comment w:id="0"><w:p><w:r><w:t>remark</w:t></w:r></w:p></w:comment></w:comments>`),
+	}
+	r := &recorder{}
+	if !WalkAll(roots, r) {
+		t.Fatal("WalkAll reported a stop")
+	}
+	if got := r.texts(); got != "one link ins cell sdt box header footer note remark" {
+		t.Errorf("texts = %q", got)
+	}
+	for _, want := range []string{"hdr", "ftr", "footnote", "comment"} {
+		found := false
+		for _, e := range r.events {
+			found = found || e == want
+		}
+		if !found {
+			t.Errorf("no %s visited in %q", want, r.events)
+		}
+	}
+
+	stop := &recorder{act: func(n Node) WalkAction {
+		if _, ok := n.(*CT_HdrFtr); ok {
+			return WalkStop
+		}
+		return WalkContinue
+	}}
+	if WalkAll(roots, stop) {
+		t.Error("WalkAll should report the stop")
+	}
+	if strings.Contains(stop.texts(), "note") {
+		t.Error("WalkAll went on after a stop")
+	}
+}
@@ -0,0 +1,89 @@
+package oxml
+
+import "github.com/beevik/etree"
+
+// --- Typed wrappers ---
+
+// Node is a typed view of an XML element: a pointer to the CT_* type the
+// schema declares for it, such as *CT_P for <w:p>, or *Element for elements
+// the schema does not describe. Callers type-switch on the wrapper types
+// rather than on tags.
+type Node interface {
+	Etree() *etree.Element
+}
+
+// Etree returns the wrapped element.
+func (el *Element) Etree() *etree.Element {
+	return el.E
+}
+
+// WrapElement returns e wrapped in its CT_* type. The type is the one the
+// schema declares for e's tag as a child of its parent's type, or else the
+// type whose tag is e's; *Element is returned when neither identifies a
+// single type. It returns nil for a nil e.
+func WrapElement(e *etree.Element) Node {
+	if e == nil {
+		return nil
+	}
+	return wrapAs(e, metaOf(e))
+}
+
+// Parent returns the parent element wrapped by WrapElement, or nil for the
+// root element.
+func (el *Element) Parent() Node {
+	p := el.E.Parent()
+	if p == nil || isDocumentElement(p) {
+		return nil
+	}
+	return WrapElement(p)
+}
+
+// Children returns the child elements wrapped in their CT_* types, in
+// document order.
+func (el *Element) Children() []Node {
+	meta := metaOf(el.E)
+	var result []Node
+	for _, child := range el.E.ChildElements() {
+		result = append(result, wrapAs(child, childMetaOf(meta, child)))
+	}
+	return result
+}
+
+// metaOf returns the metadata of e's type, resolving shared tags through the
+// types of its ancestors.
+func metaOf(e *etree.Element) *elementMeta {
+	p := e.Parent()
+	if p == nil || isDocumentElement(p) {
+		return metaByTag[schemaTag(e)]
+	}
+	return childMetaOf(metaOf(p), e)
+}
+
+// childMetaOf returns the metadata of the type of child, an element whose
+// parent's type is parent. parent may be nil.
+func childMetaOf(parent *elementMeta, child *etree.Element) *elementMeta {
+	tag := schemaTag(child)
+	if cm := parent.child(tag); cm != nil {
+		return metaByName[cm.typ]
+	}
+	return metaByTag[tag]
+}
+
+func wrapAs(e *etree.Element, meta *elementMeta) Node {
+	if meta == nil || meta.wrap == nil {
+		return &Element{E: e}
+	}
+	return meta.wrap(Element{E: e})
+}
+
+// blockContent returns the paragraphs and tables among nodes.
+func blockContent(nodes []Node) []interface{} {
+	var result []interface{}
+	for _, n := range nodes {
+		switch n.(type) {
+		case *CT_P, *CT_Tbl:
+			result = append(result, n)
+		}
+	}
+	return result
+}
@@ -0,0 +1,76 @@
+package oxml
+
+import (
+	"testing"
+)
+
+func TestWrapElement(t *testing.T) {
+	t.Parallel()
+	root, err := ParseXml([]byte(`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:body><w:p><w:pPr><w:pStyle w:val="Title"/></w:pPr><w:r/><w:ext/></w:p><w:tbl/></w:body></w:document>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := func(expr string) Node { return WrapElement(MustCompileQuery(expr).SelectFirst(root)) }
+
+	if _, ok := q("/w:document").(*CT_Document); !ok {
+		t.Errorf("root = %T, want *CT_Document", q("/w:document"))
+	}
+	// w:pStyle has no type of its own; its parent declares it a CT_String.
+	if s, ok := q("//w:pStyle").(*CT_String); !ok {
+		t.Errorf("<w:pStyle> = %T, want *CT_String", q("//w:pStyle"))
+	} else if v, _ := s.Val(); v != "Title" {
+		t.Errorf("<w:pStyle> val = %q, want Title", v)
+	}
+	if _, ok := q("//w:ext").(*Element); !ok {
+		t.Errorf("<w:ext> = %T, want *Element", q("//w:ext"))
+	}
+	if WrapElement(nil) != nil {
+		t.Error("WrapElement(nil) should be nil")
+	}
+}
+
+func TestWrapElement_SharedTag(t *testing.T) {
+	t.Parallel()
+	space := OxmlElement("c:chartSpace")
+	chart := (&Element{E: space}).AddSubElement("c:chart")
+	if _, ok := WrapElement(chart).(*CT_Chart); !ok {
+		t.Errorf("<c:chart> in <c:chartSpace> = %T, want *CT_Chart", WrapElement(chart))
+	}
+	// Detached, the tag alone does not tell CT_Chart from CT_ChartRef.
+	if _, ok := WrapElement(OxmlElement("c:chart")).(*Element); !ok {
+		t.Errorf("detached <c:chart> = %T, want *Element", WrapElement(OxmlElement("c:chart")))
+	}
+}
+
+func TestElement_ParentChildren(t *testing.T) {
+	t.Parallel()
+	body := &CT_Body{Element{E: OxmlElement("w:body")}}
+	p := body.AddP()
+	r := p.AddR()
+	body.AddTbl()
+
+	children := body.Children()
+	if len(children) != 2 {
+		t.Fatalf("Children() returned %d nodes, want 2", len(children))
+	}
+	if got, ok := children[0].(*CT_P); !ok || got.E != p.E {
+		t.Errorf("Children()[0] = %T, want the *CT_P", children[0])
+	}
+	if _, ok := children[1].(*CT_Tbl); !ok {
+		t.Errorf("Children()[1] = %T, want *CT_Tbl", children[1])
+	}
+
+	if got, ok := r.Parent().(*CT_P); !ok || got.E != p.E {
+		t.Errorf("run Parent() = %T, want the *CT_P", r.Parent())
+	}
+	if got, ok := p.Parent().(*CT_Body); !ok || got.E != body.E {
+		t.Errorf("paragraph Parent() = %T, want the *CT_Body", p.Parent())
+	}
+	if body.Parent() != nil {
+		t.Errorf("root Parent() = %v, want nil", body.Parent())
+	}
+	if n := Node(r); n.Etree() != r.E {
+		t.Error("Etree() should return the wrapped element")
+	}
+}
@@ -0,0 +1,1331 @@
+package oxml
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/beevik/etree"
+)
+
+// Query is a compiled XPath expression. It accepts a pragmatic subset of
+// XPath 1.0:
+//
+//   - location paths, absolute or relative, with the abbreviations //, ., ..
+//     and @;
+//   - the axes child, descendant, descendant-or-self, parent, ancestor,
+//     ancestor-or-self, following-sibling, preceding-sibling, self and
+//     attribute;
+//   - name tests (w:p, w:*, *), and the node tests text() and node();
+//   - predicates, including numeric ones such as [2] and [last()];
+//   - the operators or, and, =, !=, <, <=, >, >=, +, -, *, div, mod and |;
+//   - the functions last, position, count, not, true, false, boolean,
+//     number, string, concat, contains, starts-with, string-length,
+//     normalize-space, local-name and name.
+//
+// Prefixes in the expression are resolved through Nsmap. Prefixes in the
+// document are resolved through the namespace declarations in scope, so
+// <x:p xmlns:x="...wordprocessingml..."> matches w:p. An unprefixed name
+// matches only elements and attributes in no namespace, as in XPath.
+//
+// QueryAll and QueryFirst return the selected elements typed as their CT_*
+// wrappers and are the usual way to run a Query; Select and SelectFirst
+// return the bare etree elements.
+//
+// A Query is safe for concurrent use.
+type Query struct {
+	expr string
+	root xpExpr
+}
+
+// CompileQuery parses expr into a reusable Query.
+func CompileQuery(expr string) (*Query, error) {
+	toks, err := xpLex(expr)
+	if err != nil {
+		return nil, fmt.Errorf("oxml: invalid query %q: %w", expr, err)
+	}
+	p := &xpParser{toks: toks}
+	root, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("oxml: invalid query %q: %w", expr, err)
+	}
+	return &Query{expr: expr, root: root}, nil
+}
+
+// MustCompileQuery is like CompileQuery but panics if expr is invalid. It
+// simplifies initializing package-level queries.
+func MustCompileQuery(expr string) *Query {
+	q, err := CompileQuery(expr)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// String returns the source expression of the query.
+func (q *Query) String() string {
+	return q.expr
+}
+
+// Select evaluates the query with el as the context node and returns the
+// elements of the resulting node-set in document order. It returns nil when
+// the query does not evaluate to a node-set.
+func (q *Query) Select(el *etree.Element) []*etree.Element {
+	if el == nil {
+		return nil
+	}
+	nodes, _ := q.root.eval(newXpContext(el)).([]xpNode)
+	var result []*etree.Element
+	for _, n := range nodes {
+		if n.kind == xpElementNode {
+			result = append(result, n.el)
+		}
+	}
+	return result
+}
+
+// SelectFirst returns the first element Select would return, or nil.
+func (q *Query) SelectFirst(el *etree.Element) *etree.Element {
+	if found := q.Select(el); len(found) > 0 {
+		return found[0]
+	}
+	return nil
+}
+
+// Strings evaluates the query with el as the context node and returns the
+// string value of each node of the resulting node-set, such as the values
+// selected by "//w:pStyle/@w:val". A query that evaluates to a string,
+// number or boolean yields that single value.
+func (q *Query) Strings(el *etree.Element) []string {
+	if el == nil {
+		return nil
+	}
+	v := q.root.eval(newXpContext(el))
+	nodes, ok := v.([]xpNode)
+	if !ok {
+		return []string{xpString(v)}
+	}
+	result := make([]string, len(nodes))
+	for i, n := range nodes {
+		result[i] = n.stringValue()
+	}
+	return result
+}
+
+// Matches evaluates the query with el as the context node and reports its
+// boolean value: whether a node-set is non-empty, a number non-zero or a
+// string non-empty.
+func (q *Query) Matches(el *etree.Element) bool {
+	if el == nil {
+		return false
+	}
+	return xpBoolean(q.root.eval(newXpContext(el)))
+}
+
+// elementWrapper is satisfied by the pointer types of the CT_* wrappers,
+// which embed Element.
+type elementWrapper[T any] interface {
+	*T
+	bind(e *etree.Element)
+}
+
+// bind points the wrapper at e.
+func (el *Element) bind(e *etree.Element) {
+	el.E = e
+}
+
+// QueryAll returns the elements q selects from el wrapped as *T, as in
+// QueryAll[CT_P](q, body.E). Selected elements whose tag is not the one the
+// schema declares for T are skipped.
+func QueryAll[T any, PT elementWrapper[T]](q *Query, el *etree.Element) []PT {
+	meta := metaByName[reflect.TypeFor[T]().Name()]
+	var result []PT
+	for _, e := range q.Select(el) {
+		if meta != nil && schemaTag(e) != meta.tag {
+			continue
+		}
+		w := PT(new(T))
+		w.bind(e)
+		result = append(result, w)
+	}
+	return result
+}
+
+// QueryFirst returns the first element QueryAll would return, or nil.
+func QueryFirst[T any, PT elementWrapper[T]](q *Query, el *etree.Element) PT {
+	if found := QueryAll[T, PT](q, el); len(found) > 0 {
+		return found[0]
+	}
+	return nil
+}
+
+// --- Node model ---
+
+type xpNodeKind int
+
+const (
+	xpRootNode xpNodeKind = iota
+	xpElementNode
+	xpAttrNode
+	xpTextNode
+)
+
+// xpNode is a node of the XPath data model over an etree tree. el is the
+// element itself, the owner of an attribute, the parent of a text node, or
+// for the root node the topmost element: a Document's element, or the root
+// element of a detached tree.
+type xpNode struct {
+	kind xpNodeKind
+	el   *etree.Element
+	attr *etree.Attr
+	text *etree.CharData
+}
+
+// xpRootKey identifies the root node for deduplication and ordering.
+type xpRootKey struct{ top *etree.Element }
+
+func (n xpNode) key() any {
+	switch n.kind {
+	case xpRootNode:
+		return xpRootKey{n.el}
+	case xpAttrNode:
+		return n.attr
+	case xpTextNode:
+		return n.text
+	}
+	return n.el
+}
+
+// isDocumentElement reports whether e is the element a Document embeds.
+func isDocumentElement(e *etree.Element) bool {
+	return e.Parent() == nil && e.Tag == "" && e.Space == ""
+}
+
+func rootNode(n xpNode) xpNode {
+	top := n.el
+	for top.Parent() != nil {
+		top = top.Parent()
+	}
+	return xpNode{kind: xpRootNode, el: top}
+}
+
+func (n xpNode) parent() (xpNode, bool) {
+	switch n.kind {
+	case xpRootNode:
+		return xpNode{}, false
+	case xpElementNode:
+		p := n.el.Parent()
+		if p == nil || isDocumentElement(p) {
+			return rootNode(n), true
+		}
+		return xpNode{kind: xpElementNode, el: p}, true
+	}
+	if isDocumentElement(n.el) {
+		return rootNode(n), true
+	}
+	return xpNode{kind: xpElementNode, el: n.el}, true
+}
+
+func (n xpNode) children() []xpNode {
+	switch n.kind {
+	case xpRootNode:
+		if !isDocumentElement(n.el) {
+			return []xpNode{{kind: xpElementNode, el: n.el}}
+		}
+	case xpElementNode:
+	default:
+		return nil
+	}
+	var result []xpNode
+	for _, tok := range n.el.Child {
+		switch t := tok.(type) {
+		case *etree.Element:
+			result = append(result, xpNode{kind: xpElementNode, el: t})
+		case *etree.CharData:
+			result = append(result, xpNode{kind: xpTextNode, el: n.el, text: t})
+		}
+	}
+	return result
+}
+
+func (n xpNode) attributes() []xpNode {
+	if n.kind != xpElementNode {
+		return nil
+	}
+	var result []xpNode
+	for i := range n.el.Attr {
+		a := &n.el.Attr[i]
+		if a.Space == "xmlns" || a.Space == "" && a.Key == "xmlns" {
+			continue
+		}
+		result = append(result, xpNode{kind: xpAttrNode, el: n.el, attr: a})
+	}
+	return result
+}
+
+func (n xpNode) descendants(result []xpNode) []xpNode {
+	for _, c := range n.children() {
+		result = append(result, c)
+		result = c.descendants(result)
+	}
+	return result
+}
+
+func (n xpNode) siblings() []xpNode {
+	if n.kind != xpElementNode && n.kind != xpTextNode {
+		return nil
+	}
+	p, ok := n.parent()
+	if !ok {
+		return nil
+	}
+	return p.children()
+}
+
+// name returns the namespace URI and local name of an element or attribute.
+func (n xpNode) name() (uri, local string) {
+	switch n.kind {
+	case xpElementNode:
+		return lookupNamespace(n.el, n.el.Space), n.el.Tag
+	case xpAttrNode:
+		if n.attr.Space == "" {
+			return "", n.attr.Key
+		}
+		return lookupNamespace(n.el, n.attr.Space), n.attr.Key
+	}
+	return "", ""
+}
+
+// qualifiedName returns the name as written in the document.
+func (n xpNode) qualifiedName() string {
+	switch n.kind {
+	case xpElementNode:
+		return n.el.FullTag()
+	case xpAttrNode:
+		return n.attr.FullKey()
+	}
+	return ""
+}
+
+func (n xpNode) stringValue() string {
+	switch n.kind {
+	case xpAttrNode:
+		return n.attr.Value
+	case xpTextNode:
+		return n.text.Data
+	}
+	var sb strings.Builder
+	for _, d := range n.descendants(nil) {
+		if d.kind == xpTextNode {
+			sb.WriteString(d.text.Data)
+		}
+	}
+	return sb.String()
+}
+
+// --- Evaluation ---
+
+// xpValue is a node-set ([]xpNode, in document order), a string, a float64
+// or a bool.
+type xpValue any
+
+type xpContext struct {
+	node      xpNode
+	pos, size int
+	order     *xpOrder
+}
+
+func newXpContext(el *etree.Element) *xpContext {
+	return &xpContext{
+		node:  xpNode{kind: xpElementNode, el: el},
+		pos:   1,
+		size:  1,
+		order: &xpOrder{},
+	}
+}
+
+func (ctx *xpContext) at(n xpNode, pos, size int) *xpContext {
+	return &xpContext{node: n, pos: pos, size: size, order: ctx.order}
+}
+
+// xpOrder numbers the nodes of a tree in document order, on first use.
+type xpOrder struct {
+	index map[any]int
+}
+
+func (o *xpOrder) sort(nodes []xpNode) {
+	if len(nodes) < 2 {
+		return
+	}
+	if o.index == nil {
+		o.index = make(map[any]int)
+		var number func(n xpNode)
+		number = func(n xpNode) {
+			o.index[n.key()] = len(o.index)
+			for _, a := range n.attributes() {
+				o.index[a.key()] = len(o.index)
+			}
+			for _, c := range n.children() {
+				number(c)
+			}
+		}
+		number(rootNode(nodes[0]))
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return o.index[nodes[i].key()] < o.index[nodes[j].key()]
+	})
+}
+
+// union appends the nodes of b missing from a and returns the result in
+// document order.
+func (o *xpOrder) union(a, b []xpNode) []xpNode {
+	seen := make(map[any]bool, len(a)+len(b))
+	result := make([]xpNode, 0, len(a)+len(b))
+	for _, nodes := range [][]xpNode{a, b} {
+		for _, n := range nodes {
+			if !seen[n.key()] {
+				seen[n.key()] = true
+				result = append(result, n)
+			}
+		}
+	}
+	o.sort(result)
+	return result
+}
+
+func xpString(v xpValue) string {
+	switch v := v.(type) {
+	case []xpNode:
+		if len(v) == 0 {
+			return ""
+		}
+		return v[0].stringValue()
+	case string:
+		return v
+	case float64:
+		switch {
+		case math.IsNaN(v):
+			return "NaN"
+		case math.IsInf(v, 1):
+			return "Infinity"
+		case math.IsInf(v, -1):
+			return "-Infinity"
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+func xpNumber(v xpValue) float64 {
+	switch v := v.(type) {
+	case float64:
+		return v
+	case bool:
+		if v {
+			return 1
+		}
+		return 0
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(xpString(v)), 64)
+	if err != nil {
+		return math.NaN()
+	}
+	return f
+}
+
+func xpBoolean(v xpValue) bool {
+	switch v := v.(type) {
+	case []xpNode:
+		return len(v) > 0
+	case string:
+		return v != ""
+	case float64:
+		return v != 0 && !math.IsNaN(v)
+	case bool:
+		return v
+	}
+	return false
+}
+
+// xpCompare applies a comparison operator with the XPath 1.0 conversion
+// rules: a node-set compares true if any of its nodes does.
+func xpCompare(op string, l, r xpValue) bool {
+	ln, lIsSet := l.([]xpNode)
+	rn, rIsSet := r.([]xpNode)
+	switch {
+	case lIsSet && rIsSet:
+		for _, a := range ln {
+			for _, b := range rn {
+				if xpCompare(op, a.stringValue(), b.stringValue()) {
+					return true
+				}
+			}
+		}
+		return false
+	case lIsSet || rIsSet:
+		set, other := ln, r
+		if rIsSet {
+			set, other = rn, l
+		}
+		if b, ok := other.(bool); ok {
+			return xpCompareScalars(op, xpBoolean(set), b, rIsSet)
+		}
+		for _, n := range set {
+			var v xpValue = n.stringValue()
+			if _, ok := other.(float64); ok {
+				v = xpNumber(v)
+			}
+			if xpCompareScalars(op, v, other, rIsSet) {
+				return true
+			}
+		}
+		return false
+	}
+	return xpCompareScalars(op, l, r, false)
+}
+
+// xpCompareScalars compares two non-node-set values; swapped means a is
+// the right-hand operand.
+func xpCompareScalars(op string, a, b xpValue, swapped bool) bool {
+	if swapped {
+		a, b = b, a
+	}
+	if op == "=" || op == "!=" {
+		var eq bool
+		_, aBool := a.(bool)
+		_, bBool := b.(bool)
+		_, aNum := a.(float64)
+		_, bNum := b.(float64)
+		switch {
+		case aBool || bBool:
+			eq = xpBoolean(a) == xpBoolean(b)
+		case aNum || bNum:
+			eq = xpNumber(a) == xpNumber(b)
+		default:
+			eq = xpString(a) == xpString(b)
+		}
+		return eq == (op == "=")
+	}
+	x, y := xpNumber(a), xpNumber(b)
+	switch op {
+	case "<":
+		return x < y
+	case "<=":
+		return x <= y
+	case ">":
+		return x > y
+	case ">=":
+		return x >= y
+	}
+	return false
+}
+
+// --- Expressions ---
+
+type xpExpr interface {
+	eval(ctx *xpContext) xpValue
+}
+
+type xpLiteral struct{ val xpValue }
+
+func (e xpLiteral) eval(*xpContext) xpValue { return e.val }
+
+type xpBinary struct {
+	op   string
+	l, r xpExpr
+}
+
+func (e *xpBinary) eval(ctx *xpContext) xpValue {
+	switch e.op {
+	case "or":
+		return xpBoolean(e.l.eval(ctx)) || xpBoolean(e.r.eval(ctx))
+	case "and":
+		return xpBoolean(e.l.eval(ctx)) && xpBoolean(e.r.eval(ctx))
+	case "|":
+		l, _ := e.l.eval(ctx).([]xpNode)
+		r, _ := e.r.eval(ctx).([]xpNode)
+		return ctx.order.union(l, r)
+	}
+	l, r := e.l.eval(ctx), e.r.eval(ctx)
+	switch e.op {
+	case "+":
+		return xpNumber(l) + xpNumber(r)
+	case "-":
+		return xpNumber(l) - xpNumber(r)
+	case "*":
+		return xpNumber(l) * xpNumber(r)
+	case "div":
+		return xpNumber(l) / xpNumber(r)
+	case "mod":
+		return math.Mod(xpNumber(l), xpNumber(r))
+	}
+	return xpCompare(e.op, l, r)
+}
+
+type xpNegate struct{ x xpExpr }
+
+func (e xpNegate) eval(ctx *xpContext) xpValue { return -xpNumber(e.x.eval(ctx)) }
+
+// xpPath is a location path, or a filter expression followed by steps.
+type xpPath struct {
+	filter   xpExpr // nil for a location path
+	absolute bool
+	steps    []*xpStep
+}
+
+func (e *xpPath) eval(ctx *xpContext) xpValue {
+	var nodes []xpNode
+	switch {
+	case e.filter != nil:
+		v := e.filter.eval(ctx)
+		if len(e.steps) == 0 {
+			return v
+		}
+		nodes, _ = v.([]xpNode)
+	case e.absolute:
+		nodes = []xpNode{rootNode(ctx.node)}
+	default:
+		nodes = []xpNode{ctx.node}
+	}
+	for _, s := range e.steps {
+		nodes = s.apply(ctx, nodes)
+	}
+	return nodes
+}
+
+// xpFilter is a primary expression with predicates.
+type xpFilter struct {
+	primary xpExpr
+	preds   []xpExpr
+}
+
+func (e *xpFilter) eval(ctx *xpContext) xpValue {
+	v := e.primary.eval(ctx)
+	nodes, ok := v.([]xpNode)
+	if !ok {
+		return v
+	}
+	for _, pred := range e.preds {
+		nodes = filterNodes(ctx, nodes, pred)
+	}
+	return nodes
+}
+
+type xpAxis int
+
+const (
+	axisChild xpAxis = iota
+	axisDescendant
+	axisDescendantOrSelf
+	axisParent
+	axisAncestor
+	axisAncestorOrSelf
+	axisFollowingSibling
+	axisPrecedingSibling
+	axisSelf
+	axisAttribute
+)
+
+var xpAxes = map[string]xpAxis{
+	"child":              axisChild,
+	"descendant":         axisDescendant,
+	"descendant-or-self": axisDescendantOrSelf,
+	"parent":             axisParent,
+	"ancestor":           axisAncestor,
+	"ancestor-or-self":   axisAncestorOrSelf,
+	"following-sibling":  axisFollowingSibling,
+	"preceding-sibling":  axisPrecedingSibling,
+	"self":               axisSelf,
+	"attribute":          axisAttribute,
+}
+
+// reverse reports whether the axis lists nodes in reverse document order,
+// which is the order predicate positions count in.
+func (a xpAxis) reverse() bool {
+	return a == axisParent || a == axisAncestor || a == axisAncestorOrSelf || a == axisPrecedingSibling
+}
+
+// nodes returns the nodes on the axis from n, in axis order.
+func (a xpAxis) nodes(n xpNode) []xpNode {
+	switch a {
+	case axisChild:
+		return n.children()
+	case axisDescendant:
+		return n.descendants(nil)
+	case axisDescendantOrSelf:
+		return n.descendants([]xpNode{n})
+	case axisParent:
+		if p, ok := n.parent(); ok {
+			return []xpNode{p}
+		}
+		return nil
+	case axisAncestor, axisAncestorOrSelf:
+		var result []xpNode
+		if a == axisAncestorOrSelf {
+			result = append(result, n)
+		}
+		for p, ok := n.parent(); ok; p, ok = p.parent() {
+			result = append(result, p)
+		}
+		return result
+	case axisFollowingSibling, axisPrecedingSibling:
+		if n.kind == xpAttrNode {
+			return nil
+		}
+		sibs := n.siblings()
+		for i, s := range sibs {
+			if s.key() != n.key() {
+				continue
+			}
+			if a == axisFollowingSibling {
+				return sibs[i+1:]
+			}
+			preceding := make([]xpNode, i)
+			for j := range preceding {
+				preceding[j] = sibs[i-1-j]
+			}
+			return preceding
+		}
+		return nil
+	case axisSelf:
+		return []xpNode{n}
+	case axisAttribute:
+		return n.attributes()
+	}
+	return nil
+}
+
+type xpTestKind int
+
+const (
+	testName xpTestKind = iota // uri and local; local "*" matches any name
+	testAnyName
+	testText
+	testNode
+)
+
+type xpNodeTest struct {
+	kind  xpTestKind
+	uri   string
+	local string
+}
+
+// matches reports whether n passes the test on an axis whose principal node
+// type is principal.
+func (t xpNodeTest) matches(n xpNode, principal xpNodeKind) bool {
+	switch t.kind {
+	case testNode:
+		return true
+	case testText:
+		return n.kind == xpTextNode
+	case testAnyName:
+		return n.kind == principal
+	}
+	if n.kind != principal {
+		return false
+	}
+	uri, local := n.name()
+	return uri == t.uri && (t.local == "*" || local == t.local)
+}
+
+type xpStep struct {
+	axis  xpAxis
+	test  xpNodeTest
+	preds []xpExpr
+}
+
+func (s *xpStep) apply(ctx *xpContext, nodes []xpNode) []xpNode {
+	principal := xpElementNode
+	if s.axis == axisAttribute {
+		principal = xpAttrNode
+	}
+	seen := make(map[any]bool)
+	var result []xpNode
+	for _, n := range nodes {
+		var candidates []xpNode
+		for _, c := range s.axis.nodes(n) {
+			if s.test.matches(c, principal) {
+				candidates = append(candidates, c)
+			}
+		}
+		for _, pred := range s.preds {
+			candidates = filterNodes(ctx, candidates, pred)
+		}
+		for _, c := range candidates {
+			if !seen[c.key()] {
+				seen[c.key()] = true
+				result = append(result, c)
+			}
+		}
+	}
+	if len(nodes) > 1 || s.axis.reverse() {
+		ctx.order.sort(result)
+	}
+	return result
+}
+
+// filterNodes keeps the nodes for which pred holds. A numeric predicate
+// holds for the node at that position.
+func filterNodes(ctx *xpContext, nodes []xpNode, pred xpExpr) []xpNode {
+	var result []xpNode
+	for i, n := range nodes {
+		v := pred.eval(ctx.at(n, i+1, len(nodes)))
+		if f, ok := v.(float64); ok {
+			if f == float64(i+1) {
+				result = append(result, n)
+			}
+			continue
+		}
+		if xpBoolean(v) {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// --- Functions ---
+
+type xpFunc struct {
+	minArgs, maxArgs int // maxArgs -1 for any number
+	call             func(ctx *xpContext, args []xpValue) xpValue
+}
+
+var xpFuncs = map[string]xpFunc{
+	"last":     {0, 0, func(ctx *xpContext, _ []xpValue) xpValue { return float64(ctx.size) }},
+	"position": {0, 0, func(ctx *xpContext, _ []xpValue) xpValue { return float64(ctx.pos) }},
+	"count": {1, 1, func(_ *xpContext, args []xpValue) xpValue {
+		nodes, _ := args[0].([]xpNode)
+		return float64(len(nodes))
+	}},
+	"not":     {1, 1, func(_ *xpContext, args []xpValue) xpValue { return !xpBoolean(args[0]) }},
+	"true":    {0, 0, func(*xpContext, []xpValue) xpValue { return true }},
+	"false":   {0, 0, func(*xpContext, []xpValue) xpValue { return false }},
+	"boolean": {1, 1, func(_ *xpContext, args []xpValue) xpValue { return xpBoolean(args[0]) }},
+	"number": {0, 1, func(ctx *xpContext, args []xpValue) xpValue {
+		return xpNumber(contextArg(ctx, args))
+	}},
+	"string": {0, 1, func(ctx *xpContext, args []xpValue) xpValue {
+		return xpString(contextArg(ctx, args))
+	}},
+	"concat": {2, -1, func(_ *xpContext, args []xpValue) xpValue {
+		var sb strings.Builder
+		for _, a := range args {
+			sb.WriteString(xpString(a))
+		}
+		return sb.String()
+	}},
+	"contains": {2, 2, func(_ *xpContext, args []xpValue) xpValue {
+		return strings.Contains(xpString(args[0]), xpString(args[1]))
+	}},
+	"starts-with": {2, 2, func(_ *xpContext, args []xpValue) xpValue {
+		return strings.HasPrefix(xpString(args[0]), xpString(args[1]))
+	}},
+	"string-length": {0, 1, func(ctx *xpContext, args []xpValue) xpValue {
+		return float64(utf8.RuneCountInString(xpString(contextArg(ctx, args))))
+	}},
+	"normalize-space": {0, 1, func(ctx *xpContext, args []xpValue) xpValue {
+		return strings.Join(strings.Fields(xpString(contextArg(ctx, args))), " ")
+	}},
+	"local-name": {0, 1, func(ctx *xpContext, args []xpValue) xpValue {
+		if n, ok := firstNode(contextArg(ctx, args)); ok {
+			_, local := n.name()
+			return local
+		}
+		return ""
+	}},
+	"name": {0, 1, func(ctx *xpContext, args []xpValue) xpValue {
+		if n, ok := firstNode(contextArg(ctx, args)); ok {
+			return n.qualifiedName()
+		}
+		return ""
+	}},
+}
+
+// contextArg returns the single argument of a function whose argument
+// defaults to the context node.
+func contextArg(ctx *xpContext, args []xpValue) xpValue {
+	if len(args) == 0 {
+		return []xpNode{ctx.node}
+	}
+	return args[0]
+}
+
+func firstNode(v xpValue) (xpNode, bool) {
+	if nodes, _ := v.([]xpNode); len(nodes) > 0 {
+		return nodes[0], true
+	}
+	return xpNode{}, false
+}
+
+type xpCall struct {
+	fn   xpFunc
+	args []xpExpr
+}
+
+func (e *xpCall) eval(ctx *xpContext) xpValue {
+	args := make([]xpValue, len(e.args))
+	for i, a := range e.args {
+		args[i] = a.eval(ctx)
+	}
+	return e.fn.call(ctx, args)
+}
+
+// --- Lexer ---
+
+type xpTokKind int
+
+const (
+	tokEOF      xpTokKind = iota
+	tokName               // NCName, QName or a name test such as * and w:*
+	tokNumber             // 12, 1.5
+	tokLiteral            // 'text' or "text", without quotes
+	tokOperator           // and or mod div * / // | + - = != < <= > >=
+	tokPunct              // ( ) [ ] . .. @ , ::
+)
+
+type xpToken struct {
+	kind xpTokKind
+	text string
+	pos  int
+}
+
+// xpLex splits expr into tokens, telling operator names and * apart from
+// name tests by the preceding token as XPath 1.0 section 3.7 specifies.
+func xpLex(expr string) ([]xpToken, error) {
+	var toks []xpToken
+	// operatorContext reports whether a name or * at this point is an operator.
+	operatorContext := func() bool {
+		if len(toks) == 0 {
+			return false
+		}
+		prev := toks[len(toks)-1]
+		switch prev.kind {
+		case tokOperator:
+			return false
+		case tokPunct:
+			return prev.text == ")" || prev.text == "]" || prev.text == "." || prev.text == ".."
+		}
+		return true
+	}
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(expr[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated literal at offset %d", i)
+			}
+			toks = append(toks, xpToken{tokLiteral, expr[i+1 : i+1+end], start})
+			i += end + 2
+			continue
+		case c >= '0' && c <= '9' || c == '.' && i+1 < len(expr) && expr[i+1] >= '0' && expr[i+1] <= '9':
+			for i < len(expr) && (expr[i] >= '0' && expr[i] <= '9' || expr[i] == '.') {
+				i++
+			}
+			toks = append(toks, xpToken{tokNumber, expr[start:i], start})
+			continue
+		case c == '*':
+			kind := tokName
+			if operatorContext() {
+				kind = tokOperator
+			}
+			toks = append(toks, xpToken{kind, "*", start})
+			i++
+			continue
+		}
+		if r, _ := utf8.DecodeRuneInString(expr[i:]); isNameStart(r) {
+			i = scanName(expr, i)
+			if i+1 < len(expr) && expr[i] == ':' && expr[i+1] != ':' {
+				// QName or prefix:*
+				if expr[i+1] == '*' {
+					i += 2
+				} else if r, _ := utf8.DecodeRuneInString(expr[i+1:]); isNameStart(r) {
+					i = scanName(expr, i+1)
+				}
+			}
+			name := expr[start:i]
+			if operatorContext() {
+				switch name {
+				case "and", "or", "mod", "div":
+					toks = append(toks, xpToken{tokOperator, name, start})
+					continue
+				}
+				return nil, fmt.Errorf("unexpected name %q at offset %d", name, start)
+			}
+			toks = append(toks, xpToken{tokName, name, start})
+			continue
+		}
+		for _, sym := range xpSymbols {
+			if strings.HasPrefix(expr[i:], sym) {
+				kind := tokPunct
+				if xpOperatorSymbols[sym] {
+					kind = tokOperator
+				}
+				toks = append(toks, xpToken{kind, sym, start})
+				i += len(sym)
+				break
+			}
+		}
+		if i == start {
+			return nil, fmt.Errorf("unexpected character %q at offset %d", c, i)
+		}
+	}
+	return append(toks, xpToken{tokEOF, "", len(expr)}), nil
+}
+
+// xpSymbols lists the symbol tokens, longest first where one is a prefix of
+// another.
+var xpSymbols = []string{"//", "!=", "<=", ">=", "::", "..", "/", "|", "+", "-", "=", "<", ">", "(", ")", "[", "]", ".", "@", ","}
+
+var xpOperatorSymbols = map[string]bool{
+	"//": true, "/": true, "|": true, "+": true, "-": true,
+	"=": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true,
+}
+
+func isNameStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+// scanName returns the end of the NCName starting at i.
+func scanName(s string, i int) int {
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !(isNameStart(r) || unicode.IsDigit(r) || r == '-' || r == '.') {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// --- Parser ---
+
+type xpParser struct {
+	toks []xpToken
+	pos  int
+}
+
+func (p *xpParser) peek() xpToken { return p.toks[p.pos] }
+func (p *xpParser) peekAt(n int) xpToken {
+	if p.pos+n < len(p.toks) {
+		return p.toks[p.pos+n]
+	}
+	return p.toks[len(p.toks)-1]
+}
+
+func (p *xpParser) next() xpToken {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token if it is of kind with one of texts.
+func (p *xpParser) accept(kind xpTokKind, texts ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != kind {
+		return "", false
+	}
+	for _, text := range texts {
+		if t.text == text {
+			p.pos++
+			return text, true
+		}
+	}
+	return "", false
+}
+
+func (p *xpParser) expect(text string) error {
+	if t := p.next(); t.text != text || t.kind == tokLiteral {
+		return p.errorf(t, "expected %q", text)
+	}
+	return nil
+}
+
+func (p *xpParser) errorf(t xpToken, format string, args ...any) error {
+	msg := fmt.Sprintf(format, args...)
+	if t.kind == tokEOF {
+		return fmt.Errorf("%s at end of expression", msg)
+	}
+	return fmt.Errorf("%s at offset %d", msg, t.pos)
+}
+
+func (p *xpParser) parse() (xpExpr, error) {
+	e, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, p.errorf(t, "unexpected %q", t.text)
+	}
+	return e, nil
+}
+
+// xpPrecedence lists the binary operators from the loosest binding.
+var xpPrecedence = [][]string{
+	{"or"},
+	{"and"},
+	{"=", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "div", "mod"},
+}
+
+func (p *xpParser) parseBinary(level int) (xpExpr, error) {
+	if level == len(xpPrecedence) {
+		return p.parseUnary()
+	}
+	l, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept(tokOperator, xpPrecedence[level]...)
+		if !ok {
+			return l, nil
+		}
+		r, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		l = &xpBinary{op: op, l: l, r: r}
+	}
+}
+
+func (p *xpParser) parseUnary() (xpExpr, error) {
+	if _, ok := p.accept(tokOperator, "-"); ok {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return xpNegate{x}, nil
+	}
+	l, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.accept(tokOperator, "|"); !ok {
+			return l, nil
+		}
+		r, err := p.parsePath()
+		if err != nil {
+			return nil, err
+		}
+		l = &xpBinary{op: "|", l: l, r: r}
+	}
+}
+
+// descendantOrSelf is the step // abbreviates.
+var descendantOrSelf = &xpStep{axis: axisDescendantOrSelf, test: xpNodeTest{kind: testNode}}
+
+func (p *xpParser) parsePath() (xpExpr, error) {
+	path := &xpPath{}
+	t := p.peek()
+	switch {
+	case t.kind == tokOperator && (t.text == "/" || t.text == "//"):
+		p.next()
+		path.absolute = true
+		if t.text == "//" {
+			path.steps = append(path.steps, descendantOrSelf)
+		} else if !p.startsStep() {
+			return path, nil
+		}
+	case p.startsPrimary():
+		primary, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		preds, err := p.parsePredicates()
+		if err != nil {
+			return nil, err
+		}
+		path.filter = primary
+		if len(preds) > 0 {
+			path.filter = &xpFilter{primary: primary, preds: preds}
+		}
+		sep, ok := p.accept(tokOperator, "/", "//")
+		if !ok {
+			return path.filter, nil
+		}
+		if sep == "//" {
+			path.steps = append(path.steps, descendantOrSelf)
+		}
+	}
+	for {
+		step, err := p.parseStep()
+		if err != nil {
+			return nil, err
+		}
+		path.steps = append(path.steps, step)
+		sep, ok := p.accept(tokOperator, "/", "//")
+		if !ok {
+			return path, nil
+		}
+		if sep == "//" {
+			path.steps = append(path.steps, descendantOrSelf)
+		}
+	}
+}
+
+func (p *xpParser) startsStep() bool {
+	t := p.peek()
+	return t.kind == tokName || t.kind == tokPunct && (t.text == "." || t.text == ".." || t.text == "@")
+}
+
+func (p *xpParser) startsPrimary() bool {
+	t := p.peek()
+	switch t.kind {
+	case tokLiteral, tokNumber:
+		return true
+	case tokPunct:
+		return t.text == "("
+	case tokName:
+		next := p.peekAt(1)
+		return next.kind == tokPunct && next.text == "(" && t.text != "node" && t.text != "text"
+	}
+	return false
+}
+
+func (p *xpParser) parsePrimary() (xpExpr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokLiteral:
+		return xpLiteral{t.text}, nil
+	case tokNumber:
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, p.errorf(t, "invalid number %q", t.text)
+		}
+		return xpLiteral{f}, nil
+	case tokPunct: // (
+		e, err := p.parseBinary(0)
+		if err != nil {
+			return nil, err
+		}
+		return e, p.expect(")")
+	}
+	fn, ok := xpFuncs[t.text]
+	if !ok {
+		return nil, p.errorf(t, "unsupported function %s()", t.text)
+	}
+	p.next() // (
+	call := &xpCall{fn: fn}
+	if _, ok := p.accept(tokPunct, ")"); !ok {
+		for {
+			arg, err := p.parseBinary(0)
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, arg)
+			if _, ok := p.accept(tokPunct, ","); !ok {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	}
+	if len(call.args) < fn.minArgs || fn.maxArgs >= 0 && len(call.args) > fn.maxArgs {
+		return nil, p.errorf(t, "wrong number of arguments to %s()", t.text)
+	}
+	return call, nil
+}
+
+func (p *xpParser) parsePredicates() ([]xpExpr, error) {
+	var preds []xpExpr
+	for {
+		if _, ok := p.accept(tokPunct, "["); !ok {
+			return preds, nil
+		}
+		e, err := p.parseBinary(0)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		preds = append(preds, e)
+	}
+}
+
+func (p *xpParser) parseStep() (*xpStep, error) {
+	if _, ok := p.accept(tokPunct, "."); ok {
+		return &xpStep{axis: axisSelf, test: xpNodeTest{kind: testNode}}, nil
+	}
+	if _, ok := p.accept(tokPunct, ".."); ok {
+		return &xpStep{axis: axisParent, test: xpNodeTest{kind: testNode}}, nil
+	}
+	step := &xpStep{axis: axisChild}
+	if _, ok := p.accept(tokPunct, "@"); ok {
+		step.axis = axisAttribute
+	} else if t, next := p.peek(), p.peekAt(1); t.kind == tokName && next.kind == tokPunct && next.text == "::" {
+		axis, ok := xpAxes[t.text]
+		if !ok {
+			return nil, p.errorf(t, "unsupported axis %q", t.text)
+		}
+		step.axis = axis
+		p.pos += 2
+	}
+
+	t := p.next()
+	if t.kind != tokName {
+		return nil, p.errorf(t, "expected a node test")
+	}
+	if next := p.peek(); next.kind == tokPunct && next.text == "(" {
+		switch t.text {
+		case "node":
+			step.test = xpNodeTest{kind: testNode}
+		case "text":
+			step.test = xpNodeTest{kind: testText}
+		default:
+			return nil, p.errorf(t, "unsupported node test %s()", t.text)
+		}
+		p.next()
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	} else {
+		test, err := nameTest(t.text)
+		if err != nil {
+			return nil, p.errorf(t, "%v", err)
+		}
+		step.test = test
+	}
+
+	preds, err := p.parsePredicates()
+	if err != nil {
+		return nil, err
+	}
+	step.preds = preds
+	return step, nil
+}
+
+// nameTest resolves a name test such as "w:p", "w:*", "*" or "val".
+func nameTest(name string) (xpNodeTest, error) {
+	if name == "*" {
+		return xpNodeTest{kind: testAnyName}, nil
+	}
+	pfx, local, ok := strings.Cut(name, ":")
+	if !ok {
+		return xpNodeTest{kind: testName, local: name}, nil
+	}
+	uri, known := Nsmap[pfx]
+	if !known {
+		return xpNodeTest{}, fmt.Errorf("unknown namespace prefix %q", pfx)
+	}
+	return xpNodeTest{kind: testName, uri: uri, local: local}, nil
+}
@@ -0,0 +1,228 @@
+package oxml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/beevik/etree"
+)
+
+const xpathTestXml = `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+	`<w:body>` +
+	`<w:p><w:pPr><w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t>Title</w:t></w:r></w:p>` +
+	`<w:p><w:r><w:rPr><w:b/></w:rPr><w:t>bold</w:t></w:r><w:r><w:t xml:space="preserve"> plain</w:t></w:r></w:p>` +
+	`<w:tbl><w:tr><w:tc><w:p><w:r><w:rPr><w:b w:val="0"/></w:rPr><w:t>cell</w:t></w:r></w:p></w:tc></w:tr></w:tbl>` +
+	`<w:p><w:pPr><w:pStyle w:val="Heading2"/></w:pPr></w:p>` +
+	`<w:sectPr/>` +
+	`</w:body></w:document>`
+
+func parseXpathTestXml(t *testing.T) *etree.Element {
+	t.Helper()
+	el, err := ParseXml([]byte(xpathTestXml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return el
+}
+
+// texts returns the tag and text content of each element.
+func texts(els []*etree.Element) []string {
+	var result []string
+	for _, el := range els {
+		result = append(result, el.FullTag()+":"+textContent(el))
+	}
+	return result
+}
+
+func textContent(el *etree.Element) string {
+	var sb strings.Builder
+	for _, tok := range el.Child {
+		switch tok := tok.(type) {
+		case *etree.CharData:
+			sb.WriteString(tok.Data)
+		case *etree.Element:
+			sb.WriteString(textContent(tok))
+		}
+	}
+	return sb.String()
+}
+
+func TestQuery_Select(t *testing.T) {
+	t.Parallel()
+	root := parseXpathTestXml(t)
+	body := root.ChildElements()[0]
+
+	tests := []struct {
+		expr    string
+		context *etree.Element
+		want    []string
+	}{
+		{".//w:p[w:pPr/w:pStyle/@w:val='Heading1']", root, []string{"w:p:Title"}},
+		{"//w:r[w:rPr/w:b]", body, []string{"w:r:bold", "w:r:cell"}},
+		{"//w:r[w:rPr/w:b[not(@w:val='0')]]", body, []string{"w:r:bold"}},
+		{"w:p", body, []string{"w:p:Title", "w:p:bold plain", "w:p:"}},
+		{"w:p[2]", body, []string{"w:p:bold plain"}},
+		{"w:p[last()]", body, []string{"w:p:"}},
+		{"w:p[position() < 3]", body, []string{"w:p:Title", "w:p:bold plain"}},
+		{"/w:document/w:body/w:*[last()]", body, []string{"w:sectPr:"}},
+		{"//w:t[text()='cell']/ancestor::w:tbl", root, []string{"w:tbl:cell"}},
+		{"//w:t[.='cell']/ancestor::*[2]", root, []string{"w:p:cell"}},
+		{"w:tbl/preceding-sibling::w:p[1]", body, []string{"w:p:bold plain"}},
+		{"w:tbl/following-sibling::*", body, []string{"w:p:", "w:sectPr:"}},
+		{"//w:p[count(w:r) = 2] | w:p[1]", body, []string{"w:p:Title", "w:p:bold plain"}},
+		{"//w:t[starts-with(., ' ')]/..", body, []string{"w:r: plain"}},
+		{"//w:t[@xml:space='preserve']", body, []string{"w:t: plain"}},
+		{"..", body, []string{"w:document:Titlebold plaincell"}},
+		{"self::w:body/w:p[w:pPr][2]", body, []string{"w:p:"}},
+		{"descendant::w:tc//w:t", body, []string{"w:t:cell"}},
+		{"w:p[contains(string(), 'ol')]", body, []string{"w:p:bold plain"}},
+		{"w:p[normalize-space(w:r[2]) = 'plain']", body, []string{"w:p:bold plain"}},
+		{"//w:p[local-name(..) = 'tc']", root, []string{"w:p:cell"}},
+		{"w:p[(1 + 2) * 2 div 3 mod 4]", body, []string{"w:p:bold plain"}},
+		{"w:p[-1 + 2]", body, []string{"w:p:Title"}},
+		{"//w:nothing", root, nil},
+	}
+	for _, tt := range tests {
+		q, err := CompileQuery(tt.expr)
+		if err != nil {
+			t.Errorf("CompileQuery(%q): %v", tt.expr, err)
+			continue
+		}
+		if got := texts(q.Select(tt.context)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestQuery_DocumentPrefixesAreResolved(t *testing.T) {
+	t.Parallel()
+	el, err := ParseXml([]byte(`<x:document xmlns:x="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<x:body><x:p/><p/><p xmlns="http://schemas.openxmlformats.org/wordprocessingml/2006/main"/></x:body></x:document>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(MustCompileQuery("//w:p").Select(el)); got != 2 {
+		t.Errorf("//w:p selected %d elements, want 2", got)
+	}
+	if got := len(MustCompileQuery("//p").Select(el)); got != 1 {
+		t.Errorf("//p selected %d elements, want the one in no namespace", got)
+	}
+	if got := MustCompileQuery("name(/*)").Strings(el); !reflect.DeepEqual(got, []string{"x:document"}) {
+		t.Errorf("name(/*) = %q", got)
+	}
+}
+
+func TestQuery_Strings(t *testing.T) {
+	t.Parallel()
+	root := parseXpathTestXml(t)
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"//w:pStyle/@w:val", []string{"Heading1", "Heading2"}},
+		{"//w:t/text()", []string{"Title", "bold", " plain", "cell"}},
+		{"count(//w:r)", []string{"4"}},
+		{"count(//w:r) div 8", []string{"0.5"}},
+		{"concat('a', 1, true())", []string{"a1true"}},
+		{"string-length((//w:t)[2])", []string{"4"}},
+		{"//w:p[1]/w:pPr/w:pStyle/@w:val = 'Heading1'", []string{"true"}},
+		{"number('x')", []string{"NaN"}},
+	}
+	for _, tt := range tests {
+		if got := MustCompileQuery(tt.expr).Strings(root); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestQuery_Matches(t *testing.T) {
+	t.Parallel()
+	root := parseXpathTestXml(t)
+	body := root.ChildElements()[0]
+	p := body.ChildElements()[0]
+
+	if !MustCompileQuery("w:pPr/w:pStyle[@w:val='Heading1']").Matches(p) {
+		t.Error("expected the first paragraph to match")
+	}
+	if MustCompileQuery("w:r/w:rPr/w:b").Matches(p) {
+		t.Error("expected the first paragraph not to match")
+	}
+	if !MustCompileQuery("count(w:p) > 2").Matches(body) {
+		t.Error("expected count(w:p) > 2")
+	}
+}
+
+func TestCompileQuery_Errors(t *testing.T) {
+	t.Parallel()
+	for _, expr := range []string{
+		"",
+		"w:p[",
+		"//",
+		"q:p",
+		"following::w:p",
+		"w:p[foo()]",
+		"count()",
+		"w:p w:r",
+		"'open",
+		"comment()",
+		"w:p)",
+		"#",
+	} {
+		if _, err := CompileQuery(expr); err == nil {
+			t.Errorf("CompileQuery(%q): expected an error", expr)
+		}
+	}
+}
+
+func TestQueryAll_Typed(t *testing.T) {
+	t.Parallel()
+	root := parseXpathTestXml(t)
+	q := MustCompileQuery("//w:p[w:pPr/w:pStyle]")
+
+	paras := QueryAll[CT_P](q, root)
+	if len(paras) != 2 {
+		t.Fatalf("QueryAll[CT_P] returned %d paragraphs, want 2", len(paras))
+	}
+	if got := paras[1].PPr().StyleVal(); got == nil || *got != "Heading2" {
+		t.Errorf("second heading style = %v, want Heading2", got)
+	}
+
+	// Elements that are not <w:p> are skipped.
+	if got := QueryAll[CT_P](MustCompileQuery("//w:r | (//w:p)[1]"), root); len(got) != 1 {
+		t.Errorf("QueryAll[CT_P] over runs and a paragraph returned %d, want 1", len(got))
+	}
+
+	if r := QueryFirst[CT_R](MustCompileQuery("//w:r[w:rPr/w:b]"), root); r == nil || r.E.FindElement("./t").Text() != "bold" {
+		t.Errorf("QueryFirst[CT_R] = %v, want the bold run", r)
+	}
+	if r := QueryFirst[CT_R](MustCompileQuery("//w:r[w:rPr/w:i]"), root); r != nil {
+		t.Errorf("QueryFirst[CT_R] = %v, want nil", r)
+	}
+}
+
+func TestElementXPath(t *testing.T) {
+	t.Parallel()
+	root := parseXpathTestXml(t)
+	el := &Element{E: root}
+
+	if got, err := el.XPath("//w:tc/w:p"); err != nil || len(got) != 1 {
+		t.Errorf("XPath(//w:tc/w:p) = %d elements, %v, want 1", len(got), err)
+	}
+	// A detached tree's root node is the parent of its top element.
+	p := &Element{E: OxmlElement("w:p")}
+	p.AddSubElement("w:r")
+	if got := len(p.MustXPath("/w:p/w:r")); got != 1 {
+		t.Errorf("XPath(/w:p/w:r) on a detached tree returned %d elements, want 1", got)
+	}
+	if got, err := el.XPath("w:p["); got != nil || err == nil {
+		t.Errorf("XPath with an invalid expression = %v, %v, want an error", got, err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustXPath with an invalid expression did not panic")
+		}
+	}()
+	el.MustXPath("w:p[")
+}
@@ -190,3 +190,26 @@ func (e *CT_Comment) Author() (string, error) {
 func (e *CT_Comment) SetAuthor(v string) {
 	e.SetAttr("w:author", v)
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_Comments",
+		tag:  "w:comments",
+		wrap: func(el Element) Node { return &CT_Comments{el} },
+		children: []childMeta{
+			{tag: "w:comment", typ: "CT_Comment", card: cardZeroOrMore},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Comment",
+		tag:  "w:comment",
+		wrap: func(el Element) Node { return &CT_Comment{el} },
+		children: []childMeta{
+			{tag: "w:p", typ: "CT_P", card: cardZeroOrMore},
+			{tag: "w:tbl", typ: "CT_Tbl", card: cardZeroOrMore},
+		},
+		attributes: []attrMeta{
+			{name: "w:id", check: checkIntAttr},
+		},
+	})
+}
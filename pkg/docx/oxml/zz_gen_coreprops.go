@@ -652,3 +652,33 @@ func (e *CT_CoreProperties) insertVersion(child *CT_CorePropText) *CT_CorePropTe
 type CT_CorePropText struct {
 	Element
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_CoreProperties",
+		tag:  "cp:coreProperties",
+		wrap: func(el Element) Node { return &CT_CoreProperties{el} },
+		children: []childMeta{
+			{tag: "cp:category", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "cp:contentStatus", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "dcterms:created", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "dc:creator", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "dc:description", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "dc:identifier", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "cp:keywords", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "dc:language", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "cp:lastModifiedBy", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "cp:lastPrinted", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "dcterms:modified", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "cp:revision", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "dc:subject", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "dc:title", typ: "CT_CorePropText", card: cardZeroOrOne},
+			{tag: "cp:version", typ: "CT_CorePropText", card: cardZeroOrOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_CorePropText",
+		tag:  "cp:text",
+		wrap: func(el Element) Node { return &CT_CorePropText{el} },
+	})
+}
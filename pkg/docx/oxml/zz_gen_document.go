@@ -4,6 +4,7 @@ package oxml
 
 import (
 	"fmt"
+	"github.com/user/go-docx/pkg/docx/enum"
 )
 
 // Ensure imports are used.
@@ -174,3 +175,227 @@ func (e *CT_Body) insertTbl(child *CT_Tbl) *CT_Tbl {
 	e.InsertElementBefore(child.E, "w:sectPr")
 	return child
 }
+
+// PermStartList returns all <w:permStart> child elements.
+func (e *CT_Body) PermStartList() []*CT_PermStart {
+	children := e.FindAllChildren("w:permStart")
+	result := make([]*CT_PermStart, len(children))
+	for i, c := range children {
+		result[i] = &CT_PermStart{Element{E: c}}
+	}
+	return result
+}
+
+// AddPermStart adds a new <w:permStart> in correct sequence.
+func (e *CT_Body) AddPermStart() *CT_PermStart {
+	return e.addPermStart()
+}
+
+// addPermStart adds a new <w:permStart> unconditionally in correct sequence.
+func (e *CT_Body) addPermStart() *CT_PermStart {
+	child := e.newPermStart()
+	e.insertPermStart(child)
+	return child
+}
+
+// newPermStart creates a detached <w:permStart> element.
+func (e *CT_Body) newPermStart() *CT_PermStart {
+	el := OxmlElement("w:permStart")
+	return &CT_PermStart{Element{E: el}}
+}
+
+// insertPermStart inserts child before first successor.
+func (e *CT_Body) insertPermStart(child *CT_PermStart) *CT_PermStart {
+	e.InsertElementBefore(child.E, "w:sectPr")
+	return child
+}
+
+// PermEndList returns all <w:permEnd> child elements.
+func (e *CT_Body) PermEndList() []*CT_Perm {
+	children := e.FindAllChildren("w:permEnd")
+	result := make([]*CT_Perm, len(children))
+	for i, c := range children {
+		result[i] = &CT_Perm{Element{E: c}}
+	}
+	return result
+}
+
+// AddPermEnd adds a new <w:permEnd> in correct sequence.
+func (e *CT_Body) AddPermEnd() *CT_Perm {
+	return e.addPermEnd()
+}
+
+// addPermEnd adds a new <w:permEnd> unconditionally in correct sequence.
+func (e *CT_Body) addPermEnd() *CT_Perm {
+	child := e.newPermEnd()
+	e.insertPermEnd(child)
+	return child
+}
+
+// newPermEnd creates a detached <w:permEnd> element.
+func (e *CT_Body) newPermEnd() *CT_Perm {
+	el := OxmlElement("w:permEnd")
+	return &CT_Perm{Element{E: el}}
+}
+
+// insertPermEnd inserts child before first successor.
+func (e *CT_Body) insertPermEnd(child *CT_Perm) *CT_Perm {
+	e.InsertElementBefore(child.E, "w:sectPr")
+	return child
+}
+
+// --- CT_PermStart ---
+
+// CT_PermStart — start of an editable range in a protected document
+type CT_PermStart struct {
+	Element
+}
+
+// EdGrp returns the value of the "w:edGrp" attribute, or nil if absent.
+func (e *CT_PermStart) EdGrp() *enum.WdEditorType {
+	val, ok := e.GetAttr("w:edGrp")
+	if !ok {
+		return nil
+	}
+	return parseOptionalEnum(val, enum.WdEditorTypeFromXml)
+}
+
+// SetEdGrp sets the "w:edGrp" attribute.
+// Passing nil removes it.
+func (e *CT_PermStart) SetEdGrp(v *enum.WdEditorType) {
+	if v == nil {
+		e.RemoveAttr("w:edGrp")
+		return
+	}
+	e.SetAttr("w:edGrp", (*v).ToXml())
+}
+
+// Ed returns the value of the "w:ed" attribute, or "" if absent.
+func (e *CT_PermStart) Ed() string {
+	val, ok := e.GetAttr("w:ed")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetEd sets the "w:ed" attribute.
+// Passing "" removes it.
+func (e *CT_PermStart) SetEd(v string) {
+	if v == "" {
+		e.RemoveAttr("w:ed")
+		return
+	}
+	e.SetAttr("w:ed", v)
+}
+
+// ColFirst returns the value of the "w:colFirst" attribute, or 0 if absent.
+func (e *CT_PermStart) ColFirst() int {
+	val, ok := e.GetAttr("w:colFirst")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetColFirst sets the "w:colFirst" attribute.
+// Passing 0 removes it.
+func (e *CT_PermStart) SetColFirst(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:colFirst")
+		return
+	}
+	e.SetAttr("w:colFirst", formatIntAttr(v))
+}
+
+// ColLast returns the value of the "w:colLast" attribute, or 0 if absent.
+func (e *CT_PermStart) ColLast() int {
+	val, ok := e.GetAttr("w:colLast")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetColLast sets the "w:colLast" attribute.
+// Passing 0 removes it.
+func (e *CT_PermStart) SetColLast(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:colLast")
+		return
+	}
+	e.SetAttr("w:colLast", formatIntAttr(v))
+}
+
+// Id returns the value of the required "w:id" attribute.
+func (e *CT_PermStart) Id() (string, error) {
+	val, ok := e.GetAttr("w:id")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:id", e.Tag())
+	}
+	return val, nil
+}
+
+// SetId sets the required "w:id" attribute.
+func (e *CT_PermStart) SetId(v string) {
+	e.SetAttr("w:id", v)
+}
+
+// --- CT_Perm ---
+
+// CT_Perm — end of an editable range in a protected document
+type CT_Perm struct {
+	Element
+}
+
+// Id returns the value of the required "w:id" attribute.
+func (e *CT_Perm) Id() (string, error) {
+	val, ok := e.GetAttr("w:id")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:id", e.Tag())
+	}
+	return val, nil
+}
+
+// SetId sets the required "w:id" attribute.
+func (e *CT_Perm) SetId(v string) {
+	e.SetAttr("w:id", v)
+}
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_Document",
+		tag:  "w:document",
+		wrap: func(el Element) Node { return &CT_Document{el} },
+		children: []childMeta{
+			{tag: "w:body", typ: "CT_Body", card: cardZeroOrOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Body",
+		tag:  "w:body",
+		wrap: func(el Element) Node { return &CT_Body{el} },
+		children: []childMeta{
+			{tag: "w:p", typ: "CT_P", card: cardZeroOrMore, successors: []string{"w:sectPr"}},
+			{tag: "w:tbl", typ: "CT_Tbl", card: cardZeroOrMore, successors: []string{"w:sectPr"}},
+			{tag: "w:permStart", typ: "CT_PermStart", card: cardZeroOrMore, successors: []string{"w:sectPr"}},
+			{tag: "w:permEnd", typ: "CT_Perm", card: cardZeroOrMore, successors: []string{"w:sectPr"}},
+			{tag: "w:sectPr", typ: "CT_SectPr", card: cardZeroOrOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PermStart",
+		tag:  "w:permStart",
+		wrap: func(el Element) Node { return &CT_PermStart{el} },
+		attributes: []attrMeta{
+			{name: "w:edGrp", check: checkEnumAttr(enum.WdEditorTypeFromXml)},
+			{name: "w:colFirst", check: checkIntAttr},
+			{name: "w:colLast", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Perm",
+		tag:  "w:permEnd",
+		wrap: func(el Element) Node { return &CT_Perm{el} },
+	})
+}
@@ -16,9 +16,110 @@ type CT_Drawing struct {
 	Element
 }
 
+// Inline returns the <wp:inline> child element, or nil if not present.
+func (e *CT_Drawing) Inline() *CT_Inline {
+	child := e.FindChild("wp:inline")
+	if child == nil {
+		return nil
+	}
+	return &CT_Inline{Element{E: child}}
+}
+
+// GetOrAddInline returns <wp:inline>, creating it if not present.
+func (e *CT_Drawing) GetOrAddInline() *CT_Inline {
+	child := e.Inline()
+	if child != nil {
+		return child
+	}
+	return e.addInline()
+}
+
+// RemoveInline removes all <wp:inline> child elements.
+func (e *CT_Drawing) RemoveInline() {
+	e.RemoveAll("wp:inline")
+}
+
+// addInline adds a new <wp:inline> in correct sequence.
+func (e *CT_Drawing) addInline() *CT_Inline {
+	child := e.newInline()
+	e.insertInline(child)
+	return child
+}
+
+// newInline creates a detached <wp:inline> element.
+func (e *CT_Drawing) newInline() *CT_Inline {
+	el := OxmlElement("wp:inline")
+	return &CT_Inline{Element{E: el}}
+}
+
+// insertInline inserts child before first successor.
+func (e *CT_Drawing) insertInline(child *CT_Inline) *CT_Inline {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// Anchor returns the <wp:anchor> child element, or nil if not present.
+func (e *CT_Drawing) Anchor() *CT_Anchor {
+	child := e.FindChild("wp:anchor")
+	if child == nil {
+		return nil
+	}
+	return &CT_Anchor{Element{E: child}}
+}
+
+// GetOrAddAnchor returns <wp:anchor>, creating it if not present.
+func (e *CT_Drawing) GetOrAddAnchor() *CT_Anchor {
+	child := e.Anchor()
+	if child != nil {
+		return child
+	}
+	return e.addAnchor()
+}
+
+// RemoveAnchor removes all <wp:anchor> child elements.
+func (e *CT_Drawing) RemoveAnchor() {
+	e.RemoveAll("wp:anchor")
+}
+
+// addAnchor adds a new <wp:anchor> in correct sequence.
+func (e *CT_Drawing) addAnchor() *CT_Anchor {
+	child := e.newAnchor()
+	e.insertAnchor(child)
+	return child
+}
+
+// newAnchor creates a detached <wp:anchor> element.
+func (e *CT_Drawing) newAnchor() *CT_Anchor {
+	el := OxmlElement("wp:anchor")
+	return &CT_Anchor{Element{E: el}}
+}
+
+// insertAnchor inserts child before first successor.
+func (e *CT_Drawing) insertAnchor(child *CT_Anchor) *CT_Anchor {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
 // --- CT_LastRenderedPageBreak ---
 
 // CT_LastRenderedPageBreak — last rendered page break element
 type CT_LastRenderedPageBreak struct {
 	Element
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_Drawing",
+		tag:  "w:drawing",
+		wrap: func(el Element) Node { return &CT_Drawing{el} },
+		children: []childMeta{
+			{tag: "wp:inline", typ: "CT_Inline", card: cardZeroOrOne},
+			{tag: "wp:anchor", typ: "CT_Anchor", card: cardZeroOrOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_LastRenderedPageBreak",
+		tag:  "w:lastRenderedPageBreak",
+		wrap: func(el Element) Node { return &CT_LastRenderedPageBreak{el} },
+	})
+}
@@ -177,3 +177,37 @@ func (e *CT_NumLvl) Ilvl() (int, error) {
 func (e *CT_NumLvl) SetIlvl(v int) {
 	e.SetAttr("w:ilvl", formatIntAttr(v))
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_Numbering",
+		tag:  "w:numbering",
+		wrap: func(el Element) Node { return &CT_Numbering{el} },
+		children: []childMeta{
+			{tag: "w:num", typ: "CT_Num", card: cardZeroOrMore, successors: []string{"w:numIdMacAtCleanup"}},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Num",
+		tag:  "w:num",
+		wrap: func(el Element) Node { return &CT_Num{el} },
+		children: []childMeta{
+			{tag: "w:abstractNumId", typ: "CT_DecimalNumber", card: cardOneAndOnlyOne},
+			{tag: "w:lvlOverride", typ: "CT_NumLvl", card: cardZeroOrMore},
+		},
+		attributes: []attrMeta{
+			{name: "w:numId", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_NumLvl",
+		tag:  "w:lvlOverride",
+		wrap: func(el Element) Node { return &CT_NumLvl{el} },
+		children: []childMeta{
+			{tag: "w:startOverride", typ: "CT_DecimalNumber", card: cardZeroOrOne, successors: []string{"w:lvl"}},
+		},
+		attributes: []attrMeta{
+			{name: "w:ilvl", check: checkIntAttr},
+		},
+	})
+}
@@ -4,6 +4,7 @@ package oxml
 
 import (
 	"fmt"
+	"github.com/user/go-docx/pkg/docx"
 	"github.com/user/go-docx/pkg/docx/enum"
 )
 
@@ -370,137 +371,137 @@ type CT_PageMar struct {
 	Element
 }
 
-// Top returns the value of the "w:top" attribute, or 0 if absent.
-func (e *CT_PageMar) Top() int {
+// Top returns the value of the "w:top" attribute, or nil if absent.
+func (e *CT_PageMar) Top() *docx.Length {
 	val, ok := e.GetAttr("w:top")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetTop sets the "w:top" attribute.
-// Passing 0 removes it.
-func (e *CT_PageMar) SetTop(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_PageMar) SetTop(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:top")
 		return
 	}
-	e.SetAttr("w:top", formatIntAttr(v))
+	e.SetAttr("w:top", formatTwipsAttr(*v))
 }
 
-// Right returns the value of the "w:right" attribute, or 0 if absent.
-func (e *CT_PageMar) Right() int {
+// Right returns the value of the "w:right" attribute, or nil if absent.
+func (e *CT_PageMar) Right() *docx.Length {
 	val, ok := e.GetAttr("w:right")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetRight sets the "w:right" attribute.
-// Passing 0 removes it.
-func (e *CT_PageMar) SetRight(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_PageMar) SetRight(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:right")
 		return
 	}
-	e.SetAttr("w:right", formatIntAttr(v))
+	e.SetAttr("w:right", formatTwipsAttr(*v))
 }
 
-// Bottom returns the value of the "w:bottom" attribute, or 0 if absent.
-func (e *CT_PageMar) Bottom() int {
+// Bottom returns the value of the "w:bottom" attribute, or nil if absent.
+func (e *CT_PageMar) Bottom() *docx.Length {
 	val, ok := e.GetAttr("w:bottom")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetBottom sets the "w:bottom" attribute.
-// Passing 0 removes it.
-func (e *CT_PageMar) SetBottom(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_PageMar) SetBottom(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:bottom")
 		return
 	}
-	e.SetAttr("w:bottom", formatIntAttr(v))
+	e.SetAttr("w:bottom", formatTwipsAttr(*v))
 }
 
-// Left returns the value of the "w:left" attribute, or 0 if absent.
-func (e *CT_PageMar) Left() int {
+// Left returns the value of the "w:left" attribute, or nil if absent.
+func (e *CT_PageMar) Left() *docx.Length {
 	val, ok := e.GetAttr("w:left")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetLeft sets the "w:left" attribute.
-// Passing 0 removes it.
-func (e *CT_PageMar) SetLeft(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_PageMar) SetLeft(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:left")
 		return
 	}
-	e.SetAttr("w:left", formatIntAttr(v))
+	e.SetAttr("w:left", formatTwipsAttr(*v))
 }
 
-// Header returns the value of the "w:header" attribute, or 0 if absent.
-func (e *CT_PageMar) Header() int {
+// Header returns the value of the "w:header" attribute, or nil if absent.
+func (e *CT_PageMar) Header() *docx.Length {
 	val, ok := e.GetAttr("w:header")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetHeader sets the "w:header" attribute.
-// Passing 0 removes it.
-func (e *CT_PageMar) SetHeader(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_PageMar) SetHeader(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:header")
 		return
 	}
-	e.SetAttr("w:header", formatIntAttr(v))
+	e.SetAttr("w:header", formatTwipsAttr(*v))
 }
 
-// Footer returns the value of the "w:footer" attribute, or 0 if absent.
-func (e *CT_PageMar) Footer() int {
+// Footer returns the value of the "w:footer" attribute, or nil if absent.
+func (e *CT_PageMar) Footer() *docx.Length {
 	val, ok := e.GetAttr("w:footer")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetFooter sets the "w:footer" attribute.
-// Passing 0 removes it.
-func (e *CT_PageMar) SetFooter(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_PageMar) SetFooter(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:footer")
 		return
 	}
-	e.SetAttr("w:footer", formatIntAttr(v))
+	e.SetAttr("w:footer", formatTwipsAttr(*v))
 }
 
-// Gutter returns the value of the "w:gutter" attribute, or 0 if absent.
-func (e *CT_PageMar) Gutter() int {
+// Gutter returns the value of the "w:gutter" attribute, or nil if absent.
+func (e *CT_PageMar) Gutter() *docx.Length {
 	val, ok := e.GetAttr("w:gutter")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetGutter sets the "w:gutter" attribute.
-// Passing 0 removes it.
-func (e *CT_PageMar) SetGutter(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_PageMar) SetGutter(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:gutter")
 		return
 	}
-	e.SetAttr("w:gutter", formatIntAttr(v))
+	e.SetAttr("w:gutter", formatTwipsAttr(*v))
 }
 
 // --- CT_PageSz ---
@@ -510,42 +511,42 @@ type CT_PageSz struct {
 	Element
 }
 
-// W returns the value of the "w:w" attribute, or 0 if absent.
-func (e *CT_PageSz) W() int {
+// W returns the value of the "w:w" attribute, or nil if absent.
+func (e *CT_PageSz) W() *docx.Length {
 	val, ok := e.GetAttr("w:w")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetW sets the "w:w" attribute.
-// Passing 0 removes it.
-func (e *CT_PageSz) SetW(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_PageSz) SetW(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:w")
 		return
 	}
-	e.SetAttr("w:w", formatIntAttr(v))
+	e.SetAttr("w:w", formatTwipsAttr(*v))
 }
 
-// H returns the value of the "w:h" attribute, or 0 if absent.
-func (e *CT_PageSz) H() int {
+// H returns the value of the "w:h" attribute, or nil if absent.
+func (e *CT_PageSz) H() *docx.Length {
 	val, ok := e.GetAttr("w:h")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetH sets the "w:h" attribute.
-// Passing 0 removes it.
-func (e *CT_PageSz) SetH(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_PageSz) SetH(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:h")
 		return
 	}
-	e.SetAttr("w:h", formatIntAttr(v))
+	e.SetAttr("w:h", formatTwipsAttr(*v))
 }
 
 // Orient returns the value of the "w:orient" attribute, or enum.WdOrientation(0) if absent.
@@ -592,3 +593,68 @@ func (e *CT_SectType) SetVal(v enum.WdSectionStart) {
 	}
 	e.SetAttr("w:val", v.ToXml())
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_SectPr",
+		tag:  "w:sectPr",
+		wrap: func(el Element) Node { return &CT_SectPr{el} },
+		children: []childMeta{
+			{tag: "w:headerReference", typ: "CT_HdrFtrRef", card: cardZeroOrMore, successors: []string{"w:footnotePr", "w:endnotePr", "w:type", "w:pgSz", "w:pgMar", "w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType", "w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:footerReference", typ: "CT_HdrFtrRef", card: cardZeroOrMore, successors: []string{"w:footnotePr", "w:endnotePr", "w:type", "w:pgSz", "w:pgMar", "w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType", "w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:type", typ: "CT_SectType", card: cardZeroOrOne, successors: []string{"w:pgSz", "w:pgMar", "w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType", "w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:pgSz", typ: "CT_PageSz", card: cardZeroOrOne, successors: []string{"w:pgMar", "w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType", "w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:pgMar", typ: "CT_PageMar", card: cardZeroOrOne, successors: []string{"w:paperSrc", "w:pgBorders", "w:lnNumType", "w:pgNumType", "w:cols", "w:formProt", "w:vAlign", "w:noEndnote", "w:titlePg", "w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+			{tag: "w:titlePg", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:textDirection", "w:bidi", "w:rtlGutter", "w:docGrid", "w:printerSettings", "w:sectPrChange"}},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_HdrFtr",
+		tag:  "w:hdr",
+		wrap: func(el Element) Node { return &CT_HdrFtr{el} },
+		children: []childMeta{
+			{tag: "w:p", typ: "CT_P", card: cardZeroOrMore},
+			{tag: "w:tbl", typ: "CT_Tbl", card: cardZeroOrMore},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_HdrFtrRef",
+		tag:  "w:headerReference",
+		wrap: func(el Element) Node { return &CT_HdrFtrRef{el} },
+		attributes: []attrMeta{
+			{name: "w:type", check: checkEnumAttr(enum.WdHeaderFooterIndexFromXml)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PageMar",
+		tag:  "w:pgMar",
+		wrap: func(el Element) Node { return &CT_PageMar{el} },
+		attributes: []attrMeta{
+			{name: "w:top", check: checkValueAttr(parseTwips)},
+			{name: "w:right", check: checkValueAttr(parseTwips)},
+			{name: "w:bottom", check: checkValueAttr(parseTwips)},
+			{name: "w:left", check: checkValueAttr(parseTwips)},
+			{name: "w:header", check: checkValueAttr(parseTwips)},
+			{name: "w:footer", check: checkValueAttr(parseTwips)},
+			{name: "w:gutter", check: checkValueAttr(parseTwips)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PageSz",
+		tag:  "w:pgSz",
+		wrap: func(el Element) Node { return &CT_PageSz{el} },
+		attributes: []attrMeta{
+			{name: "w:w", check: checkValueAttr(parseTwips)},
+			{name: "w:h", check: checkValueAttr(parseTwips)},
+			{name: "w:orient", check: checkEnumAttr(enum.WdOrientationFromXml)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_SectType",
+		tag:  "w:type",
+		wrap: func(el Element) Node { return &CT_SectType{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkEnumAttr(enum.WdSectionStartFromXml)},
+		},
+	})
+}
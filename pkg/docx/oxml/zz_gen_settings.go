@@ -4,6 +4,8 @@ package oxml
 
 import (
 	"fmt"
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
 )
 
 // Ensure imports are used.
@@ -16,6 +18,384 @@ type CT_Settings struct {
 	Element
 }
 
+// WriteProtection returns the <w:writeProtection> child element, or nil if not present.
+func (e *CT_Settings) WriteProtection() *CT_WriteProtection {
+	child := e.FindChild("w:writeProtection")
+	if child == nil {
+		return nil
+	}
+	return &CT_WriteProtection{Element{E: child}}
+}
+
+// GetOrAddWriteProtection returns <w:writeProtection>, creating it if not present.
+func (e *CT_Settings) GetOrAddWriteProtection() *CT_WriteProtection {
+	child := e.WriteProtection()
+	if child != nil {
+		return child
+	}
+	return e.addWriteProtection()
+}
+
+// RemoveWriteProtection removes all <w:writeProtection> child elements.
+func (e *CT_Settings) RemoveWriteProtection() {
+	e.RemoveAll("w:writeProtection")
+}
+
+// addWriteProtection adds a new <w:writeProtection> in correct sequence.
+func (e *CT_Settings) addWriteProtection() *CT_WriteProtection {
+	child := e.newWriteProtection()
+	e.insertWriteProtection(child)
+	return child
+}
+
+// newWriteProtection creates a detached <w:writeProtection> element.
+func (e *CT_Settings) newWriteProtection() *CT_WriteProtection {
+	el := OxmlElement("w:writeProtection")
+	return &CT_WriteProtection{Element{E: el}}
+}
+
+// insertWriteProtection inserts child before first successor.
+func (e *CT_Settings) insertWriteProtection(child *CT_WriteProtection) *CT_WriteProtection {
+	e.InsertElementBefore(child.E, "w:view", "w:zoom", "w:removePersonalInformation", "w:removeDateAndTime", "w:doNotDisplayPageBoundaries", "w:displayBackgroundShape", "w:printPostScriptOverText", "w:printFractionalCharacterWidth", "w:printFormsData", "w:embedTrueTypeFonts", "w:embedSystemFonts", "w:saveSubsetFonts", "w:saveFormsData", "w:mirrorMargins", "w:alignBordersAndEdges", "w:bordersDoNotSurroundHeader", "w:bordersDoNotSurroundFooter", "w:gutterAtTop", "w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// Zoom returns the <w:zoom> child element, or nil if not present.
+func (e *CT_Settings) Zoom() *CT_Zoom {
+	child := e.FindChild("w:zoom")
+	if child == nil {
+		return nil
+	}
+	return &CT_Zoom{Element{E: child}}
+}
+
+// GetOrAddZoom returns <w:zoom>, creating it if not present.
+func (e *CT_Settings) GetOrAddZoom() *CT_Zoom {
+	child := e.Zoom()
+	if child != nil {
+		return child
+	}
+	return e.addZoom()
+}
+
+// RemoveZoom removes all <w:zoom> child elements.
+func (e *CT_Settings) RemoveZoom() {
+	e.RemoveAll("w:zoom")
+}
+
+// addZoom adds a new <w:zoom> in correct sequence.
+func (e *CT_Settings) addZoom() *CT_Zoom {
+	child := e.newZoom()
+	e.insertZoom(child)
+	return child
+}
+
+// newZoom creates a detached <w:zoom> element.
+func (e *CT_Settings) newZoom() *CT_Zoom {
+	el := OxmlElement("w:zoom")
+	return &CT_Zoom{Element{E: el}}
+}
+
+// insertZoom inserts child before first successor.
+func (e *CT_Settings) insertZoom(child *CT_Zoom) *CT_Zoom {
+	e.InsertElementBefore(child.E, "w:removePersonalInformation", "w:removeDateAndTime", "w:doNotDisplayPageBoundaries", "w:displayBackgroundShape", "w:printPostScriptOverText", "w:printFractionalCharacterWidth", "w:printFormsData", "w:embedTrueTypeFonts", "w:embedSystemFonts", "w:saveSubsetFonts", "w:saveFormsData", "w:mirrorMargins", "w:alignBordersAndEdges", "w:bordersDoNotSurroundHeader", "w:bordersDoNotSurroundFooter", "w:gutterAtTop", "w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// MirrorMargins returns the <w:mirrorMargins> child element, or nil if not present.
+func (e *CT_Settings) MirrorMargins() *CT_OnOff {
+	child := e.FindChild("w:mirrorMargins")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{E: child}}
+}
+
+// GetOrAddMirrorMargins returns <w:mirrorMargins>, creating it if not present.
+func (e *CT_Settings) GetOrAddMirrorMargins() *CT_OnOff {
+	child := e.MirrorMargins()
+	if child != nil {
+		return child
+	}
+	return e.addMirrorMargins()
+}
+
+// RemoveMirrorMargins removes all <w:mirrorMargins> child elements.
+func (e *CT_Settings) RemoveMirrorMargins() {
+	e.RemoveAll("w:mirrorMargins")
+}
+
+// addMirrorMargins adds a new <w:mirrorMargins> in correct sequence.
+func (e *CT_Settings) addMirrorMargins() *CT_OnOff {
+	child := e.newMirrorMargins()
+	e.insertMirrorMargins(child)
+	return child
+}
+
+// newMirrorMargins creates a detached <w:mirrorMargins> element.
+func (e *CT_Settings) newMirrorMargins() *CT_OnOff {
+	el := OxmlElement("w:mirrorMargins")
+	return &CT_OnOff{Element{E: el}}
+}
+
+// insertMirrorMargins inserts child before first successor.
+func (e *CT_Settings) insertMirrorMargins(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.E, "w:alignBordersAndEdges", "w:bordersDoNotSurroundHeader", "w:bordersDoNotSurroundFooter", "w:gutterAtTop", "w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// GutterAtTop returns the <w:gutterAtTop> child element, or nil if not present.
+func (e *CT_Settings) GutterAtTop() *CT_OnOff {
+	child := e.FindChild("w:gutterAtTop")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{E: child}}
+}
+
+// GetOrAddGutterAtTop returns <w:gutterAtTop>, creating it if not present.
+func (e *CT_Settings) GetOrAddGutterAtTop() *CT_OnOff {
+	child := e.GutterAtTop()
+	if child != nil {
+		return child
+	}
+	return e.addGutterAtTop()
+}
+
+// RemoveGutterAtTop removes all <w:gutterAtTop> child elements.
+func (e *CT_Settings) RemoveGutterAtTop() {
+	e.RemoveAll("w:gutterAtTop")
+}
+
+// addGutterAtTop adds a new <w:gutterAtTop> in correct sequence.
+func (e *CT_Settings) addGutterAtTop() *CT_OnOff {
+	child := e.newGutterAtTop()
+	e.insertGutterAtTop(child)
+	return child
+}
+
+// newGutterAtTop creates a detached <w:gutterAtTop> element.
+func (e *CT_Settings) newGutterAtTop() *CT_OnOff {
+	el := OxmlElement("w:gutterAtTop")
+	return &CT_OnOff{Element{E: el}}
+}
+
+// insertGutterAtTop inserts child before first successor.
+func (e *CT_Settings) insertGutterAtTop(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.E, "w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// TrackRevisions returns the <w:trackRevisions> child element, or nil if not present.
+func (e *CT_Settings) TrackRevisions() *CT_OnOff {
+	child := e.FindChild("w:trackRevisions")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{E: child}}
+}
+
+// GetOrAddTrackRevisions returns <w:trackRevisions>, creating it if not present.
+func (e *CT_Settings) GetOrAddTrackRevisions() *CT_OnOff {
+	child := e.TrackRevisions()
+	if child != nil {
+		return child
+	}
+	return e.addTrackRevisions()
+}
+
+// RemoveTrackRevisions removes all <w:trackRevisions> child elements.
+func (e *CT_Settings) RemoveTrackRevisions() {
+	e.RemoveAll("w:trackRevisions")
+}
+
+// addTrackRevisions adds a new <w:trackRevisions> in correct sequence.
+func (e *CT_Settings) addTrackRevisions() *CT_OnOff {
+	child := e.newTrackRevisions()
+	e.insertTrackRevisions(child)
+	return child
+}
+
+// newTrackRevisions creates a detached <w:trackRevisions> element.
+func (e *CT_Settings) newTrackRevisions() *CT_OnOff {
+	el := OxmlElement("w:trackRevisions")
+	return &CT_OnOff{Element{E: el}}
+}
+
+// insertTrackRevisions inserts child before first successor.
+func (e *CT_Settings) insertTrackRevisions(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.E, "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// DoNotTrackMoves returns the <w:doNotTrackMoves> child element, or nil if not present.
+func (e *CT_Settings) DoNotTrackMoves() *CT_OnOff {
+	child := e.FindChild("w:doNotTrackMoves")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{E: child}}
+}
+
+// GetOrAddDoNotTrackMoves returns <w:doNotTrackMoves>, creating it if not present.
+func (e *CT_Settings) GetOrAddDoNotTrackMoves() *CT_OnOff {
+	child := e.DoNotTrackMoves()
+	if child != nil {
+		return child
+	}
+	return e.addDoNotTrackMoves()
+}
+
+// RemoveDoNotTrackMoves removes all <w:doNotTrackMoves> child elements.
+func (e *CT_Settings) RemoveDoNotTrackMoves() {
+	e.RemoveAll("w:doNotTrackMoves")
+}
+
+// addDoNotTrackMoves adds a new <w:doNotTrackMoves> in correct sequence.
+func (e *CT_Settings) addDoNotTrackMoves() *CT_OnOff {
+	child := e.newDoNotTrackMoves()
+	e.insertDoNotTrackMoves(child)
+	return child
+}
+
+// newDoNotTrackMoves creates a detached <w:doNotTrackMoves> element.
+func (e *CT_Settings) newDoNotTrackMoves() *CT_OnOff {
+	el := OxmlElement("w:doNotTrackMoves")
+	return &CT_OnOff{Element{E: el}}
+}
+
+// insertDoNotTrackMoves inserts child before first successor.
+func (e *CT_Settings) insertDoNotTrackMoves(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.E, "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// DocumentProtection returns the <w:documentProtection> child element, or nil if not present.
+func (e *CT_Settings) DocumentProtection() *CT_DocProtect {
+	child := e.FindChild("w:documentProtection")
+	if child == nil {
+		return nil
+	}
+	return &CT_DocProtect{Element{E: child}}
+}
+
+// GetOrAddDocumentProtection returns <w:documentProtection>, creating it if not present.
+func (e *CT_Settings) GetOrAddDocumentProtection() *CT_DocProtect {
+	child := e.DocumentProtection()
+	if child != nil {
+		return child
+	}
+	return e.addDocumentProtection()
+}
+
+// RemoveDocumentProtection removes all <w:documentProtection> child elements.
+func (e *CT_Settings) RemoveDocumentProtection() {
+	e.RemoveAll("w:documentProtection")
+}
+
+// addDocumentProtection adds a new <w:documentProtection> in correct sequence.
+func (e *CT_Settings) addDocumentProtection() *CT_DocProtect {
+	child := e.newDocumentProtection()
+	e.insertDocumentProtection(child)
+	return child
+}
+
+// newDocumentProtection creates a detached <w:documentProtection> element.
+func (e *CT_Settings) newDocumentProtection() *CT_DocProtect {
+	el := OxmlElement("w:documentProtection")
+	return &CT_DocProtect{Element{E: el}}
+}
+
+// insertDocumentProtection inserts child before first successor.
+func (e *CT_Settings) insertDocumentProtection(child *CT_DocProtect) *CT_DocProtect {
+	e.InsertElementBefore(child.E, "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// DefaultTabStop returns the <w:defaultTabStop> child element, or nil if not present.
+func (e *CT_Settings) DefaultTabStop() *CT_TwipsMeasure {
+	child := e.FindChild("w:defaultTabStop")
+	if child == nil {
+		return nil
+	}
+	return &CT_TwipsMeasure{Element{E: child}}
+}
+
+// GetOrAddDefaultTabStop returns <w:defaultTabStop>, creating it if not present.
+func (e *CT_Settings) GetOrAddDefaultTabStop() *CT_TwipsMeasure {
+	child := e.DefaultTabStop()
+	if child != nil {
+		return child
+	}
+	return e.addDefaultTabStop()
+}
+
+// RemoveDefaultTabStop removes all <w:defaultTabStop> child elements.
+func (e *CT_Settings) RemoveDefaultTabStop() {
+	e.RemoveAll("w:defaultTabStop")
+}
+
+// addDefaultTabStop adds a new <w:defaultTabStop> in correct sequence.
+func (e *CT_Settings) addDefaultTabStop() *CT_TwipsMeasure {
+	child := e.newDefaultTabStop()
+	e.insertDefaultTabStop(child)
+	return child
+}
+
+// newDefaultTabStop creates a detached <w:defaultTabStop> element.
+func (e *CT_Settings) newDefaultTabStop() *CT_TwipsMeasure {
+	el := OxmlElement("w:defaultTabStop")
+	return &CT_TwipsMeasure{Element{E: el}}
+}
+
+// insertDefaultTabStop inserts child before first successor.
+func (e *CT_Settings) insertDefaultTabStop(child *CT_TwipsMeasure) *CT_TwipsMeasure {
+	e.InsertElementBefore(child.E, "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// AutoHyphenation returns the <w:autoHyphenation> child element, or nil if not present.
+func (e *CT_Settings) AutoHyphenation() *CT_OnOff {
+	child := e.FindChild("w:autoHyphenation")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{E: child}}
+}
+
+// GetOrAddAutoHyphenation returns <w:autoHyphenation>, creating it if not present.
+func (e *CT_Settings) GetOrAddAutoHyphenation() *CT_OnOff {
+	child := e.AutoHyphenation()
+	if child != nil {
+		return child
+	}
+	return e.addAutoHyphenation()
+}
+
+// RemoveAutoHyphenation removes all <w:autoHyphenation> child elements.
+func (e *CT_Settings) RemoveAutoHyphenation() {
+	e.RemoveAll("w:autoHyphenation")
+}
+
+// addAutoHyphenation adds a new <w:autoHyphenation> in correct sequence.
+func (e *CT_Settings) addAutoHyphenation() *CT_OnOff {
+	child := e.newAutoHyphenation()
+	e.insertAutoHyphenation(child)
+	return child
+}
+
+// newAutoHyphenation creates a detached <w:autoHyphenation> element.
+func (e *CT_Settings) newAutoHyphenation() *CT_OnOff {
+	el := OxmlElement("w:autoHyphenation")
+	return &CT_OnOff{Element{E: el}}
+}
+
+// insertAutoHyphenation inserts child before first successor.
+func (e *CT_Settings) insertAutoHyphenation(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.E, "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
 // EvenAndOddHeaders returns the <w:evenAndOddHeaders> child element, or nil if not present.
 func (e *CT_Settings) EvenAndOddHeaders() *CT_OnOff {
 	child := e.FindChild("w:evenAndOddHeaders")
@@ -54,6 +434,1018 @@ func (e *CT_Settings) newEvenAndOddHeaders() *CT_OnOff {
 
 // insertEvenAndOddHeaders inserts child before first successor.
 func (e *CT_Settings) insertEvenAndOddHeaders(child *CT_OnOff) *CT_OnOff {
-	e.InsertElementBefore(child.E, "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids")
+	e.InsertElementBefore(child.E, "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// UpdateFields returns the <w:updateFields> child element, or nil if not present.
+func (e *CT_Settings) UpdateFields() *CT_OnOff {
+	child := e.FindChild("w:updateFields")
+	if child == nil {
+		return nil
+	}
+	return &CT_OnOff{Element{E: child}}
+}
+
+// GetOrAddUpdateFields returns <w:updateFields>, creating it if not present.
+func (e *CT_Settings) GetOrAddUpdateFields() *CT_OnOff {
+	child := e.UpdateFields()
+	if child != nil {
+		return child
+	}
+	return e.addUpdateFields()
+}
+
+// RemoveUpdateFields removes all <w:updateFields> child elements.
+func (e *CT_Settings) RemoveUpdateFields() {
+	e.RemoveAll("w:updateFields")
+}
+
+// addUpdateFields adds a new <w:updateFields> in correct sequence.
+func (e *CT_Settings) addUpdateFields() *CT_OnOff {
+	child := e.newUpdateFields()
+	e.insertUpdateFields(child)
+	return child
+}
+
+// newUpdateFields creates a detached <w:updateFields> element.
+func (e *CT_Settings) newUpdateFields() *CT_OnOff {
+	el := OxmlElement("w:updateFields")
+	return &CT_OnOff{Element{E: el}}
+}
+
+// insertUpdateFields inserts child before first successor.
+func (e *CT_Settings) insertUpdateFields(child *CT_OnOff) *CT_OnOff {
+	e.InsertElementBefore(child.E, "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// Compat returns the <w:compat> child element, or nil if not present.
+func (e *CT_Settings) Compat() *CT_Compat {
+	child := e.FindChild("w:compat")
+	if child == nil {
+		return nil
+	}
+	return &CT_Compat{Element{E: child}}
+}
+
+// GetOrAddCompat returns <w:compat>, creating it if not present.
+func (e *CT_Settings) GetOrAddCompat() *CT_Compat {
+	child := e.Compat()
+	if child != nil {
+		return child
+	}
+	return e.addCompat()
+}
+
+// RemoveCompat removes all <w:compat> child elements.
+func (e *CT_Settings) RemoveCompat() {
+	e.RemoveAll("w:compat")
+}
+
+// addCompat adds a new <w:compat> in correct sequence.
+func (e *CT_Settings) addCompat() *CT_Compat {
+	child := e.newCompat()
+	e.insertCompat(child)
+	return child
+}
+
+// newCompat creates a detached <w:compat> element.
+func (e *CT_Settings) newCompat() *CT_Compat {
+	el := OxmlElement("w:compat")
+	return &CT_Compat{Element{E: el}}
+}
+
+// insertCompat inserts child before first successor.
+func (e *CT_Settings) insertCompat(child *CT_Compat) *CT_Compat {
+	e.InsertElementBefore(child.E, "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
+	return child
+}
+
+// Rsids returns the <w:rsids> child element, or nil if not present.
+func (e *CT_Settings) Rsids() *CT_DocRsids {
+	child := e.FindChild("w:rsids")
+	if child == nil {
+		return nil
+	}
+	return &CT_DocRsids{Element{E: child}}
+}
+
+// GetOrAddRsids returns <w:rsids>, creating it if not present.
+func (e *CT_Settings) GetOrAddRsids() *CT_DocRsids {
+	child := e.Rsids()
+	if child != nil {
+		return child
+	}
+	return e.addRsids()
+}
+
+// RemoveRsids removes all <w:rsids> child elements.
+func (e *CT_Settings) RemoveRsids() {
+	e.RemoveAll("w:rsids")
+}
+
+// addRsids adds a new <w:rsids> in correct sequence.
+func (e *CT_Settings) addRsids() *CT_DocRsids {
+	child := e.newRsids()
+	e.insertRsids(child)
+	return child
+}
+
+// newRsids creates a detached <w:rsids> element.
+func (e *CT_Settings) newRsids() *CT_DocRsids {
+	el := OxmlElement("w:rsids")
+	return &CT_DocRsids{Element{E: el}}
+}
+
+// insertRsids inserts child before first successor.
+func (e *CT_Settings) insertRsids(child *CT_DocRsids) *CT_DocRsids {
+	e.InsertElementBefore(child.E, "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId")
 	return child
 }
+
+// DefaultTabStopVal returns the "w:val" value of the <w:defaultTabStop> child, or nil if
+// <w:defaultTabStop> is not present.
+func (e *CT_Settings) DefaultTabStopVal() *docx.Length {
+	child := e.DefaultTabStop()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := parseTwipsAttr(val)
+	return &v
+}
+
+// SetDefaultTabStopVal sets the "w:val" value of the <w:defaultTabStop> child, adding
+// the child if needed. Passing nil removes <w:defaultTabStop>.
+func (e *CT_Settings) SetDefaultTabStopVal(val *docx.Length) {
+	if val == nil {
+		e.RemoveDefaultTabStop()
+		return
+	}
+	v := *val
+	child := e.GetOrAddDefaultTabStop()
+	child.SetAttr("w:val", formatTwipsAttr(v))
+}
+
+// --- CT_DocProtect ---
+
+// CT_DocProtect — document editing restrictions element
+type CT_DocProtect struct {
+	Element
+}
+
+// Edit returns the value of the "w:edit" attribute, or nil if absent.
+func (e *CT_DocProtect) Edit() *enum.WdProtectionType {
+	val, ok := e.GetAttr("w:edit")
+	if !ok {
+		return nil
+	}
+	return parseOptionalEnum(val, enum.WdProtectionTypeFromXml)
+}
+
+// SetEdit sets the "w:edit" attribute.
+// Passing nil removes it.
+func (e *CT_DocProtect) SetEdit(v *enum.WdProtectionType) {
+	if v == nil {
+		e.RemoveAttr("w:edit")
+		return
+	}
+	e.SetAttr("w:edit", (*v).ToXml())
+}
+
+// Formatting returns the value of the "w:formatting" attribute, or false if absent.
+func (e *CT_DocProtect) Formatting() bool {
+	val, ok := e.GetAttr("w:formatting")
+	if !ok {
+		return false
+	}
+	return parseBoolAttr(val)
+}
+
+// SetFormatting sets the "w:formatting" attribute.
+// Passing false removes it.
+func (e *CT_DocProtect) SetFormatting(v bool) {
+	if v == false {
+		e.RemoveAttr("w:formatting")
+		return
+	}
+	e.SetAttr("w:formatting", formatBoolAttr(v))
+}
+
+// Enforcement returns the value of the "w:enforcement" attribute, or false if absent.
+func (e *CT_DocProtect) Enforcement() bool {
+	val, ok := e.GetAttr("w:enforcement")
+	if !ok {
+		return false
+	}
+	return parseBoolAttr(val)
+}
+
+// SetEnforcement sets the "w:enforcement" attribute.
+// Passing false removes it.
+func (e *CT_DocProtect) SetEnforcement(v bool) {
+	if v == false {
+		e.RemoveAttr("w:enforcement")
+		return
+	}
+	e.SetAttr("w:enforcement", formatBoolAttr(v))
+}
+
+// CryptProviderType returns the value of the "w:cryptProviderType" attribute, or "" if absent.
+func (e *CT_DocProtect) CryptProviderType() string {
+	val, ok := e.GetAttr("w:cryptProviderType")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetCryptProviderType sets the "w:cryptProviderType" attribute.
+// Passing "" removes it.
+func (e *CT_DocProtect) SetCryptProviderType(v string) {
+	if v == "" {
+		e.RemoveAttr("w:cryptProviderType")
+		return
+	}
+	e.SetAttr("w:cryptProviderType", v)
+}
+
+// CryptAlgorithmClass returns the value of the "w:cryptAlgorithmClass" attribute, or "" if absent.
+func (e *CT_DocProtect) CryptAlgorithmClass() string {
+	val, ok := e.GetAttr("w:cryptAlgorithmClass")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetCryptAlgorithmClass sets the "w:cryptAlgorithmClass" attribute.
+// Passing "" removes it.
+func (e *CT_DocProtect) SetCryptAlgorithmClass(v string) {
+	if v == "" {
+		e.RemoveAttr("w:cryptAlgorithmClass")
+		return
+	}
+	e.SetAttr("w:cryptAlgorithmClass", v)
+}
+
+// CryptAlgorithmType returns the value of the "w:cryptAlgorithmType" attribute, or "" if absent.
+func (e *CT_DocProtect) CryptAlgorithmType() string {
+	val, ok := e.GetAttr("w:cryptAlgorithmType")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetCryptAlgorithmType sets the "w:cryptAlgorithmType" attribute.
+// Passing "" removes it.
+func (e *CT_DocProtect) SetCryptAlgorithmType(v string) {
+	if v == "" {
+		e.RemoveAttr("w:cryptAlgorithmType")
+		return
+	}
+	e.SetAttr("w:cryptAlgorithmType", v)
+}
+
+// CryptAlgorithmSid returns the value of the "w:cryptAlgorithmSid" attribute, or 0 if absent.
+func (e *CT_DocProtect) CryptAlgorithmSid() int {
+	val, ok := e.GetAttr("w:cryptAlgorithmSid")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetCryptAlgorithmSid sets the "w:cryptAlgorithmSid" attribute.
+// Passing 0 removes it.
+func (e *CT_DocProtect) SetCryptAlgorithmSid(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:cryptAlgorithmSid")
+		return
+	}
+	e.SetAttr("w:cryptAlgorithmSid", formatIntAttr(v))
+}
+
+// CryptSpinCount returns the value of the "w:cryptSpinCount" attribute, or 0 if absent.
+func (e *CT_DocProtect) CryptSpinCount() int {
+	val, ok := e.GetAttr("w:cryptSpinCount")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetCryptSpinCount sets the "w:cryptSpinCount" attribute.
+// Passing 0 removes it.
+func (e *CT_DocProtect) SetCryptSpinCount(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:cryptSpinCount")
+		return
+	}
+	e.SetAttr("w:cryptSpinCount", formatIntAttr(v))
+}
+
+// Hash returns the value of the "w:hash" attribute, or "" if absent.
+func (e *CT_DocProtect) Hash() string {
+	val, ok := e.GetAttr("w:hash")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetHash sets the "w:hash" attribute.
+// Passing "" removes it.
+func (e *CT_DocProtect) SetHash(v string) {
+	if v == "" {
+		e.RemoveAttr("w:hash")
+		return
+	}
+	e.SetAttr("w:hash", v)
+}
+
+// Salt returns the value of the "w:salt" attribute, or "" if absent.
+func (e *CT_DocProtect) Salt() string {
+	val, ok := e.GetAttr("w:salt")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetSalt sets the "w:salt" attribute.
+// Passing "" removes it.
+func (e *CT_DocProtect) SetSalt(v string) {
+	if v == "" {
+		e.RemoveAttr("w:salt")
+		return
+	}
+	e.SetAttr("w:salt", v)
+}
+
+// AlgorithmName returns the value of the "w:algorithmName" attribute, or "" if absent.
+func (e *CT_DocProtect) AlgorithmName() string {
+	val, ok := e.GetAttr("w:algorithmName")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetAlgorithmName sets the "w:algorithmName" attribute.
+// Passing "" removes it.
+func (e *CT_DocProtect) SetAlgorithmName(v string) {
+	if v == "" {
+		e.RemoveAttr("w:algorithmName")
+		return
+	}
+	e.SetAttr("w:algorithmName", v)
+}
+
+// HashValue returns the value of the "w:hashValue" attribute, or "" if absent.
+func (e *CT_DocProtect) HashValue() string {
+	val, ok := e.GetAttr("w:hashValue")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetHashValue sets the "w:hashValue" attribute.
+// Passing "" removes it.
+func (e *CT_DocProtect) SetHashValue(v string) {
+	if v == "" {
+		e.RemoveAttr("w:hashValue")
+		return
+	}
+	e.SetAttr("w:hashValue", v)
+}
+
+// SaltValue returns the value of the "w:saltValue" attribute, or "" if absent.
+func (e *CT_DocProtect) SaltValue() string {
+	val, ok := e.GetAttr("w:saltValue")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetSaltValue sets the "w:saltValue" attribute.
+// Passing "" removes it.
+func (e *CT_DocProtect) SetSaltValue(v string) {
+	if v == "" {
+		e.RemoveAttr("w:saltValue")
+		return
+	}
+	e.SetAttr("w:saltValue", v)
+}
+
+// SpinCount returns the value of the "w:spinCount" attribute, or 0 if absent.
+func (e *CT_DocProtect) SpinCount() int {
+	val, ok := e.GetAttr("w:spinCount")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetSpinCount sets the "w:spinCount" attribute.
+// Passing 0 removes it.
+func (e *CT_DocProtect) SetSpinCount(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:spinCount")
+		return
+	}
+	e.SetAttr("w:spinCount", formatIntAttr(v))
+}
+
+// --- CT_WriteProtection ---
+
+// CT_WriteProtection — write protection element
+type CT_WriteProtection struct {
+	Element
+}
+
+// Recommended returns the value of the "w:recommended" attribute, or false if absent.
+func (e *CT_WriteProtection) Recommended() bool {
+	val, ok := e.GetAttr("w:recommended")
+	if !ok {
+		return false
+	}
+	return parseBoolAttr(val)
+}
+
+// SetRecommended sets the "w:recommended" attribute.
+// Passing false removes it.
+func (e *CT_WriteProtection) SetRecommended(v bool) {
+	if v == false {
+		e.RemoveAttr("w:recommended")
+		return
+	}
+	e.SetAttr("w:recommended", formatBoolAttr(v))
+}
+
+// CryptProviderType returns the value of the "w:cryptProviderType" attribute, or "" if absent.
+func (e *CT_WriteProtection) CryptProviderType() string {
+	val, ok := e.GetAttr("w:cryptProviderType")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetCryptProviderType sets the "w:cryptProviderType" attribute.
+// Passing "" removes it.
+func (e *CT_WriteProtection) SetCryptProviderType(v string) {
+	if v == "" {
+		e.RemoveAttr("w:cryptProviderType")
+		return
+	}
+	e.SetAttr("w:cryptProviderType", v)
+}
+
+// CryptAlgorithmClass returns the value of the "w:cryptAlgorithmClass" attribute, or "" if absent.
+func (e *CT_WriteProtection) CryptAlgorithmClass() string {
+	val, ok := e.GetAttr("w:cryptAlgorithmClass")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetCryptAlgorithmClass sets the "w:cryptAlgorithmClass" attribute.
+// Passing "" removes it.
+func (e *CT_WriteProtection) SetCryptAlgorithmClass(v string) {
+	if v == "" {
+		e.RemoveAttr("w:cryptAlgorithmClass")
+		return
+	}
+	e.SetAttr("w:cryptAlgorithmClass", v)
+}
+
+// CryptAlgorithmType returns the value of the "w:cryptAlgorithmType" attribute, or "" if absent.
+func (e *CT_WriteProtection) CryptAlgorithmType() string {
+	val, ok := e.GetAttr("w:cryptAlgorithmType")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetCryptAlgorithmType sets the "w:cryptAlgorithmType" attribute.
+// Passing "" removes it.
+func (e *CT_WriteProtection) SetCryptAlgorithmType(v string) {
+	if v == "" {
+		e.RemoveAttr("w:cryptAlgorithmType")
+		return
+	}
+	e.SetAttr("w:cryptAlgorithmType", v)
+}
+
+// CryptAlgorithmSid returns the value of the "w:cryptAlgorithmSid" attribute, or 0 if absent.
+func (e *CT_WriteProtection) CryptAlgorithmSid() int {
+	val, ok := e.GetAttr("w:cryptAlgorithmSid")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetCryptAlgorithmSid sets the "w:cryptAlgorithmSid" attribute.
+// Passing 0 removes it.
+func (e *CT_WriteProtection) SetCryptAlgorithmSid(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:cryptAlgorithmSid")
+		return
+	}
+	e.SetAttr("w:cryptAlgorithmSid", formatIntAttr(v))
+}
+
+// CryptSpinCount returns the value of the "w:cryptSpinCount" attribute, or 0 if absent.
+func (e *CT_WriteProtection) CryptSpinCount() int {
+	val, ok := e.GetAttr("w:cryptSpinCount")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetCryptSpinCount sets the "w:cryptSpinCount" attribute.
+// Passing 0 removes it.
+func (e *CT_WriteProtection) SetCryptSpinCount(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:cryptSpinCount")
+		return
+	}
+	e.SetAttr("w:cryptSpinCount", formatIntAttr(v))
+}
+
+// Hash returns the value of the "w:hash" attribute, or "" if absent.
+func (e *CT_WriteProtection) Hash() string {
+	val, ok := e.GetAttr("w:hash")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetHash sets the "w:hash" attribute.
+// Passing "" removes it.
+func (e *CT_WriteProtection) SetHash(v string) {
+	if v == "" {
+		e.RemoveAttr("w:hash")
+		return
+	}
+	e.SetAttr("w:hash", v)
+}
+
+// Salt returns the value of the "w:salt" attribute, or "" if absent.
+func (e *CT_WriteProtection) Salt() string {
+	val, ok := e.GetAttr("w:salt")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetSalt sets the "w:salt" attribute.
+// Passing "" removes it.
+func (e *CT_WriteProtection) SetSalt(v string) {
+	if v == "" {
+		e.RemoveAttr("w:salt")
+		return
+	}
+	e.SetAttr("w:salt", v)
+}
+
+// AlgorithmName returns the value of the "w:algorithmName" attribute, or "" if absent.
+func (e *CT_WriteProtection) AlgorithmName() string {
+	val, ok := e.GetAttr("w:algorithmName")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetAlgorithmName sets the "w:algorithmName" attribute.
+// Passing "" removes it.
+func (e *CT_WriteProtection) SetAlgorithmName(v string) {
+	if v == "" {
+		e.RemoveAttr("w:algorithmName")
+		return
+	}
+	e.SetAttr("w:algorithmName", v)
+}
+
+// HashValue returns the value of the "w:hashValue" attribute, or "" if absent.
+func (e *CT_WriteProtection) HashValue() string {
+	val, ok := e.GetAttr("w:hashValue")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetHashValue sets the "w:hashValue" attribute.
+// Passing "" removes it.
+func (e *CT_WriteProtection) SetHashValue(v string) {
+	if v == "" {
+		e.RemoveAttr("w:hashValue")
+		return
+	}
+	e.SetAttr("w:hashValue", v)
+}
+
+// SaltValue returns the value of the "w:saltValue" attribute, or "" if absent.
+func (e *CT_WriteProtection) SaltValue() string {
+	val, ok := e.GetAttr("w:saltValue")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetSaltValue sets the "w:saltValue" attribute.
+// Passing "" removes it.
+func (e *CT_WriteProtection) SetSaltValue(v string) {
+	if v == "" {
+		e.RemoveAttr("w:saltValue")
+		return
+	}
+	e.SetAttr("w:saltValue", v)
+}
+
+// SpinCount returns the value of the "w:spinCount" attribute, or 0 if absent.
+func (e *CT_WriteProtection) SpinCount() int {
+	val, ok := e.GetAttr("w:spinCount")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetSpinCount sets the "w:spinCount" attribute.
+// Passing 0 removes it.
+func (e *CT_WriteProtection) SetSpinCount(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:spinCount")
+		return
+	}
+	e.SetAttr("w:spinCount", formatIntAttr(v))
+}
+
+// --- CT_Zoom ---
+
+// CT_Zoom — document zoom element
+type CT_Zoom struct {
+	Element
+}
+
+// Val returns the value of the "w:val" attribute, or nil if absent.
+func (e *CT_Zoom) Val() *enum.WdPageFit {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	return parseOptionalEnum(val, enum.WdPageFitFromXml)
+}
+
+// SetVal sets the "w:val" attribute.
+// Passing nil removes it.
+func (e *CT_Zoom) SetVal(v *enum.WdPageFit) {
+	if v == nil {
+		e.RemoveAttr("w:val")
+		return
+	}
+	e.SetAttr("w:val", (*v).ToXml())
+}
+
+// Percent returns the value of the "w:percent" attribute, or 0 if absent.
+func (e *CT_Zoom) Percent() int {
+	val, ok := e.GetAttr("w:percent")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetPercent sets the "w:percent" attribute.
+// Passing 0 removes it.
+func (e *CT_Zoom) SetPercent(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:percent")
+		return
+	}
+	e.SetAttr("w:percent", formatIntAttr(v))
+}
+
+// --- CT_TwipsMeasure ---
+
+// CT_TwipsMeasure — measurement in twentieths of a point, used for w:defaultTabStop, w:hyphenationZone, etc.
+type CT_TwipsMeasure struct {
+	Element
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_TwipsMeasure) Val() (docx.Length, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return parseTwipsAttr(val), nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_TwipsMeasure) SetVal(v docx.Length) {
+	e.SetAttr("w:val", formatTwipsAttr(v))
+}
+
+// --- CT_Compat ---
+
+// CT_Compat — compatibility settings element
+type CT_Compat struct {
+	Element
+}
+
+// CompatSettingList returns all <w:compatSetting> child elements.
+func (e *CT_Compat) CompatSettingList() []*CT_CompatSetting {
+	children := e.FindAllChildren("w:compatSetting")
+	result := make([]*CT_CompatSetting, len(children))
+	for i, c := range children {
+		result[i] = &CT_CompatSetting{Element{E: c}}
+	}
+	return result
+}
+
+// AddCompatSetting adds a new <w:compatSetting> in correct sequence.
+func (e *CT_Compat) AddCompatSetting() *CT_CompatSetting {
+	return e.addCompatSetting()
+}
+
+// addCompatSetting adds a new <w:compatSetting> unconditionally in correct sequence.
+func (e *CT_Compat) addCompatSetting() *CT_CompatSetting {
+	child := e.newCompatSetting()
+	e.insertCompatSetting(child)
+	return child
+}
+
+// newCompatSetting creates a detached <w:compatSetting> element.
+func (e *CT_Compat) newCompatSetting() *CT_CompatSetting {
+	el := OxmlElement("w:compatSetting")
+	return &CT_CompatSetting{Element{E: el}}
+}
+
+// insertCompatSetting inserts child before first successor.
+func (e *CT_Compat) insertCompatSetting(child *CT_CompatSetting) *CT_CompatSetting {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// --- CT_CompatSetting ---
+
+// CT_CompatSetting — named compatibility setting
+type CT_CompatSetting struct {
+	Element
+}
+
+// Name returns the value of the required "w:name" attribute.
+func (e *CT_CompatSetting) Name() (string, error) {
+	val, ok := e.GetAttr("w:name")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:name", e.Tag())
+	}
+	return val, nil
+}
+
+// SetName sets the required "w:name" attribute.
+func (e *CT_CompatSetting) SetName(v string) {
+	e.SetAttr("w:name", v)
+}
+
+// Uri returns the value of the required "w:uri" attribute.
+func (e *CT_CompatSetting) Uri() (string, error) {
+	val, ok := e.GetAttr("w:uri")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:uri", e.Tag())
+	}
+	return val, nil
+}
+
+// SetUri sets the required "w:uri" attribute.
+func (e *CT_CompatSetting) SetUri(v string) {
+	e.SetAttr("w:uri", v)
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_CompatSetting) Val() (string, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return val, nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_CompatSetting) SetVal(v string) {
+	e.SetAttr("w:val", v)
+}
+
+// --- CT_DocRsids ---
+
+// CT_DocRsids — revision save ids element
+type CT_DocRsids struct {
+	Element
+}
+
+// RsidRoot returns the <w:rsidRoot> child element, or nil if not present.
+func (e *CT_DocRsids) RsidRoot() *CT_LongHexNumber {
+	child := e.FindChild("w:rsidRoot")
+	if child == nil {
+		return nil
+	}
+	return &CT_LongHexNumber{Element{E: child}}
+}
+
+// GetOrAddRsidRoot returns <w:rsidRoot>, creating it if not present.
+func (e *CT_DocRsids) GetOrAddRsidRoot() *CT_LongHexNumber {
+	child := e.RsidRoot()
+	if child != nil {
+		return child
+	}
+	return e.addRsidRoot()
+}
+
+// RemoveRsidRoot removes all <w:rsidRoot> child elements.
+func (e *CT_DocRsids) RemoveRsidRoot() {
+	e.RemoveAll("w:rsidRoot")
+}
+
+// addRsidRoot adds a new <w:rsidRoot> in correct sequence.
+func (e *CT_DocRsids) addRsidRoot() *CT_LongHexNumber {
+	child := e.newRsidRoot()
+	e.insertRsidRoot(child)
+	return child
+}
+
+// newRsidRoot creates a detached <w:rsidRoot> element.
+func (e *CT_DocRsids) newRsidRoot() *CT_LongHexNumber {
+	el := OxmlElement("w:rsidRoot")
+	return &CT_LongHexNumber{Element{E: el}}
+}
+
+// insertRsidRoot inserts child before first successor.
+func (e *CT_DocRsids) insertRsidRoot(child *CT_LongHexNumber) *CT_LongHexNumber {
+	e.InsertElementBefore(child.E, "w:rsid")
+	return child
+}
+
+// RsidList returns all <w:rsid> child elements.
+func (e *CT_DocRsids) RsidList() []*CT_LongHexNumber {
+	children := e.FindAllChildren("w:rsid")
+	result := make([]*CT_LongHexNumber, len(children))
+	for i, c := range children {
+		result[i] = &CT_LongHexNumber{Element{E: c}}
+	}
+	return result
+}
+
+// AddRsid adds a new <w:rsid> in correct sequence.
+func (e *CT_DocRsids) AddRsid() *CT_LongHexNumber {
+	return e.addRsid()
+}
+
+// addRsid adds a new <w:rsid> unconditionally in correct sequence.
+func (e *CT_DocRsids) addRsid() *CT_LongHexNumber {
+	child := e.newRsid()
+	e.insertRsid(child)
+	return child
+}
+
+// newRsid creates a detached <w:rsid> element.
+func (e *CT_DocRsids) newRsid() *CT_LongHexNumber {
+	el := OxmlElement("w:rsid")
+	return &CT_LongHexNumber{Element{E: el}}
+}
+
+// insertRsid inserts child before first successor.
+func (e *CT_DocRsids) insertRsid(child *CT_LongHexNumber) *CT_LongHexNumber {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// --- CT_LongHexNumber ---
+
+// CT_LongHexNumber — four-byte hexadecimal number element used for w:rsid and w:rsidRoot
+type CT_LongHexNumber struct {
+	Element
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_LongHexNumber) Val() (string, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return val, nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_LongHexNumber) SetVal(v string) {
+	e.SetAttr("w:val", v)
+}
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_Settings",
+		tag:  "w:settings",
+		wrap: func(el Element) Node { return &CT_Settings{el} },
+		children: []childMeta{
+			{tag: "w:writeProtection", typ: "CT_WriteProtection", card: cardZeroOrOne, successors: []string{"w:view", "w:zoom", "w:removePersonalInformation", "w:removeDateAndTime", "w:doNotDisplayPageBoundaries", "w:displayBackgroundShape", "w:printPostScriptOverText", "w:printFractionalCharacterWidth", "w:printFormsData", "w:embedTrueTypeFonts", "w:embedSystemFonts", "w:saveSubsetFonts", "w:saveFormsData", "w:mirrorMargins", "w:alignBordersAndEdges", "w:bordersDoNotSurroundHeader", "w:bordersDoNotSurroundFooter", "w:gutterAtTop", "w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:zoom", typ: "CT_Zoom", card: cardZeroOrOne, successors: []string{"w:removePersonalInformation", "w:removeDateAndTime", "w:doNotDisplayPageBoundaries", "w:displayBackgroundShape", "w:printPostScriptOverText", "w:printFractionalCharacterWidth", "w:printFormsData", "w:embedTrueTypeFonts", "w:embedSystemFonts", "w:saveSubsetFonts", "w:saveFormsData", "w:mirrorMargins", "w:alignBordersAndEdges", "w:bordersDoNotSurroundHeader", "w:bordersDoNotSurroundFooter", "w:gutterAtTop", "w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:mirrorMargins", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:alignBordersAndEdges", "w:bordersDoNotSurroundHeader", "w:bordersDoNotSurroundFooter", "w:gutterAtTop", "w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:gutterAtTop", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:hideSpellingErrors", "w:hideGrammaticalErrors", "w:activeWritingStyle", "w:proofState", "w:formsDesign", "w:attachedTemplate", "w:linkStyles", "w:stylePaneFormatFilter", "w:stylePaneSortMethod", "w:documentType", "w:mailMerge", "w:revisionView", "w:trackRevisions", "w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:trackRevisions", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:doNotTrackMoves", "w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:doNotTrackMoves", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:doNotTrackFormatting", "w:documentProtection", "w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:documentProtection", typ: "CT_DocProtect", card: cardZeroOrOne, successors: []string{"w:autoFormatOverride", "w:styleLockTheme", "w:styleLockQFSet", "w:defaultTabStop", "w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:defaultTabStop", typ: "CT_TwipsMeasure", card: cardZeroOrOne, successors: []string{"w:autoHyphenation", "w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:autoHyphenation", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:consecutiveHyphenLimit", "w:hyphenationZone", "w:doNotHyphenateCaps", "w:showEnvelope", "w:summaryLength", "w:clickAndTypeStyle", "w:defaultTableStyle", "w:evenAndOddHeaders", "w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:evenAndOddHeaders", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:bookFoldRevPrinting", "w:bookFoldPrinting", "w:bookFoldPrintingSheets", "w:drawingGridHorizontalSpacing", "w:drawingGridVerticalSpacing", "w:displayHorizontalDrawingGridEvery", "w:displayVerticalDrawingGridEvery", "w:doNotUseMarginsForDrawingGridOrigin", "w:drawingGridHorizontalOrigin", "w:drawingGridVerticalOrigin", "w:doNotShadeFormData", "w:noPunctuationKerning", "w:characterSpacingControl", "w:printTwoOnOne", "w:strictFirstAndLastChars", "w:noLineBreaksAfter", "w:noLineBreaksBefore", "w:savePreviewPicture", "w:doNotValidateAgainstSchema", "w:saveInvalidXml", "w:ignoreMixedContent", "w:alwaysShowPlaceholderText", "w:doNotDemarcateInvalidXml", "w:saveXmlDataOnly", "w:useXSLTWhenSaving", "w:saveThroughXslt", "w:showXMLTags", "w:alwaysMergeEmptyNamespace", "w:updateFields", "w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:updateFields", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:hdrShapeDefaults", "w:footnotePr", "w:endnotePr", "w:compat", "w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:compat", typ: "CT_Compat", card: cardZeroOrOne, successors: []string{"w:docVars", "w:rsids", "m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+			{tag: "w:rsids", typ: "CT_DocRsids", card: cardZeroOrOne, successors: []string{"m:mathPr", "w:attachedSchema", "w:themeFontLang", "w:clrSchemeMapping", "w:doNotIncludeSubdocsInStats", "w:doNotAutoCompressPictures", "w:forceUpgrade", "w:captions", "w:readModeInkLockDown", "w:smartTagType", "sl:schemaLibrary", "w:shapeDefaults", "w:doNotEmbedSmartTags", "w:decimalSymbol", "w:listSeparator", "w14:docId", "w14:conflictMode", "w14:discardImageEditingData", "w14:defaultImageDpi", "w15:chartTrackingRefBased", "w15:docId"}},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_DocProtect",
+		tag:  "w:documentProtection",
+		wrap: func(el Element) Node { return &CT_DocProtect{el} },
+		attributes: []attrMeta{
+			{name: "w:edit", check: checkEnumAttr(enum.WdProtectionTypeFromXml)},
+			{name: "w:formatting", check: checkBoolAttr},
+			{name: "w:enforcement", check: checkBoolAttr},
+			{name: "w:cryptAlgorithmSid", check: checkIntAttr},
+			{name: "w:cryptSpinCount", check: checkIntAttr},
+			{name: "w:spinCount", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_WriteProtection",
+		tag:  "w:writeProtection",
+		wrap: func(el Element) Node { return &CT_WriteProtection{el} },
+		attributes: []attrMeta{
+			{name: "w:recommended", check: checkBoolAttr},
+			{name: "w:cryptAlgorithmSid", check: checkIntAttr},
+			{name: "w:cryptSpinCount", check: checkIntAttr},
+			{name: "w:spinCount", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Zoom",
+		tag:  "w:zoom",
+		wrap: func(el Element) Node { return &CT_Zoom{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkEnumAttr(enum.WdPageFitFromXml)},
+			{name: "w:percent", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TwipsMeasure",
+		tag:  "w:defaultTabStop",
+		wrap: func(el Element) Node { return &CT_TwipsMeasure{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkValueAttr(parseTwips)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Compat",
+		tag:  "w:compat",
+		wrap: func(el Element) Node { return &CT_Compat{el} },
+		children: []childMeta{
+			{tag: "w:compatSetting", typ: "CT_CompatSetting", card: cardZeroOrMore},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_CompatSetting",
+		tag:  "w:compatSetting",
+		wrap: func(el Element) Node { return &CT_CompatSetting{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_DocRsids",
+		tag:  "w:rsids",
+		wrap: func(el Element) Node { return &CT_DocRsids{el} },
+		children: []childMeta{
+			{tag: "w:rsidRoot", typ: "CT_LongHexNumber", card: cardZeroOrOne, successors: []string{"w:rsid"}},
+			{tag: "w:rsid", typ: "CT_LongHexNumber", card: cardZeroOrMore},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_LongHexNumber",
+		tag:  "w:rsid",
+		wrap: func(el Element) Node { return &CT_LongHexNumber{el} },
+	})
+}
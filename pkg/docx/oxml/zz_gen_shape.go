@@ -4,6 +4,7 @@ package oxml
 
 import (
 	"fmt"
+	"github.com/user/go-docx/pkg/docx/enum"
 )
 
 // Ensure imports are used.
@@ -53,491 +54,2489 @@ type CT_Anchor struct {
 	Element
 }
 
-// --- CT_Picture ---
+// EffectExtent returns the <wp:effectExtent> child element, or nil if not present.
+func (e *CT_Anchor) EffectExtent() *CT_EffectExtent {
+	child := e.FindChild("wp:effectExtent")
+	if child == nil {
+		return nil
+	}
+	return &CT_EffectExtent{Element{E: child}}
+}
 
-// CT_Picture — DrawingML picture element
-type CT_Picture struct {
-	Element
+// GetOrAddEffectExtent returns <wp:effectExtent>, creating it if not present.
+func (e *CT_Anchor) GetOrAddEffectExtent() *CT_EffectExtent {
+	child := e.EffectExtent()
+	if child != nil {
+		return child
+	}
+	return e.addEffectExtent()
 }
 
-// NvPicPr returns the required <pic:nvPicPr> child element.
+// RemoveEffectExtent removes all <wp:effectExtent> child elements.
+func (e *CT_Anchor) RemoveEffectExtent() {
+	e.RemoveAll("wp:effectExtent")
+}
+
+// addEffectExtent adds a new <wp:effectExtent> in correct sequence.
+func (e *CT_Anchor) addEffectExtent() *CT_EffectExtent {
+	child := e.newEffectExtent()
+	e.insertEffectExtent(child)
+	return child
+}
+
+// newEffectExtent creates a detached <wp:effectExtent> element.
+func (e *CT_Anchor) newEffectExtent() *CT_EffectExtent {
+	el := OxmlElement("wp:effectExtent")
+	return &CT_EffectExtent{Element{E: el}}
+}
+
+// insertEffectExtent inserts child before first successor.
+func (e *CT_Anchor) insertEffectExtent(child *CT_EffectExtent) *CT_EffectExtent {
+	e.InsertElementBefore(child.E, "wp:wrapNone", "wp:wrapSquare", "wp:wrapTight", "wp:wrapThrough", "wp:wrapTopAndBottom", "wp:docPr", "wp:cNvGraphicFramePr", "a:graphic")
+	return child
+}
+
+// SimplePos returns the required <wp:simplePos> child element.
 // Panics if not present (invalid XML).
-func (e *CT_Picture) NvPicPr() *CT_PictureNonVisual {
-	child := e.FindChild("pic:nvPicPr")
+func (e *CT_Anchor) SimplePos() *CT_Point2D {
+	child := e.FindChild("wp:simplePos")
 	if child == nil {
-		panic(fmt.Sprintf("required <%s> child not present in <%s>", "pic:nvPicPr", e.Tag()))
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "wp:simplePos", e.Tag()))
 	}
-	return &CT_PictureNonVisual{Element{E: child}}
+	return &CT_Point2D{Element{E: child}}
 }
 
-// BlipFill returns the required <pic:blipFill> child element.
+// PositionH returns the required <wp:positionH> child element.
 // Panics if not present (invalid XML).
-func (e *CT_Picture) BlipFill() *CT_BlipFillProperties {
-	child := e.FindChild("pic:blipFill")
+func (e *CT_Anchor) PositionH() *CT_PosH {
+	child := e.FindChild("wp:positionH")
 	if child == nil {
-		panic(fmt.Sprintf("required <%s> child not present in <%s>", "pic:blipFill", e.Tag()))
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "wp:positionH", e.Tag()))
 	}
-	return &CT_BlipFillProperties{Element{E: child}}
+	return &CT_PosH{Element{E: child}}
 }
 
-// SpPr returns the required <pic:spPr> child element.
+// PositionV returns the required <wp:positionV> child element.
 // Panics if not present (invalid XML).
-func (e *CT_Picture) SpPr() *CT_ShapeProperties {
-	child := e.FindChild("pic:spPr")
+func (e *CT_Anchor) PositionV() *CT_PosV {
+	child := e.FindChild("wp:positionV")
 	if child == nil {
-		panic(fmt.Sprintf("required <%s> child not present in <%s>", "pic:spPr", e.Tag()))
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "wp:positionV", e.Tag()))
 	}
-	return &CT_ShapeProperties{Element{E: child}}
+	return &CT_PosV{Element{E: child}}
 }
 
-// --- CT_PictureNonVisual ---
-
-// CT_PictureNonVisual — non-visual picture properties element
-type CT_PictureNonVisual struct {
-	Element
+// Extent returns the required <wp:extent> child element.
+// Panics if not present (invalid XML).
+func (e *CT_Anchor) Extent() *CT_PositiveSize2D {
+	child := e.FindChild("wp:extent")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "wp:extent", e.Tag()))
+	}
+	return &CT_PositiveSize2D{Element{E: child}}
 }
 
-// CNvPr returns the required <pic:cNvPr> child element.
+// DocPr returns the required <wp:docPr> child element.
 // Panics if not present (invalid XML).
-func (e *CT_PictureNonVisual) CNvPr() *CT_NonVisualDrawingProps {
-	child := e.FindChild("pic:cNvPr")
+func (e *CT_Anchor) DocPr() *CT_NonVisualDrawingProps {
+	child := e.FindChild("wp:docPr")
 	if child == nil {
-		panic(fmt.Sprintf("required <%s> child not present in <%s>", "pic:cNvPr", e.Tag()))
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "wp:docPr", e.Tag()))
 	}
 	return &CT_NonVisualDrawingProps{Element{E: child}}
 }
 
-// --- CT_NonVisualDrawingProps ---
+// Graphic returns the required <a:graphic> child element.
+// Panics if not present (invalid XML).
+func (e *CT_Anchor) Graphic() *CT_GraphicalObject {
+	child := e.FindChild("a:graphic")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "a:graphic", e.Tag()))
+	}
+	return &CT_GraphicalObject{Element{E: child}}
+}
 
-// CT_NonVisualDrawingProps — non-visual drawing properties element
-type CT_NonVisualDrawingProps struct {
-	Element
+// DistT returns the value of the "distT" attribute, or 0 if absent.
+func (e *CT_Anchor) DistT() int64 {
+	val, ok := e.GetAttr("distT")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
 }
 
-// Id returns the value of the required "id" attribute.
-func (e *CT_NonVisualDrawingProps) Id() (int, error) {
-	val, ok := e.GetAttr("id")
+// SetDistT sets the "distT" attribute.
+// Passing 0 removes it.
+func (e *CT_Anchor) SetDistT(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distT")
+		return
+	}
+	e.SetAttr("distT", formatInt64Attr(v))
+}
+
+// DistB returns the value of the "distB" attribute, or 0 if absent.
+func (e *CT_Anchor) DistB() int64 {
+	val, ok := e.GetAttr("distB")
 	if !ok {
-		return 0, fmt.Errorf("required attribute %q not present on <%s>", "id", e.Tag())
+		return 0
 	}
-	return parseIntAttr(val), nil
+	return parseInt64Attr(val)
 }
 
-// SetId sets the required "id" attribute.
-func (e *CT_NonVisualDrawingProps) SetId(v int) {
-	e.SetAttr("id", formatIntAttr(v))
+// SetDistB sets the "distB" attribute.
+// Passing 0 removes it.
+func (e *CT_Anchor) SetDistB(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distB")
+		return
+	}
+	e.SetAttr("distB", formatInt64Attr(v))
 }
 
-// Name returns the value of the required "name" attribute.
-func (e *CT_NonVisualDrawingProps) Name() (string, error) {
-	val, ok := e.GetAttr("name")
+// DistL returns the value of the "distL" attribute, or 0 if absent.
+func (e *CT_Anchor) DistL() int64 {
+	val, ok := e.GetAttr("distL")
 	if !ok {
-		return "", fmt.Errorf("required attribute %q not present on <%s>", "name", e.Tag())
+		return 0
 	}
-	return val, nil
+	return parseInt64Attr(val)
 }
 
-// SetName sets the required "name" attribute.
-func (e *CT_NonVisualDrawingProps) SetName(v string) {
-	e.SetAttr("name", v)
+// SetDistL sets the "distL" attribute.
+// Passing 0 removes it.
+func (e *CT_Anchor) SetDistL(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distL")
+		return
+	}
+	e.SetAttr("distL", formatInt64Attr(v))
 }
 
-// --- CT_NonVisualPictureProperties ---
+// DistR returns the value of the "distR" attribute, or 0 if absent.
+func (e *CT_Anchor) DistR() int64 {
+	val, ok := e.GetAttr("distR")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
 
-// CT_NonVisualPictureProperties — non-visual picture-specific properties
-type CT_NonVisualPictureProperties struct {
-	Element
+// SetDistR sets the "distR" attribute.
+// Passing 0 removes it.
+func (e *CT_Anchor) SetDistR(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distR")
+		return
+	}
+	e.SetAttr("distR", formatInt64Attr(v))
 }
 
-// --- CT_GraphicalObject ---
+// UseSimplePos returns the value of the "simplePos" attribute, or false if absent.
+func (e *CT_Anchor) UseSimplePos() bool {
+	val, ok := e.GetAttr("simplePos")
+	if !ok {
+		return false
+	}
+	return parseBoolAttr(val)
+}
 
-// CT_GraphicalObject — graphical object element
-type CT_GraphicalObject struct {
-	Element
+// SetUseSimplePos sets the "simplePos" attribute.
+// Passing false removes it.
+func (e *CT_Anchor) SetUseSimplePos(v bool) {
+	if v == false {
+		e.RemoveAttr("simplePos")
+		return
+	}
+	e.SetAttr("simplePos", formatBoolAttr(v))
 }
 
-// GraphicData returns the required <a:graphicData> child element.
-// Panics if not present (invalid XML).
-func (e *CT_GraphicalObject) GraphicData() *CT_GraphicalObjectData {
-	child := e.FindChild("a:graphicData")
+// Hidden returns the value of the "hidden" attribute, or false if absent.
+func (e *CT_Anchor) Hidden() bool {
+	val, ok := e.GetAttr("hidden")
+	if !ok {
+		return false
+	}
+	return parseBoolAttr(val)
+}
+
+// SetHidden sets the "hidden" attribute.
+// Passing false removes it.
+func (e *CT_Anchor) SetHidden(v bool) {
+	if v == false {
+		e.RemoveAttr("hidden")
+		return
+	}
+	e.SetAttr("hidden", formatBoolAttr(v))
+}
+
+// RelativeHeight returns the value of the required "relativeHeight" attribute.
+func (e *CT_Anchor) RelativeHeight() (int64, error) {
+	val, ok := e.GetAttr("relativeHeight")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "relativeHeight", e.Tag())
+	}
+	return parseInt64Attr(val), nil
+}
+
+// SetRelativeHeight sets the required "relativeHeight" attribute.
+func (e *CT_Anchor) SetRelativeHeight(v int64) {
+	e.SetAttr("relativeHeight", formatInt64Attr(v))
+}
+
+// BehindDoc returns the value of the required "behindDoc" attribute.
+func (e *CT_Anchor) BehindDoc() (bool, error) {
+	val, ok := e.GetAttr("behindDoc")
+	if !ok {
+		return false, fmt.Errorf("required attribute %q not present on <%s>", "behindDoc", e.Tag())
+	}
+	return parseBoolAttr(val), nil
+}
+
+// SetBehindDoc sets the required "behindDoc" attribute.
+func (e *CT_Anchor) SetBehindDoc(v bool) {
+	e.SetAttr("behindDoc", formatBoolAttr(v))
+}
+
+// Locked returns the value of the required "locked" attribute.
+func (e *CT_Anchor) Locked() (bool, error) {
+	val, ok := e.GetAttr("locked")
+	if !ok {
+		return false, fmt.Errorf("required attribute %q not present on <%s>", "locked", e.Tag())
+	}
+	return parseBoolAttr(val), nil
+}
+
+// SetLocked sets the required "locked" attribute.
+func (e *CT_Anchor) SetLocked(v bool) {
+	e.SetAttr("locked", formatBoolAttr(v))
+}
+
+// LayoutInCell returns the value of the required "layoutInCell" attribute.
+func (e *CT_Anchor) LayoutInCell() (bool, error) {
+	val, ok := e.GetAttr("layoutInCell")
+	if !ok {
+		return false, fmt.Errorf("required attribute %q not present on <%s>", "layoutInCell", e.Tag())
+	}
+	return parseBoolAttr(val), nil
+}
+
+// SetLayoutInCell sets the required "layoutInCell" attribute.
+func (e *CT_Anchor) SetLayoutInCell(v bool) {
+	e.SetAttr("layoutInCell", formatBoolAttr(v))
+}
+
+// AllowOverlap returns the value of the required "allowOverlap" attribute.
+func (e *CT_Anchor) AllowOverlap() (bool, error) {
+	val, ok := e.GetAttr("allowOverlap")
+	if !ok {
+		return false, fmt.Errorf("required attribute %q not present on <%s>", "allowOverlap", e.Tag())
+	}
+	return parseBoolAttr(val), nil
+}
+
+// SetAllowOverlap sets the required "allowOverlap" attribute.
+func (e *CT_Anchor) SetAllowOverlap(v bool) {
+	e.SetAttr("allowOverlap", formatBoolAttr(v))
+}
+
+// Wrap returns the child element belonging to this choice group,
+// or nil if no member child is present.
+func (e *CT_Anchor) Wrap() *Element {
+	child := e.FirstChildIn("wp:wrapNone", "wp:wrapSquare", "wp:wrapTight", "wp:wrapThrough", "wp:wrapTopAndBottom")
 	if child == nil {
-		panic(fmt.Sprintf("required <%s> child not present in <%s>", "a:graphicData", e.Tag()))
+		return nil
 	}
-	return &CT_GraphicalObjectData{Element{E: child}}
+	return &Element{E: child}
 }
 
-// --- CT_GraphicalObjectData ---
+// RemoveWrap removes the current choice group child element if present.
+func (e *CT_Anchor) RemoveWrap() {
+	e.RemoveAll("wp:wrapNone", "wp:wrapSquare", "wp:wrapTight", "wp:wrapThrough", "wp:wrapTopAndBottom")
+}
 
-// CT_GraphicalObjectData — graphical object data element
-type CT_GraphicalObjectData struct {
-	Element
+// WrapNone returns the <wp:wrapNone> choice member, or nil if not present.
+func (e *CT_Anchor) WrapNone() *CT_WrapNone {
+	child := e.FindChild("wp:wrapNone")
+	if child == nil {
+		return nil
+	}
+	return &CT_WrapNone{Element{E: child}}
 }
 
-// Pic returns the <pic:pic> child element, or nil if not present.
-func (e *CT_GraphicalObjectData) Pic() *CT_Picture {
-	child := e.FindChild("pic:pic")
+// GetOrChangeToWrapNone returns the <wp:wrapNone> child, replacing any other
+// group element if found.
+func (e *CT_Anchor) GetOrChangeToWrapNone() *CT_WrapNone {
+	child := e.WrapNone()
+	if child != nil {
+		return child
+	}
+	e.RemoveWrap()
+	return e.addWrapNone()
+}
+
+// addWrapNone adds a new <wp:wrapNone> in correct sequence.
+func (e *CT_Anchor) addWrapNone() *CT_WrapNone {
+	child := e.newWrapNone()
+	e.insertWrapNone(child)
+	return child
+}
+
+// newWrapNone creates a detached <wp:wrapNone> element.
+func (e *CT_Anchor) newWrapNone() *CT_WrapNone {
+	el := OxmlElement("wp:wrapNone")
+	return &CT_WrapNone{Element{E: el}}
+}
+
+// insertWrapNone inserts child before first successor.
+func (e *CT_Anchor) insertWrapNone(child *CT_WrapNone) *CT_WrapNone {
+	e.InsertElementBefore(child.E, "wp:docPr", "wp:cNvGraphicFramePr", "a:graphic")
+	return child
+}
+
+// WrapSquare returns the <wp:wrapSquare> choice member, or nil if not present.
+func (e *CT_Anchor) WrapSquare() *CT_WrapSquare {
+	child := e.FindChild("wp:wrapSquare")
 	if child == nil {
 		return nil
 	}
-	return &CT_Picture{Element{E: child}}
+	return &CT_WrapSquare{Element{E: child}}
 }
 
-// GetOrAddPic returns <pic:pic>, creating it if not present.
-func (e *CT_GraphicalObjectData) GetOrAddPic() *CT_Picture {
-	child := e.Pic()
+// GetOrChangeToWrapSquare returns the <wp:wrapSquare> child, replacing any other
+// group element if found.
+func (e *CT_Anchor) GetOrChangeToWrapSquare() *CT_WrapSquare {
+	child := e.WrapSquare()
 	if child != nil {
 		return child
 	}
-	return e.addPic()
+	e.RemoveWrap()
+	return e.addWrapSquare()
 }
 
-// RemovePic removes all <pic:pic> child elements.
-func (e *CT_GraphicalObjectData) RemovePic() {
-	e.RemoveAll("pic:pic")
+// addWrapSquare adds a new <wp:wrapSquare> in correct sequence.
+func (e *CT_Anchor) addWrapSquare() *CT_WrapSquare {
+	child := e.newWrapSquare()
+	e.insertWrapSquare(child)
+	return child
 }
 
-// addPic adds a new <pic:pic> in correct sequence.
-func (e *CT_GraphicalObjectData) addPic() *CT_Picture {
-	child := e.newPic()
-	e.insertPic(child)
+// newWrapSquare creates a detached <wp:wrapSquare> element.
+func (e *CT_Anchor) newWrapSquare() *CT_WrapSquare {
+	el := OxmlElement("wp:wrapSquare")
+	return &CT_WrapSquare{Element{E: el}}
+}
+
+// insertWrapSquare inserts child before first successor.
+func (e *CT_Anchor) insertWrapSquare(child *CT_WrapSquare) *CT_WrapSquare {
+	e.InsertElementBefore(child.E, "wp:docPr", "wp:cNvGraphicFramePr", "a:graphic")
 	return child
 }
 
-// newPic creates a detached <pic:pic> element.
-func (e *CT_GraphicalObjectData) newPic() *CT_Picture {
-	el := OxmlElement("pic:pic")
-	return &CT_Picture{Element{E: el}}
+// WrapTight returns the <wp:wrapTight> choice member, or nil if not present.
+func (e *CT_Anchor) WrapTight() *CT_WrapTight {
+	child := e.FindChild("wp:wrapTight")
+	if child == nil {
+		return nil
+	}
+	return &CT_WrapTight{Element{E: child}}
 }
 
-// insertPic inserts child before first successor.
-func (e *CT_GraphicalObjectData) insertPic(child *CT_Picture) *CT_Picture {
-	e.InsertElementBefore(child.E)
+// GetOrChangeToWrapTight returns the <wp:wrapTight> child, replacing any other
+// group element if found.
+func (e *CT_Anchor) GetOrChangeToWrapTight() *CT_WrapTight {
+	child := e.WrapTight()
+	if child != nil {
+		return child
+	}
+	e.RemoveWrap()
+	return e.addWrapTight()
+}
+
+// addWrapTight adds a new <wp:wrapTight> in correct sequence.
+func (e *CT_Anchor) addWrapTight() *CT_WrapTight {
+	child := e.newWrapTight()
+	e.insertWrapTight(child)
 	return child
 }
 
-// Uri returns the value of the required "uri" attribute.
-func (e *CT_GraphicalObjectData) Uri() (string, error) {
-	val, ok := e.GetAttr("uri")
-	if !ok {
-		return "", fmt.Errorf("required attribute %q not present on <%s>", "uri", e.Tag())
+// newWrapTight creates a detached <wp:wrapTight> element.
+func (e *CT_Anchor) newWrapTight() *CT_WrapTight {
+	el := OxmlElement("wp:wrapTight")
+	return &CT_WrapTight{Element{E: el}}
+}
+
+// insertWrapTight inserts child before first successor.
+func (e *CT_Anchor) insertWrapTight(child *CT_WrapTight) *CT_WrapTight {
+	e.InsertElementBefore(child.E, "wp:docPr", "wp:cNvGraphicFramePr", "a:graphic")
+	return child
+}
+
+// WrapThrough returns the <wp:wrapThrough> choice member, or nil if not present.
+func (e *CT_Anchor) WrapThrough() *CT_WrapThrough {
+	child := e.FindChild("wp:wrapThrough")
+	if child == nil {
+		return nil
 	}
-	return val, nil
+	return &CT_WrapThrough{Element{E: child}}
 }
 
-// SetUri sets the required "uri" attribute.
-func (e *CT_GraphicalObjectData) SetUri(v string) {
-	e.SetAttr("uri", v)
+// GetOrChangeToWrapThrough returns the <wp:wrapThrough> child, replacing any other
+// group element if found.
+func (e *CT_Anchor) GetOrChangeToWrapThrough() *CT_WrapThrough {
+	child := e.WrapThrough()
+	if child != nil {
+		return child
+	}
+	e.RemoveWrap()
+	return e.addWrapThrough()
+}
+
+// addWrapThrough adds a new <wp:wrapThrough> in correct sequence.
+func (e *CT_Anchor) addWrapThrough() *CT_WrapThrough {
+	child := e.newWrapThrough()
+	e.insertWrapThrough(child)
+	return child
+}
+
+// newWrapThrough creates a detached <wp:wrapThrough> element.
+func (e *CT_Anchor) newWrapThrough() *CT_WrapThrough {
+	el := OxmlElement("wp:wrapThrough")
+	return &CT_WrapThrough{Element{E: el}}
+}
+
+// insertWrapThrough inserts child before first successor.
+func (e *CT_Anchor) insertWrapThrough(child *CT_WrapThrough) *CT_WrapThrough {
+	e.InsertElementBefore(child.E, "wp:docPr", "wp:cNvGraphicFramePr", "a:graphic")
+	return child
+}
+
+// WrapTopAndBottom returns the <wp:wrapTopAndBottom> choice member, or nil if not present.
+func (e *CT_Anchor) WrapTopAndBottom() *CT_WrapTopBottom {
+	child := e.FindChild("wp:wrapTopAndBottom")
+	if child == nil {
+		return nil
+	}
+	return &CT_WrapTopBottom{Element{E: child}}
+}
+
+// GetOrChangeToWrapTopAndBottom returns the <wp:wrapTopAndBottom> child, replacing any other
+// group element if found.
+func (e *CT_Anchor) GetOrChangeToWrapTopAndBottom() *CT_WrapTopBottom {
+	child := e.WrapTopAndBottom()
+	if child != nil {
+		return child
+	}
+	e.RemoveWrap()
+	return e.addWrapTopAndBottom()
+}
+
+// addWrapTopAndBottom adds a new <wp:wrapTopAndBottom> in correct sequence.
+func (e *CT_Anchor) addWrapTopAndBottom() *CT_WrapTopBottom {
+	child := e.newWrapTopAndBottom()
+	e.insertWrapTopAndBottom(child)
+	return child
+}
+
+// newWrapTopAndBottom creates a detached <wp:wrapTopAndBottom> element.
+func (e *CT_Anchor) newWrapTopAndBottom() *CT_WrapTopBottom {
+	el := OxmlElement("wp:wrapTopAndBottom")
+	return &CT_WrapTopBottom{Element{E: el}}
+}
+
+// insertWrapTopAndBottom inserts child before first successor.
+func (e *CT_Anchor) insertWrapTopAndBottom(child *CT_WrapTopBottom) *CT_WrapTopBottom {
+	e.InsertElementBefore(child.E, "wp:docPr", "wp:cNvGraphicFramePr", "a:graphic")
+	return child
+}
+
+// --- CT_PosH ---
+
+// CT_PosH — horizontal position of a floating shape
+type CT_PosH struct {
+	Element
+}
+
+// RelativeFrom returns the value of the required "relativeFrom" attribute.
+func (e *CT_PosH) RelativeFrom() (enum.WdRelativeHorizontalPosition, error) {
+	val, ok := e.GetAttr("relativeFrom")
+	if !ok {
+		return enum.WdRelativeHorizontalPosition(0), fmt.Errorf("required attribute %q not present on <%s>", "relativeFrom", e.Tag())
+	}
+	return mustParseEnum(val, enum.WdRelativeHorizontalPositionFromXml), nil
+}
+
+// SetRelativeFrom sets the required "relativeFrom" attribute.
+func (e *CT_PosH) SetRelativeFrom(v enum.WdRelativeHorizontalPosition) {
+	e.SetAttr("relativeFrom", v.ToXml())
+}
+
+// Pos returns the child element belonging to this choice group,
+// or nil if no member child is present.
+func (e *CT_PosH) Pos() *Element {
+	child := e.FirstChildIn("wp:align", "wp:posOffset")
+	if child == nil {
+		return nil
+	}
+	return &Element{E: child}
+}
+
+// RemovePos removes the current choice group child element if present.
+func (e *CT_PosH) RemovePos() {
+	e.RemoveAll("wp:align", "wp:posOffset")
+}
+
+// Align returns the <wp:align> choice member, or nil if not present.
+func (e *CT_PosH) Align() *CT_PosAlign {
+	child := e.FindChild("wp:align")
+	if child == nil {
+		return nil
+	}
+	return &CT_PosAlign{Element{E: child}}
+}
+
+// GetOrChangeToAlign returns the <wp:align> child, replacing any other
+// group element if found.
+func (e *CT_PosH) GetOrChangeToAlign() *CT_PosAlign {
+	child := e.Align()
+	if child != nil {
+		return child
+	}
+	e.RemovePos()
+	return e.addAlign()
+}
+
+// addAlign adds a new <wp:align> in correct sequence.
+func (e *CT_PosH) addAlign() *CT_PosAlign {
+	child := e.newAlign()
+	e.insertAlign(child)
+	return child
+}
+
+// newAlign creates a detached <wp:align> element.
+func (e *CT_PosH) newAlign() *CT_PosAlign {
+	el := OxmlElement("wp:align")
+	return &CT_PosAlign{Element{E: el}}
+}
+
+// insertAlign inserts child before first successor.
+func (e *CT_PosH) insertAlign(child *CT_PosAlign) *CT_PosAlign {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// PosOffset returns the <wp:posOffset> choice member, or nil if not present.
+func (e *CT_PosH) PosOffset() *CT_PosOffset {
+	child := e.FindChild("wp:posOffset")
+	if child == nil {
+		return nil
+	}
+	return &CT_PosOffset{Element{E: child}}
+}
+
+// GetOrChangeToPosOffset returns the <wp:posOffset> child, replacing any other
+// group element if found.
+func (e *CT_PosH) GetOrChangeToPosOffset() *CT_PosOffset {
+	child := e.PosOffset()
+	if child != nil {
+		return child
+	}
+	e.RemovePos()
+	return e.addPosOffset()
+}
+
+// addPosOffset adds a new <wp:posOffset> in correct sequence.
+func (e *CT_PosH) addPosOffset() *CT_PosOffset {
+	child := e.newPosOffset()
+	e.insertPosOffset(child)
+	return child
+}
+
+// newPosOffset creates a detached <wp:posOffset> element.
+func (e *CT_PosH) newPosOffset() *CT_PosOffset {
+	el := OxmlElement("wp:posOffset")
+	return &CT_PosOffset{Element{E: el}}
+}
+
+// insertPosOffset inserts child before first successor.
+func (e *CT_PosH) insertPosOffset(child *CT_PosOffset) *CT_PosOffset {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// --- CT_PosV ---
+
+// CT_PosV — vertical position of a floating shape
+type CT_PosV struct {
+	Element
+}
+
+// RelativeFrom returns the value of the required "relativeFrom" attribute.
+func (e *CT_PosV) RelativeFrom() (enum.WdRelativeVerticalPosition, error) {
+	val, ok := e.GetAttr("relativeFrom")
+	if !ok {
+		return enum.WdRelativeVerticalPosition(0), fmt.Errorf("required attribute %q not present on <%s>", "relativeFrom", e.Tag())
+	}
+	return mustParseEnum(val, enum.WdRelativeVerticalPositionFromXml), nil
+}
+
+// SetRelativeFrom sets the required "relativeFrom" attribute.
+func (e *CT_PosV) SetRelativeFrom(v enum.WdRelativeVerticalPosition) {
+	e.SetAttr("relativeFrom", v.ToXml())
+}
+
+// Pos returns the child element belonging to this choice group,
+// or nil if no member child is present.
+func (e *CT_PosV) Pos() *Element {
+	child := e.FirstChildIn("wp:align", "wp:posOffset")
+	if child == nil {
+		return nil
+	}
+	return &Element{E: child}
+}
+
+// RemovePos removes the current choice group child element if present.
+func (e *CT_PosV) RemovePos() {
+	e.RemoveAll("wp:align", "wp:posOffset")
+}
+
+// Align returns the <wp:align> choice member, or nil if not present.
+func (e *CT_PosV) Align() *CT_PosAlign {
+	child := e.FindChild("wp:align")
+	if child == nil {
+		return nil
+	}
+	return &CT_PosAlign{Element{E: child}}
+}
+
+// GetOrChangeToAlign returns the <wp:align> child, replacing any other
+// group element if found.
+func (e *CT_PosV) GetOrChangeToAlign() *CT_PosAlign {
+	child := e.Align()
+	if child != nil {
+		return child
+	}
+	e.RemovePos()
+	return e.addAlign()
+}
+
+// addAlign adds a new <wp:align> in correct sequence.
+func (e *CT_PosV) addAlign() *CT_PosAlign {
+	child := e.newAlign()
+	e.insertAlign(child)
+	return child
+}
+
+// newAlign creates a detached <wp:align> element.
+func (e *CT_PosV) newAlign() *CT_PosAlign {
+	el := OxmlElement("wp:align")
+	return &CT_PosAlign{Element{E: el}}
+}
+
+// insertAlign inserts child before first successor.
+func (e *CT_PosV) insertAlign(child *CT_PosAlign) *CT_PosAlign {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// PosOffset returns the <wp:posOffset> choice member, or nil if not present.
+func (e *CT_PosV) PosOffset() *CT_PosOffset {
+	child := e.FindChild("wp:posOffset")
+	if child == nil {
+		return nil
+	}
+	return &CT_PosOffset{Element{E: child}}
+}
+
+// GetOrChangeToPosOffset returns the <wp:posOffset> child, replacing any other
+// group element if found.
+func (e *CT_PosV) GetOrChangeToPosOffset() *CT_PosOffset {
+	child := e.PosOffset()
+	if child != nil {
+		return child
+	}
+	e.RemovePos()
+	return e.addPosOffset()
+}
+
+// addPosOffset adds a new <wp:posOffset> in correct sequence.
+func (e *CT_PosV) addPosOffset() *CT_PosOffset {
+	child := e.newPosOffset()
+	e.insertPosOffset(child)
+	return child
+}
+
+// newPosOffset creates a detached <wp:posOffset> element.
+func (e *CT_PosV) newPosOffset() *CT_PosOffset {
+	el := OxmlElement("wp:posOffset")
+	return &CT_PosOffset{Element{E: el}}
+}
+
+// insertPosOffset inserts child before first successor.
+func (e *CT_PosV) insertPosOffset(child *CT_PosOffset) *CT_PosOffset {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// --- CT_PosAlign ---
+
+// CT_PosAlign — relative alignment of a floating shape; the value is the element text
+type CT_PosAlign struct {
+	Element
+}
+
+// --- CT_PosOffset ---
+
+// CT_PosOffset — absolute offset of a floating shape in EMU; the value is the element text
+type CT_PosOffset struct {
+	Element
+}
+
+// --- CT_EffectExtent ---
+
+// CT_EffectExtent — extra extent added to each edge of a shape for effects
+type CT_EffectExtent struct {
+	Element
+}
+
+// L returns the value of the required "l" attribute.
+func (e *CT_EffectExtent) L() (int64, error) {
+	val, ok := e.GetAttr("l")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "l", e.Tag())
+	}
+	return parseInt64Attr(val), nil
+}
+
+// SetL sets the required "l" attribute.
+func (e *CT_EffectExtent) SetL(v int64) {
+	e.SetAttr("l", formatInt64Attr(v))
+}
+
+// T returns the value of the required "t" attribute.
+func (e *CT_EffectExtent) T() (int64, error) {
+	val, ok := e.GetAttr("t")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "t", e.Tag())
+	}
+	return parseInt64Attr(val), nil
+}
+
+// SetT sets the required "t" attribute.
+func (e *CT_EffectExtent) SetT(v int64) {
+	e.SetAttr("t", formatInt64Attr(v))
+}
+
+// R returns the value of the required "r" attribute.
+func (e *CT_EffectExtent) R() (int64, error) {
+	val, ok := e.GetAttr("r")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "r", e.Tag())
+	}
+	return parseInt64Attr(val), nil
+}
+
+// SetR sets the required "r" attribute.
+func (e *CT_EffectExtent) SetR(v int64) {
+	e.SetAttr("r", formatInt64Attr(v))
+}
+
+// B returns the value of the required "b" attribute.
+func (e *CT_EffectExtent) B() (int64, error) {
+	val, ok := e.GetAttr("b")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "b", e.Tag())
+	}
+	return parseInt64Attr(val), nil
+}
+
+// SetB sets the required "b" attribute.
+func (e *CT_EffectExtent) SetB(v int64) {
+	e.SetAttr("b", formatInt64Attr(v))
+}
+
+// --- CT_WrapNone ---
+
+// CT_WrapNone — no text wrapping; the shape is in front of or behind the text
+type CT_WrapNone struct {
+	Element
+}
+
+// --- CT_WrapSquare ---
+
+// CT_WrapSquare — square text wrapping around the shape's bounding box
+type CT_WrapSquare struct {
+	Element
+}
+
+// DistT returns the value of the "distT" attribute, or 0 if absent.
+func (e *CT_WrapSquare) DistT() int64 {
+	val, ok := e.GetAttr("distT")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetDistT sets the "distT" attribute.
+// Passing 0 removes it.
+func (e *CT_WrapSquare) SetDistT(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distT")
+		return
+	}
+	e.SetAttr("distT", formatInt64Attr(v))
+}
+
+// DistB returns the value of the "distB" attribute, or 0 if absent.
+func (e *CT_WrapSquare) DistB() int64 {
+	val, ok := e.GetAttr("distB")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetDistB sets the "distB" attribute.
+// Passing 0 removes it.
+func (e *CT_WrapSquare) SetDistB(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distB")
+		return
+	}
+	e.SetAttr("distB", formatInt64Attr(v))
+}
+
+// DistL returns the value of the "distL" attribute, or 0 if absent.
+func (e *CT_WrapSquare) DistL() int64 {
+	val, ok := e.GetAttr("distL")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetDistL sets the "distL" attribute.
+// Passing 0 removes it.
+func (e *CT_WrapSquare) SetDistL(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distL")
+		return
+	}
+	e.SetAttr("distL", formatInt64Attr(v))
+}
+
+// DistR returns the value of the "distR" attribute, or 0 if absent.
+func (e *CT_WrapSquare) DistR() int64 {
+	val, ok := e.GetAttr("distR")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetDistR sets the "distR" attribute.
+// Passing 0 removes it.
+func (e *CT_WrapSquare) SetDistR(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distR")
+		return
+	}
+	e.SetAttr("distR", formatInt64Attr(v))
+}
+
+// WrapText returns the value of the required "wrapText" attribute.
+func (e *CT_WrapSquare) WrapText() (string, error) {
+	val, ok := e.GetAttr("wrapText")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "wrapText", e.Tag())
+	}
+	return val, nil
+}
+
+// SetWrapText sets the required "wrapText" attribute.
+func (e *CT_WrapSquare) SetWrapText(v string) {
+	e.SetAttr("wrapText", v)
+}
+
+// --- CT_WrapTight ---
+
+// CT_WrapTight — tight text wrapping around the shape's wrap polygon
+type CT_WrapTight struct {
+	Element
+}
+
+// WrapPolygon returns the required <wp:wrapPolygon> child element.
+// Panics if not present (invalid XML).
+func (e *CT_WrapTight) WrapPolygon() *CT_WrapPath {
+	child := e.FindChild("wp:wrapPolygon")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "wp:wrapPolygon", e.Tag()))
+	}
+	return &CT_WrapPath{Element{E: child}}
+}
+
+// DistL returns the value of the "distL" attribute, or 0 if absent.
+func (e *CT_WrapTight) DistL() int64 {
+	val, ok := e.GetAttr("distL")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetDistL sets the "distL" attribute.
+// Passing 0 removes it.
+func (e *CT_WrapTight) SetDistL(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distL")
+		return
+	}
+	e.SetAttr("distL", formatInt64Attr(v))
+}
+
+// DistR returns the value of the "distR" attribute, or 0 if absent.
+func (e *CT_WrapTight) DistR() int64 {
+	val, ok := e.GetAttr("distR")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetDistR sets the "distR" attribute.
+// Passing 0 removes it.
+func (e *CT_WrapTight) SetDistR(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distR")
+		return
+	}
+	e.SetAttr("distR", formatInt64Attr(v))
+}
+
+// WrapText returns the value of the required "wrapText" attribute.
+func (e *CT_WrapTight) WrapText() (string, error) {
+	val, ok := e.GetAttr("wrapText")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "wrapText", e.Tag())
+	}
+	return val, nil
+}
+
+// SetWrapText sets the required "wrapText" attribute.
+func (e *CT_WrapTight) SetWrapText(v string) {
+	e.SetAttr("wrapText", v)
+}
+
+// --- CT_WrapThrough ---
+
+// CT_WrapThrough — through text wrapping, filling open areas of the wrap polygon
+type CT_WrapThrough struct {
+	Element
+}
+
+// WrapPolygon returns the required <wp:wrapPolygon> child element.
+// Panics if not present (invalid XML).
+func (e *CT_WrapThrough) WrapPolygon() *CT_WrapPath {
+	child := e.FindChild("wp:wrapPolygon")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "wp:wrapPolygon", e.Tag()))
+	}
+	return &CT_WrapPath{Element{E: child}}
+}
+
+// DistL returns the value of the "distL" attribute, or 0 if absent.
+func (e *CT_WrapThrough) DistL() int64 {
+	val, ok := e.GetAttr("distL")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetDistL sets the "distL" attribute.
+// Passing 0 removes it.
+func (e *CT_WrapThrough) SetDistL(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distL")
+		return
+	}
+	e.SetAttr("distL", formatInt64Attr(v))
+}
+
+// DistR returns the value of the "distR" attribute, or 0 if absent.
+func (e *CT_WrapThrough) DistR() int64 {
+	val, ok := e.GetAttr("distR")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetDistR sets the "distR" attribute.
+// Passing 0 removes it.
+func (e *CT_WrapThrough) SetDistR(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distR")
+		return
+	}
+	e.SetAttr("distR", formatInt64Attr(v))
+}
+
+// WrapText returns the value of the required "wrapText" attribute.
+func (e *CT_WrapThrough) WrapText() (string, error) {
+	val, ok := e.GetAttr("wrapText")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "wrapText", e.Tag())
+	}
+	return val, nil
+}
+
+// SetWrapText sets the required "wrapText" attribute.
+func (e *CT_WrapThrough) SetWrapText(v string) {
+	e.SetAttr("wrapText", v)
+}
+
+// --- CT_WrapTopBottom ---
+
+// CT_WrapTopBottom — top and bottom text wrapping
+type CT_WrapTopBottom struct {
+	Element
+}
+
+// DistT returns the value of the "distT" attribute, or 0 if absent.
+func (e *CT_WrapTopBottom) DistT() int64 {
+	val, ok := e.GetAttr("distT")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetDistT sets the "distT" attribute.
+// Passing 0 removes it.
+func (e *CT_WrapTopBottom) SetDistT(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distT")
+		return
+	}
+	e.SetAttr("distT", formatInt64Attr(v))
+}
+
+// DistB returns the value of the "distB" attribute, or 0 if absent.
+func (e *CT_WrapTopBottom) DistB() int64 {
+	val, ok := e.GetAttr("distB")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetDistB sets the "distB" attribute.
+// Passing 0 removes it.
+func (e *CT_WrapTopBottom) SetDistB(v int64) {
+	if v == 0 {
+		e.RemoveAttr("distB")
+		return
+	}
+	e.SetAttr("distB", formatInt64Attr(v))
+}
+
+// --- CT_WrapPath ---
+
+// CT_WrapPath — wrap polygon in 21600ths of the shape extent
+type CT_WrapPath struct {
+	Element
+}
+
+// Start returns the required <wp:start> child element.
+// Panics if not present (invalid XML).
+func (e *CT_WrapPath) Start() *CT_Point2D {
+	child := e.FindChild("wp:start")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "wp:start", e.Tag()))
+	}
+	return &CT_Point2D{Element{E: child}}
+}
+
+// LineToList returns all <wp:lineTo> child elements.
+// At least one must be present in valid XML.
+func (e *CT_WrapPath) LineToList() []*CT_Point2D {
+	children := e.FindAllChildren("wp:lineTo")
+	result := make([]*CT_Point2D, len(children))
+	for i, c := range children {
+		result[i] = &CT_Point2D{Element{E: c}}
+	}
+	return result
+}
+
+// AddLineTo adds a new <wp:lineTo> in correct sequence.
+func (e *CT_WrapPath) AddLineTo() *CT_Point2D {
+	return e.addLineTo()
+}
+
+// addLineTo adds a new <wp:lineTo> unconditionally in correct sequence.
+func (e *CT_WrapPath) addLineTo() *CT_Point2D {
+	child := e.newLineTo()
+	e.insertLineTo(child)
+	return child
+}
+
+// newLineTo creates a detached <wp:lineTo> element.
+func (e *CT_WrapPath) newLineTo() *CT_Point2D {
+	el := OxmlElement("wp:lineTo")
+	return &CT_Point2D{Element{E: el}}
+}
+
+// insertLineTo inserts child before first successor.
+func (e *CT_WrapPath) insertLineTo(child *CT_Point2D) *CT_Point2D {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// Edited returns the value of the "edited" attribute, or false if absent.
+func (e *CT_WrapPath) Edited() bool {
+	val, ok := e.GetAttr("edited")
+	if !ok {
+		return false
+	}
+	return parseBoolAttr(val)
+}
+
+// SetEdited sets the "edited" attribute.
+// Passing false removes it.
+func (e *CT_WrapPath) SetEdited(v bool) {
+	if v == false {
+		e.RemoveAttr("edited")
+		return
+	}
+	e.SetAttr("edited", formatBoolAttr(v))
+}
+
+// --- CT_Picture ---
+
+// CT_Picture — DrawingML picture element
+type CT_Picture struct {
+	Element
+}
+
+// NvPicPr returns the required <pic:nvPicPr> child element.
+// Panics if not present (invalid XML).
+func (e *CT_Picture) NvPicPr() *CT_PictureNonVisual {
+	child := e.FindChild("pic:nvPicPr")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "pic:nvPicPr", e.Tag()))
+	}
+	return &CT_PictureNonVisual{Element{E: child}}
+}
+
+// BlipFill returns the required <pic:blipFill> child element.
+// Panics if not present (invalid XML).
+func (e *CT_Picture) BlipFill() *CT_BlipFillProperties {
+	child := e.FindChild("pic:blipFill")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "pic:blipFill", e.Tag()))
+	}
+	return &CT_BlipFillProperties{Element{E: child}}
+}
+
+// SpPr returns the required <pic:spPr> child element.
+// Panics if not present (invalid XML).
+func (e *CT_Picture) SpPr() *CT_ShapeProperties {
+	child := e.FindChild("pic:spPr")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "pic:spPr", e.Tag()))
+	}
+	return &CT_ShapeProperties{Element{E: child}}
+}
+
+// --- CT_PictureNonVisual ---
+
+// CT_PictureNonVisual — non-visual picture properties element
+type CT_PictureNonVisual struct {
+	Element
+}
+
+// CNvPr returns the required <pic:cNvPr> child element.
+// Panics if not present (invalid XML).
+func (e *CT_PictureNonVisual) CNvPr() *CT_NonVisualDrawingProps {
+	child := e.FindChild("pic:cNvPr")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "pic:cNvPr", e.Tag()))
+	}
+	return &CT_NonVisualDrawingProps{Element{E: child}}
+}
+
+// --- CT_NonVisualDrawingProps ---
+
+// CT_NonVisualDrawingProps — non-visual drawing properties element
+type CT_NonVisualDrawingProps struct {
+	Element
+}
+
+// Id returns the value of the required "id" attribute.
+func (e *CT_NonVisualDrawingProps) Id() (int, error) {
+	val, ok := e.GetAttr("id")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "id", e.Tag())
+	}
+	return parseIntAttr(val), nil
+}
+
+// SetId sets the required "id" attribute.
+func (e *CT_NonVisualDrawingProps) SetId(v int) {
+	e.SetAttr("id", formatIntAttr(v))
+}
+
+// Name returns the value of the required "name" attribute.
+func (e *CT_NonVisualDrawingProps) Name() (string, error) {
+	val, ok := e.GetAttr("name")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "name", e.Tag())
+	}
+	return val, nil
+}
+
+// SetName sets the required "name" attribute.
+func (e *CT_NonVisualDrawingProps) SetName(v string) {
+	e.SetAttr("name", v)
+}
+
+// --- CT_NonVisualPictureProperties ---
+
+// CT_NonVisualPictureProperties — non-visual picture-specific properties
+type CT_NonVisualPictureProperties struct {
+	Element
+}
+
+// --- CT_GraphicalObject ---
+
+// CT_GraphicalObject — graphical object element
+type CT_GraphicalObject struct {
+	Element
+}
+
+// GraphicData returns the required <a:graphicData> child element.
+// Panics if not present (invalid XML).
+func (e *CT_GraphicalObject) GraphicData() *CT_GraphicalObjectData {
+	child := e.FindChild("a:graphicData")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "a:graphicData", e.Tag()))
+	}
+	return &CT_GraphicalObjectData{Element{E: child}}
+}
+
+// --- CT_GraphicalObjectData ---
+
+// CT_GraphicalObjectData — graphical object data element
+type CT_GraphicalObjectData struct {
+	Element
+}
+
+// Pic returns the <pic:pic> child element, or nil if not present.
+func (e *CT_GraphicalObjectData) Pic() *CT_Picture {
+	child := e.FindChild("pic:pic")
+	if child == nil {
+		return nil
+	}
+	return &CT_Picture{Element{E: child}}
+}
+
+// GetOrAddPic returns <pic:pic>, creating it if not present.
+func (e *CT_GraphicalObjectData) GetOrAddPic() *CT_Picture {
+	child := e.Pic()
+	if child != nil {
+		return child
+	}
+	return e.addPic()
+}
+
+// RemovePic removes all <pic:pic> child elements.
+func (e *CT_GraphicalObjectData) RemovePic() {
+	e.RemoveAll("pic:pic")
+}
+
+// addPic adds a new <pic:pic> in correct sequence.
+func (e *CT_GraphicalObjectData) addPic() *CT_Picture {
+	child := e.newPic()
+	e.insertPic(child)
+	return child
+}
+
+// newPic creates a detached <pic:pic> element.
+func (e *CT_GraphicalObjectData) newPic() *CT_Picture {
+	el := OxmlElement("pic:pic")
+	return &CT_Picture{Element{E: el}}
+}
+
+// insertPic inserts child before first successor.
+func (e *CT_GraphicalObjectData) insertPic(child *CT_Picture) *CT_Picture {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// Uri returns the value of the required "uri" attribute.
+func (e *CT_GraphicalObjectData) Uri() (string, error) {
+	val, ok := e.GetAttr("uri")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "uri", e.Tag())
+	}
+	return val, nil
+}
+
+// SetUri sets the required "uri" attribute.
+func (e *CT_GraphicalObjectData) SetUri(v string) {
+	e.SetAttr("uri", v)
+}
+
+// --- CT_BlipFillProperties ---
+
+// CT_BlipFillProperties — blip fill properties element
+type CT_BlipFillProperties struct {
+	Element
+}
+
+// Blip returns the <a:blip> child element, or nil if not present.
+func (e *CT_BlipFillProperties) Blip() *CT_Blip {
+	child := e.FindChild("a:blip")
+	if child == nil {
+		return nil
+	}
+	return &CT_Blip{Element{E: child}}
+}
+
+// GetOrAddBlip returns <a:blip>, creating it if not present.
+func (e *CT_BlipFillProperties) GetOrAddBlip() *CT_Blip {
+	child := e.Blip()
+	if child != nil {
+		return child
+	}
+	return e.addBlip()
+}
+
+// RemoveBlip removes all <a:blip> child elements.
+func (e *CT_BlipFillProperties) RemoveBlip() {
+	e.RemoveAll("a:blip")
+}
+
+// addBlip adds a new <a:blip> in correct sequence.
+func (e *CT_BlipFillProperties) addBlip() *CT_Blip {
+	child := e.newBlip()
+	e.insertBlip(child)
+	return child
+}
+
+// newBlip creates a detached <a:blip> element.
+func (e *CT_BlipFillProperties) newBlip() *CT_Blip {
+	el := OxmlElement("a:blip")
+	return &CT_Blip{Element{E: el}}
+}
+
+// insertBlip inserts child before first successor.
+func (e *CT_BlipFillProperties) insertBlip(child *CT_Blip) *CT_Blip {
+	e.InsertElementBefore(child.E, "a:srcRect", "a:tile", "a:stretch")
+	return child
+}
+
+// --- CT_Blip ---
+
+// CT_Blip — blip element specifying image source
+type CT_Blip struct {
+	Element
+}
+
+// Embed returns the value of the "r:embed" attribute, or "" if absent.
+func (e *CT_Blip) Embed() string {
+	val, ok := e.GetAttr("r:embed")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetEmbed sets the "r:embed" attribute.
+// Passing "" removes it.
+func (e *CT_Blip) SetEmbed(v string) {
+	if v == "" {
+		e.RemoveAttr("r:embed")
+		return
+	}
+	e.SetAttr("r:embed", v)
+}
+
+// Link returns the value of the "r:link" attribute, or "" if absent.
+func (e *CT_Blip) Link() string {
+	val, ok := e.GetAttr("r:link")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetLink sets the "r:link" attribute.
+// Passing "" removes it.
+func (e *CT_Blip) SetLink(v string) {
+	if v == "" {
+		e.RemoveAttr("r:link")
+		return
+	}
+	e.SetAttr("r:link", v)
+}
+
+// --- CT_ShapeProperties ---
+
+// CT_ShapeProperties — shape properties element
+type CT_ShapeProperties struct {
+	Element
+}
+
+// Xfrm returns the <a:xfrm> child element, or nil if not present.
+func (e *CT_ShapeProperties) Xfrm() *CT_Transform2D {
+	child := e.FindChild("a:xfrm")
+	if child == nil {
+		return nil
+	}
+	return &CT_Transform2D{Element{E: child}}
+}
+
+// GetOrAddXfrm returns <a:xfrm>, creating it if not present.
+func (e *CT_ShapeProperties) GetOrAddXfrm() *CT_Transform2D {
+	child := e.Xfrm()
+	if child != nil {
+		return child
+	}
+	return e.addXfrm()
+}
+
+// RemoveXfrm removes all <a:xfrm> child elements.
+func (e *CT_ShapeProperties) RemoveXfrm() {
+	e.RemoveAll("a:xfrm")
+}
+
+// addXfrm adds a new <a:xfrm> in correct sequence.
+func (e *CT_ShapeProperties) addXfrm() *CT_Transform2D {
+	child := e.newXfrm()
+	e.insertXfrm(child)
+	return child
+}
+
+// newXfrm creates a detached <a:xfrm> element.
+func (e *CT_ShapeProperties) newXfrm() *CT_Transform2D {
+	el := OxmlElement("a:xfrm")
+	return &CT_Transform2D{Element{E: el}}
+}
+
+// insertXfrm inserts child before first successor.
+func (e *CT_ShapeProperties) insertXfrm(child *CT_Transform2D) *CT_Transform2D {
+	e.InsertElementBefore(child.E, "a:custGeom", "a:prstGeom", "a:noFill", "a:solidFill", "a:gradFill", "a:blipFill", "a:pattFill", "a:grpFill", "a:ln", "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst")
+	return child
+}
+
+// PrstGeom returns the <a:prstGeom> child element, or nil if not present.
+func (e *CT_ShapeProperties) PrstGeom() *CT_PresetGeometry2D {
+	child := e.FindChild("a:prstGeom")
+	if child == nil {
+		return nil
+	}
+	return &CT_PresetGeometry2D{Element{E: child}}
+}
+
+// GetOrAddPrstGeom returns <a:prstGeom>, creating it if not present.
+func (e *CT_ShapeProperties) GetOrAddPrstGeom() *CT_PresetGeometry2D {
+	child := e.PrstGeom()
+	if child != nil {
+		return child
+	}
+	return e.addPrstGeom()
+}
+
+// RemovePrstGeom removes all <a:prstGeom> child elements.
+func (e *CT_ShapeProperties) RemovePrstGeom() {
+	e.RemoveAll("a:prstGeom")
+}
+
+// addPrstGeom adds a new <a:prstGeom> in correct sequence.
+func (e *CT_ShapeProperties) addPrstGeom() *CT_PresetGeometry2D {
+	child := e.newPrstGeom()
+	e.insertPrstGeom(child)
+	return child
+}
+
+// newPrstGeom creates a detached <a:prstGeom> element.
+func (e *CT_ShapeProperties) newPrstGeom() *CT_PresetGeometry2D {
+	el := OxmlElement("a:prstGeom")
+	return &CT_PresetGeometry2D{Element{E: el}}
+}
+
+// insertPrstGeom inserts child before first successor.
+func (e *CT_ShapeProperties) insertPrstGeom(child *CT_PresetGeometry2D) *CT_PresetGeometry2D {
+	e.InsertElementBefore(child.E, "a:noFill", "a:solidFill", "a:gradFill", "a:blipFill", "a:pattFill", "a:grpFill", "a:ln", "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst")
+	return child
+}
+
+// Ln returns the <a:ln> child element, or nil if not present.
+func (e *CT_ShapeProperties) Ln() *CT_LineProperties {
+	child := e.FindChild("a:ln")
+	if child == nil {
+		return nil
+	}
+	return &CT_LineProperties{Element{E: child}}
+}
+
+// GetOrAddLn returns <a:ln>, creating it if not present.
+func (e *CT_ShapeProperties) GetOrAddLn() *CT_LineProperties {
+	child := e.Ln()
+	if child != nil {
+		return child
+	}
+	return e.addLn()
+}
+
+// RemoveLn removes all <a:ln> child elements.
+func (e *CT_ShapeProperties) RemoveLn() {
+	e.RemoveAll("a:ln")
+}
+
+// addLn adds a new <a:ln> in correct sequence.
+func (e *CT_ShapeProperties) addLn() *CT_LineProperties {
+	child := e.newLn()
+	e.insertLn(child)
+	return child
+}
+
+// newLn creates a detached <a:ln> element.
+func (e *CT_ShapeProperties) newLn() *CT_LineProperties {
+	el := OxmlElement("a:ln")
+	return &CT_LineProperties{Element{E: el}}
+}
+
+// insertLn inserts child before first successor.
+func (e *CT_ShapeProperties) insertLn(child *CT_LineProperties) *CT_LineProperties {
+	e.InsertElementBefore(child.E, "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst")
+	return child
+}
+
+// Fill returns the child element belonging to this choice group,
+// or nil if no member child is present.
+func (e *CT_ShapeProperties) Fill() *Element {
+	child := e.FirstChildIn("a:noFill", "a:solidFill")
+	if child == nil {
+		return nil
+	}
+	return &Element{E: child}
+}
+
+// RemoveFill removes the current choice group child element if present.
+func (e *CT_ShapeProperties) RemoveFill() {
+	e.RemoveAll("a:noFill", "a:solidFill")
+}
+
+// NoFill returns the <a:noFill> choice member, or nil if not present.
+func (e *CT_ShapeProperties) NoFill() *CT_NoFillProperties {
+	child := e.FindChild("a:noFill")
+	if child == nil {
+		return nil
+	}
+	return &CT_NoFillProperties{Element{E: child}}
+}
+
+// GetOrChangeToNoFill returns the <a:noFill> child, replacing any other
+// group element if found.
+func (e *CT_ShapeProperties) GetOrChangeToNoFill() *CT_NoFillProperties {
+	child := e.NoFill()
+	if child != nil {
+		return child
+	}
+	e.RemoveFill()
+	return e.addNoFill()
+}
+
+// addNoFill adds a new <a:noFill> in correct sequence.
+func (e *CT_ShapeProperties) addNoFill() *CT_NoFillProperties {
+	child := e.newNoFill()
+	e.insertNoFill(child)
+	return child
+}
+
+// newNoFill creates a detached <a:noFill> element.
+func (e *CT_ShapeProperties) newNoFill() *CT_NoFillProperties {
+	el := OxmlElement("a:noFill")
+	return &CT_NoFillProperties{Element{E: el}}
+}
+
+// insertNoFill inserts child before first successor.
+func (e *CT_ShapeProperties) insertNoFill(child *CT_NoFillProperties) *CT_NoFillProperties {
+	e.InsertElementBefore(child.E, "a:ln", "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst")
+	return child
+}
+
+// SolidFill returns the <a:solidFill> choice member, or nil if not present.
+func (e *CT_ShapeProperties) SolidFill() *CT_SolidColorFillProperties {
+	child := e.FindChild("a:solidFill")
+	if child == nil {
+		return nil
+	}
+	return &CT_SolidColorFillProperties{Element{E: child}}
+}
+
+// GetOrChangeToSolidFill returns the <a:solidFill> child, replacing any other
+// group element if found.
+func (e *CT_ShapeProperties) GetOrChangeToSolidFill() *CT_SolidColorFillProperties {
+	child := e.SolidFill()
+	if child != nil {
+		return child
+	}
+	e.RemoveFill()
+	return e.addSolidFill()
+}
+
+// addSolidFill adds a new <a:solidFill> in correct sequence.
+func (e *CT_ShapeProperties) addSolidFill() *CT_SolidColorFillProperties {
+	child := e.newSolidFill()
+	e.insertSolidFill(child)
+	return child
+}
+
+// newSolidFill creates a detached <a:solidFill> element.
+func (e *CT_ShapeProperties) newSolidFill() *CT_SolidColorFillProperties {
+	el := OxmlElement("a:solidFill")
+	return &CT_SolidColorFillProperties{Element{E: el}}
+}
+
+// insertSolidFill inserts child before first successor.
+func (e *CT_ShapeProperties) insertSolidFill(child *CT_SolidColorFillProperties) *CT_SolidColorFillProperties {
+	e.InsertElementBefore(child.E, "a:ln", "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst")
+	return child
+}
+
+// --- CT_Transform2D ---
+
+// CT_Transform2D — 2D transform element
+type CT_Transform2D struct {
+	Element
+}
+
+// Off returns the <a:off> child element, or nil if not present.
+func (e *CT_Transform2D) Off() *CT_Point2D {
+	child := e.FindChild("a:off")
+	if child == nil {
+		return nil
+	}
+	return &CT_Point2D{Element{E: child}}
+}
+
+// GetOrAddOff returns <a:off>, creating it if not present.
+func (e *CT_Transform2D) GetOrAddOff() *CT_Point2D {
+	child := e.Off()
+	if child != nil {
+		return child
+	}
+	return e.addOff()
+}
+
+// RemoveOff removes all <a:off> child elements.
+func (e *CT_Transform2D) RemoveOff() {
+	e.RemoveAll("a:off")
+}
+
+// addOff adds a new <a:off> in correct sequence.
+func (e *CT_Transform2D) addOff() *CT_Point2D {
+	child := e.newOff()
+	e.insertOff(child)
+	return child
+}
+
+// newOff creates a detached <a:off> element.
+func (e *CT_Transform2D) newOff() *CT_Point2D {
+	el := OxmlElement("a:off")
+	return &CT_Point2D{Element{E: el}}
+}
+
+// insertOff inserts child before first successor.
+func (e *CT_Transform2D) insertOff(child *CT_Point2D) *CT_Point2D {
+	e.InsertElementBefore(child.E, "a:ext")
+	return child
+}
+
+// Ext returns the <a:ext> child element, or nil if not present.
+func (e *CT_Transform2D) Ext() *CT_PositiveSize2D {
+	child := e.FindChild("a:ext")
+	if child == nil {
+		return nil
+	}
+	return &CT_PositiveSize2D{Element{E: child}}
+}
+
+// GetOrAddExt returns <a:ext>, creating it if not present.
+func (e *CT_Transform2D) GetOrAddExt() *CT_PositiveSize2D {
+	child := e.Ext()
+	if child != nil {
+		return child
+	}
+	return e.addExt()
+}
+
+// RemoveExt removes all <a:ext> child elements.
+func (e *CT_Transform2D) RemoveExt() {
+	e.RemoveAll("a:ext")
+}
+
+// addExt adds a new <a:ext> in correct sequence.
+func (e *CT_Transform2D) addExt() *CT_PositiveSize2D {
+	child := e.newExt()
+	e.insertExt(child)
+	return child
+}
+
+// newExt creates a detached <a:ext> element.
+func (e *CT_Transform2D) newExt() *CT_PositiveSize2D {
+	el := OxmlElement("a:ext")
+	return &CT_PositiveSize2D{Element{E: el}}
+}
+
+// insertExt inserts child before first successor.
+func (e *CT_Transform2D) insertExt(child *CT_PositiveSize2D) *CT_PositiveSize2D {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// --- CT_PositiveSize2D ---
+
+// CT_PositiveSize2D — positive size 2D element
+type CT_PositiveSize2D struct {
+	Element
+}
+
+// Cx returns the value of the required "cx" attribute.
+func (e *CT_PositiveSize2D) Cx() (int64, error) {
+	val, ok := e.GetAttr("cx")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "cx", e.Tag())
+	}
+	return parseInt64Attr(val), nil
+}
+
+// SetCx sets the required "cx" attribute.
+func (e *CT_PositiveSize2D) SetCx(v int64) {
+	e.SetAttr("cx", formatInt64Attr(v))
+}
+
+// Cy returns the value of the required "cy" attribute.
+func (e *CT_PositiveSize2D) Cy() (int64, error) {
+	val, ok := e.GetAttr("cy")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "cy", e.Tag())
+	}
+	return parseInt64Attr(val), nil
+}
+
+// SetCy sets the required "cy" attribute.
+func (e *CT_PositiveSize2D) SetCy(v int64) {
+	e.SetAttr("cy", formatInt64Attr(v))
+}
+
+// --- CT_Point2D ---
+
+// CT_Point2D — point 2D element
+type CT_Point2D struct {
+	Element
+}
+
+// X returns the value of the required "x" attribute.
+func (e *CT_Point2D) X() (int64, error) {
+	val, ok := e.GetAttr("x")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "x", e.Tag())
+	}
+	return parseInt64Attr(val), nil
+}
+
+// SetX sets the required "x" attribute.
+func (e *CT_Point2D) SetX(v int64) {
+	e.SetAttr("x", formatInt64Attr(v))
+}
+
+// Y returns the value of the required "y" attribute.
+func (e *CT_Point2D) Y() (int64, error) {
+	val, ok := e.GetAttr("y")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "y", e.Tag())
+	}
+	return parseInt64Attr(val), nil
+}
+
+// SetY sets the required "y" attribute.
+func (e *CT_Point2D) SetY(v int64) {
+	e.SetAttr("y", formatInt64Attr(v))
+}
+
+// --- CT_PresetGeometry2D ---
+
+// CT_PresetGeometry2D — preset geometry element
+type CT_PresetGeometry2D struct {
+	Element
+}
+
+// AvLst returns the <a:avLst> child element, or nil if not present.
+func (e *CT_PresetGeometry2D) AvLst() *CT_GeomGuideList {
+	child := e.FindChild("a:avLst")
+	if child == nil {
+		return nil
+	}
+	return &CT_GeomGuideList{Element{E: child}}
+}
+
+// GetOrAddAvLst returns <a:avLst>, creating it if not present.
+func (e *CT_PresetGeometry2D) GetOrAddAvLst() *CT_GeomGuideList {
+	child := e.AvLst()
+	if child != nil {
+		return child
+	}
+	return e.addAvLst()
+}
+
+// RemoveAvLst removes all <a:avLst> child elements.
+func (e *CT_PresetGeometry2D) RemoveAvLst() {
+	e.RemoveAll("a:avLst")
+}
+
+// addAvLst adds a new <a:avLst> in correct sequence.
+func (e *CT_PresetGeometry2D) addAvLst() *CT_GeomGuideList {
+	child := e.newAvLst()
+	e.insertAvLst(child)
+	return child
+}
+
+// newAvLst creates a detached <a:avLst> element.
+func (e *CT_PresetGeometry2D) newAvLst() *CT_GeomGuideList {
+	el := OxmlElement("a:avLst")
+	return &CT_GeomGuideList{Element{E: el}}
+}
+
+// insertAvLst inserts child before first successor.
+func (e *CT_PresetGeometry2D) insertAvLst(child *CT_GeomGuideList) *CT_GeomGuideList {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// Prst returns the value of the required "prst" attribute.
+func (e *CT_PresetGeometry2D) Prst() (string, error) {
+	val, ok := e.GetAttr("prst")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "prst", e.Tag())
+	}
+	return val, nil
+}
+
+// SetPrst sets the required "prst" attribute.
+func (e *CT_PresetGeometry2D) SetPrst(v string) {
+	e.SetAttr("prst", v)
+}
+
+// --- CT_GeomGuideList ---
+
+// CT_GeomGuideList — shape adjust values list
+type CT_GeomGuideList struct {
+	Element
+}
+
+// --- CT_LineProperties ---
+
+// CT_LineProperties — outline properties element
+type CT_LineProperties struct {
+	Element
+}
+
+// W returns the value of the "w" attribute, or 0 if absent.
+func (e *CT_LineProperties) W() int64 {
+	val, ok := e.GetAttr("w")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
+
+// SetW sets the "w" attribute.
+// Passing 0 removes it.
+func (e *CT_LineProperties) SetW(v int64) {
+	if v == 0 {
+		e.RemoveAttr("w")
+		return
+	}
+	e.SetAttr("w", formatInt64Attr(v))
+}
+
+// Fill returns the child element belonging to this choice group,
+// or nil if no member child is present.
+func (e *CT_LineProperties) Fill() *Element {
+	child := e.FirstChildIn("a:noFill", "a:solidFill")
+	if child == nil {
+		return nil
+	}
+	return &Element{E: child}
+}
+
+// RemoveFill removes the current choice group child element if present.
+func (e *CT_LineProperties) RemoveFill() {
+	e.RemoveAll("a:noFill", "a:solidFill")
+}
+
+// NoFill returns the <a:noFill> choice member, or nil if not present.
+func (e *CT_LineProperties) NoFill() *CT_NoFillProperties {
+	child := e.FindChild("a:noFill")
+	if child == nil {
+		return nil
+	}
+	return &CT_NoFillProperties{Element{E: child}}
+}
+
+// GetOrChangeToNoFill returns the <a:noFill> child, replacing any other
+// group element if found.
+func (e *CT_LineProperties) GetOrChangeToNoFill() *CT_NoFillProperties {
+	child := e.NoFill()
+	if child != nil {
+		return child
+	}
+	e.RemoveFill()
+	return e.addNoFill()
+}
+
+// addNoFill adds a new <a:noFill> in correct sequence.
+func (e *CT_LineProperties) addNoFill() *CT_NoFillProperties {
+	child := e.newNoFill()
+	e.insertNoFill(child)
+	return child
+}
+
+// newNoFill creates a detached <a:noFill> element.
+func (e *CT_LineProperties) newNoFill() *CT_NoFillProperties {
+	el := OxmlElement("a:noFill")
+	return &CT_NoFillProperties{Element{E: el}}
+}
+
+// insertNoFill inserts child before first successor.
+func (e *CT_LineProperties) insertNoFill(child *CT_NoFillProperties) *CT_NoFillProperties {
+	e.InsertElementBefore(child.E, "a:prstDash", "a:custDash", "a:round", "a:bevel", "a:miter", "a:headEnd", "a:tailEnd", "a:extLst")
+	return child
+}
+
+// SolidFill returns the <a:solidFill> choice member, or nil if not present.
+func (e *CT_LineProperties) SolidFill() *CT_SolidColorFillProperties {
+	child := e.FindChild("a:solidFill")
+	if child == nil {
+		return nil
+	}
+	return &CT_SolidColorFillProperties{Element{E: child}}
+}
+
+// GetOrChangeToSolidFill returns the <a:solidFill> child, replacing any other
+// group element if found.
+func (e *CT_LineProperties) GetOrChangeToSolidFill() *CT_SolidColorFillProperties {
+	child := e.SolidFill()
+	if child != nil {
+		return child
+	}
+	e.RemoveFill()
+	return e.addSolidFill()
+}
+
+// addSolidFill adds a new <a:solidFill> in correct sequence.
+func (e *CT_LineProperties) addSolidFill() *CT_SolidColorFillProperties {
+	child := e.newSolidFill()
+	e.insertSolidFill(child)
+	return child
+}
+
+// newSolidFill creates a detached <a:solidFill> element.
+func (e *CT_LineProperties) newSolidFill() *CT_SolidColorFillProperties {
+	el := OxmlElement("a:solidFill")
+	return &CT_SolidColorFillProperties{Element{E: el}}
+}
+
+// insertSolidFill inserts child before first successor.
+func (e *CT_LineProperties) insertSolidFill(child *CT_SolidColorFillProperties) *CT_SolidColorFillProperties {
+	e.InsertElementBefore(child.E, "a:prstDash", "a:custDash", "a:round", "a:bevel", "a:miter", "a:headEnd", "a:tailEnd", "a:extLst")
+	return child
+}
+
+// --- CT_NoFillProperties ---
+
+// CT_NoFillProperties — no fill element
+type CT_NoFillProperties struct {
+	Element
+}
+
+// --- CT_SolidColorFillProperties ---
+
+// CT_SolidColorFillProperties — solid color fill element
+type CT_SolidColorFillProperties struct {
+	Element
+}
+
+// Color returns the child element belonging to this choice group,
+// or nil if no member child is present.
+func (e *CT_SolidColorFillProperties) Color() *Element {
+	child := e.FirstChildIn("a:srgbClr", "a:schemeClr")
+	if child == nil {
+		return nil
+	}
+	return &Element{E: child}
+}
+
+// RemoveColor removes the current choice group child element if present.
+func (e *CT_SolidColorFillProperties) RemoveColor() {
+	e.RemoveAll("a:srgbClr", "a:schemeClr")
+}
+
+// SrgbClr returns the <a:srgbClr> choice member, or nil if not present.
+func (e *CT_SolidColorFillProperties) SrgbClr() *CT_SRgbColor {
+	child := e.FindChild("a:srgbClr")
+	if child == nil {
+		return nil
+	}
+	return &CT_SRgbColor{Element{E: child}}
+}
+
+// GetOrChangeToSrgbClr returns the <a:srgbClr> child, replacing any other
+// group element if found.
+func (e *CT_SolidColorFillProperties) GetOrChangeToSrgbClr() *CT_SRgbColor {
+	child := e.SrgbClr()
+	if child != nil {
+		return child
+	}
+	e.RemoveColor()
+	return e.addSrgbClr()
+}
+
+// addSrgbClr adds a new <a:srgbClr> in correct sequence.
+func (e *CT_SolidColorFillProperties) addSrgbClr() *CT_SRgbColor {
+	child := e.newSrgbClr()
+	e.insertSrgbClr(child)
+	return child
 }
 
-// --- CT_BlipFillProperties ---
+// newSrgbClr creates a detached <a:srgbClr> element.
+func (e *CT_SolidColorFillProperties) newSrgbClr() *CT_SRgbColor {
+	el := OxmlElement("a:srgbClr")
+	return &CT_SRgbColor{Element{E: el}}
+}
 
-// CT_BlipFillProperties — blip fill properties element
-type CT_BlipFillProperties struct {
-	Element
+// insertSrgbClr inserts child before first successor.
+func (e *CT_SolidColorFillProperties) insertSrgbClr(child *CT_SRgbColor) *CT_SRgbColor {
+	e.InsertElementBefore(child.E)
+	return child
 }
 
-// Blip returns the <a:blip> child element, or nil if not present.
-func (e *CT_BlipFillProperties) Blip() *CT_Blip {
-	child := e.FindChild("a:blip")
+// SchemeClr returns the <a:schemeClr> choice member, or nil if not present.
+func (e *CT_SolidColorFillProperties) SchemeClr() *CT_SchemeColor {
+	child := e.FindChild("a:schemeClr")
 	if child == nil {
 		return nil
 	}
-	return &CT_Blip{Element{E: child}}
+	return &CT_SchemeColor{Element{E: child}}
 }
 
-// GetOrAddBlip returns <a:blip>, creating it if not present.
-func (e *CT_BlipFillProperties) GetOrAddBlip() *CT_Blip {
-	child := e.Blip()
+// GetOrChangeToSchemeClr returns the <a:schemeClr> child, replacing any other
+// group element if found.
+func (e *CT_SolidColorFillProperties) GetOrChangeToSchemeClr() *CT_SchemeColor {
+	child := e.SchemeClr()
 	if child != nil {
 		return child
 	}
-	return e.addBlip()
+	e.RemoveColor()
+	return e.addSchemeClr()
 }
 
-// RemoveBlip removes all <a:blip> child elements.
-func (e *CT_BlipFillProperties) RemoveBlip() {
-	e.RemoveAll("a:blip")
-}
-
-// addBlip adds a new <a:blip> in correct sequence.
-func (e *CT_BlipFillProperties) addBlip() *CT_Blip {
-	child := e.newBlip()
-	e.insertBlip(child)
+// addSchemeClr adds a new <a:schemeClr> in correct sequence.
+func (e *CT_SolidColorFillProperties) addSchemeClr() *CT_SchemeColor {
+	child := e.newSchemeClr()
+	e.insertSchemeClr(child)
 	return child
 }
 
-// newBlip creates a detached <a:blip> element.
-func (e *CT_BlipFillProperties) newBlip() *CT_Blip {
-	el := OxmlElement("a:blip")
-	return &CT_Blip{Element{E: el}}
+// newSchemeClr creates a detached <a:schemeClr> element.
+func (e *CT_SolidColorFillProperties) newSchemeClr() *CT_SchemeColor {
+	el := OxmlElement("a:schemeClr")
+	return &CT_SchemeColor{Element{E: el}}
 }
 
-// insertBlip inserts child before first successor.
-func (e *CT_BlipFillProperties) insertBlip(child *CT_Blip) *CT_Blip {
-	e.InsertElementBefore(child.E, "a:srcRect", "a:tile", "a:stretch")
+// insertSchemeClr inserts child before first successor.
+func (e *CT_SolidColorFillProperties) insertSchemeClr(child *CT_SchemeColor) *CT_SchemeColor {
+	e.InsertElementBefore(child.E)
 	return child
 }
 
-// --- CT_Blip ---
+// --- CT_SRgbColor ---
 
-// CT_Blip — blip element specifying image source
-type CT_Blip struct {
+// CT_SRgbColor — RGB color element
+type CT_SRgbColor struct {
 	Element
 }
 
-// Embed returns the value of the "r:embed" attribute, or "" if absent.
-func (e *CT_Blip) Embed() string {
-	val, ok := e.GetAttr("r:embed")
+// Val returns the value of the required "val" attribute.
+func (e *CT_SRgbColor) Val() (string, error) {
+	val, ok := e.GetAttr("val")
 	if !ok {
-		return ""
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "val", e.Tag())
 	}
-	return val
+	return val, nil
 }
 
-// SetEmbed sets the "r:embed" attribute.
-// Passing "" removes it.
-func (e *CT_Blip) SetEmbed(v string) {
-	if v == "" {
-		e.RemoveAttr("r:embed")
-		return
-	}
-	e.SetAttr("r:embed", v)
+// SetVal sets the required "val" attribute.
+func (e *CT_SRgbColor) SetVal(v string) {
+	e.SetAttr("val", v)
 }
 
-// Link returns the value of the "r:link" attribute, or "" if absent.
-func (e *CT_Blip) Link() string {
-	val, ok := e.GetAttr("r:link")
+// --- CT_SchemeColor ---
+
+// CT_SchemeColor — theme color element
+type CT_SchemeColor struct {
+	Element
+}
+
+// Val returns the value of the required "val" attribute.
+func (e *CT_SchemeColor) Val() (string, error) {
+	val, ok := e.GetAttr("val")
 	if !ok {
-		return ""
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "val", e.Tag())
 	}
-	return val
+	return val, nil
 }
 
-// SetLink sets the "r:link" attribute.
-// Passing "" removes it.
-func (e *CT_Blip) SetLink(v string) {
-	if v == "" {
-		e.RemoveAttr("r:link")
-		return
-	}
-	e.SetAttr("r:link", v)
+// SetVal sets the required "val" attribute.
+func (e *CT_SchemeColor) SetVal(v string) {
+	e.SetAttr("val", v)
 }
 
-// --- CT_ShapeProperties ---
+// --- CT_WordprocessingShape ---
 
-// CT_ShapeProperties — shape properties element
-type CT_ShapeProperties struct {
+// CT_WordprocessingShape — WordprocessingML DrawingML shape, e.g. a text box
+type CT_WordprocessingShape struct {
 	Element
 }
 
-// Xfrm returns the <a:xfrm> child element, or nil if not present.
-func (e *CT_ShapeProperties) Xfrm() *CT_Transform2D {
-	child := e.FindChild("a:xfrm")
+// CNvSpPr returns the <wps:cNvSpPr> child element, or nil if not present.
+func (e *CT_WordprocessingShape) CNvSpPr() *CT_NonVisualDrawingShapeProps {
+	child := e.FindChild("wps:cNvSpPr")
 	if child == nil {
 		return nil
 	}
-	return &CT_Transform2D{Element{E: child}}
+	return &CT_NonVisualDrawingShapeProps{Element{E: child}}
 }
 
-// GetOrAddXfrm returns <a:xfrm>, creating it if not present.
-func (e *CT_ShapeProperties) GetOrAddXfrm() *CT_Transform2D {
-	child := e.Xfrm()
+// GetOrAddCNvSpPr returns <wps:cNvSpPr>, creating it if not present.
+func (e *CT_WordprocessingShape) GetOrAddCNvSpPr() *CT_NonVisualDrawingShapeProps {
+	child := e.CNvSpPr()
 	if child != nil {
 		return child
 	}
-	return e.addXfrm()
+	return e.addCNvSpPr()
 }
 
-// RemoveXfrm removes all <a:xfrm> child elements.
-func (e *CT_ShapeProperties) RemoveXfrm() {
-	e.RemoveAll("a:xfrm")
+// RemoveCNvSpPr removes all <wps:cNvSpPr> child elements.
+func (e *CT_WordprocessingShape) RemoveCNvSpPr() {
+	e.RemoveAll("wps:cNvSpPr")
 }
 
-// addXfrm adds a new <a:xfrm> in correct sequence.
-func (e *CT_ShapeProperties) addXfrm() *CT_Transform2D {
-	child := e.newXfrm()
-	e.insertXfrm(child)
+// addCNvSpPr adds a new <wps:cNvSpPr> in correct sequence.
+func (e *CT_WordprocessingShape) addCNvSpPr() *CT_NonVisualDrawingShapeProps {
+	child := e.newCNvSpPr()
+	e.insertCNvSpPr(child)
 	return child
 }
 
-// newXfrm creates a detached <a:xfrm> element.
-func (e *CT_ShapeProperties) newXfrm() *CT_Transform2D {
-	el := OxmlElement("a:xfrm")
-	return &CT_Transform2D{Element{E: el}}
+// newCNvSpPr creates a detached <wps:cNvSpPr> element.
+func (e *CT_WordprocessingShape) newCNvSpPr() *CT_NonVisualDrawingShapeProps {
+	el := OxmlElement("wps:cNvSpPr")
+	return &CT_NonVisualDrawingShapeProps{Element{E: el}}
 }
 
-// insertXfrm inserts child before first successor.
-func (e *CT_ShapeProperties) insertXfrm(child *CT_Transform2D) *CT_Transform2D {
-	e.InsertElementBefore(child.E, "a:custGeom", "a:prstGeom", "a:ln", "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst")
+// insertCNvSpPr inserts child before first successor.
+func (e *CT_WordprocessingShape) insertCNvSpPr(child *CT_NonVisualDrawingShapeProps) *CT_NonVisualDrawingShapeProps {
+	e.InsertElementBefore(child.E, "wps:spPr", "wps:style", "wps:extLst", "wps:txbx", "wps:linkedTxbx", "wps:bodyPr")
 	return child
 }
 
-// --- CT_Transform2D ---
-
-// CT_Transform2D — 2D transform element
-type CT_Transform2D struct {
-	Element
-}
-
-// Off returns the <a:off> child element, or nil if not present.
-func (e *CT_Transform2D) Off() *CT_Point2D {
-	child := e.FindChild("a:off")
+// Txbx returns the <wps:txbx> child element, or nil if not present.
+func (e *CT_WordprocessingShape) Txbx() *CT_TextboxInfo {
+	child := e.FindChild("wps:txbx")
 	if child == nil {
 		return nil
 	}
-	return &CT_Point2D{Element{E: child}}
+	return &CT_TextboxInfo{Element{E: child}}
 }
 
-// GetOrAddOff returns <a:off>, creating it if not present.
-func (e *CT_Transform2D) GetOrAddOff() *CT_Point2D {
-	child := e.Off()
+// GetOrAddTxbx returns <wps:txbx>, creating it if not present.
+func (e *CT_WordprocessingShape) GetOrAddTxbx() *CT_TextboxInfo {
+	child := e.Txbx()
 	if child != nil {
 		return child
 	}
-	return e.addOff()
+	return e.addTxbx()
 }
 
-// RemoveOff removes all <a:off> child elements.
-func (e *CT_Transform2D) RemoveOff() {
-	e.RemoveAll("a:off")
+// RemoveTxbx removes all <wps:txbx> child elements.
+func (e *CT_WordprocessingShape) RemoveTxbx() {
+	e.RemoveAll("wps:txbx")
 }
 
-// addOff adds a new <a:off> in correct sequence.
-func (e *CT_Transform2D) addOff() *CT_Point2D {
-	child := e.newOff()
-	e.insertOff(child)
+// addTxbx adds a new <wps:txbx> in correct sequence.
+func (e *CT_WordprocessingShape) addTxbx() *CT_TextboxInfo {
+	child := e.newTxbx()
+	e.insertTxbx(child)
 	return child
 }
 
-// newOff creates a detached <a:off> element.
-func (e *CT_Transform2D) newOff() *CT_Point2D {
-	el := OxmlElement("a:off")
-	return &CT_Point2D{Element{E: el}}
+// newTxbx creates a detached <wps:txbx> element.
+func (e *CT_WordprocessingShape) newTxbx() *CT_TextboxInfo {
+	el := OxmlElement("wps:txbx")
+	return &CT_TextboxInfo{Element{E: el}}
 }
 
-// insertOff inserts child before first successor.
-func (e *CT_Transform2D) insertOff(child *CT_Point2D) *CT_Point2D {
-	e.InsertElementBefore(child.E, "a:ext")
+// insertTxbx inserts child before first successor.
+func (e *CT_WordprocessingShape) insertTxbx(child *CT_TextboxInfo) *CT_TextboxInfo {
+	e.InsertElementBefore(child.E, "wps:linkedTxbx", "wps:bodyPr")
 	return child
 }
 
-// Ext returns the <a:ext> child element, or nil if not present.
-func (e *CT_Transform2D) Ext() *CT_PositiveSize2D {
-	child := e.FindChild("a:ext")
+// SpPr returns the required <wps:spPr> child element.
+// Panics if not present (invalid XML).
+func (e *CT_WordprocessingShape) SpPr() *CT_ShapeProperties {
+	child := e.FindChild("wps:spPr")
 	if child == nil {
-		return nil
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "wps:spPr", e.Tag()))
 	}
-	return &CT_PositiveSize2D{Element{E: child}}
+	return &CT_ShapeProperties{Element{E: child}}
 }
 
-// GetOrAddExt returns <a:ext>, creating it if not present.
-func (e *CT_Transform2D) GetOrAddExt() *CT_PositiveSize2D {
-	child := e.Ext()
-	if child != nil {
-		return child
+// BodyPr returns the required <wps:bodyPr> child element.
+// Panics if not present (invalid XML).
+func (e *CT_WordprocessingShape) BodyPr() *CT_TextBodyProperties {
+	child := e.FindChild("wps:bodyPr")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "wps:bodyPr", e.Tag()))
 	}
-	return e.addExt()
+	return &CT_TextBodyProperties{Element{E: child}}
 }
 
-// RemoveExt removes all <a:ext> child elements.
-func (e *CT_Transform2D) RemoveExt() {
-	e.RemoveAll("a:ext")
+// --- CT_NonVisualDrawingShapeProps ---
+
+// CT_NonVisualDrawingShapeProps — non-visual shape properties
+type CT_NonVisualDrawingShapeProps struct {
+	Element
 }
 
-// addExt adds a new <a:ext> in correct sequence.
-func (e *CT_Transform2D) addExt() *CT_PositiveSize2D {
-	child := e.newExt()
-	e.insertExt(child)
+// TxBox returns the value of the "txBox" attribute, or false if absent.
+func (e *CT_NonVisualDrawingShapeProps) TxBox() bool {
+	val, ok := e.GetAttr("txBox")
+	if !ok {
+		return false
+	}
+	return parseBoolAttr(val)
+}
+
+// SetTxBox sets the "txBox" attribute.
+// Passing false removes it.
+func (e *CT_NonVisualDrawingShapeProps) SetTxBox(v bool) {
+	if v == false {
+		e.RemoveAttr("txBox")
+		return
+	}
+	e.SetAttr("txBox", formatBoolAttr(v))
+}
+
+// --- CT_TextboxInfo ---
+
+// CT_TextboxInfo — text box of a shape
+type CT_TextboxInfo struct {
+	Element
+}
+
+// TxbxContent returns the required <w:txbxContent> child element.
+// Panics if not present (invalid XML).
+func (e *CT_TextboxInfo) TxbxContent() *CT_TxbxContent {
+	child := e.FindChild("w:txbxContent")
+	if child == nil {
+		panic(fmt.Sprintf("required <%s> child not present in <%s>", "w:txbxContent", e.Tag()))
+	}
+	return &CT_TxbxContent{Element{E: child}}
+}
+
+// --- CT_TxbxContent ---
+
+// CT_TxbxContent — block-level content of a text box
+type CT_TxbxContent struct {
+	Element
+}
+
+// PList returns all <w:p> child elements.
+func (e *CT_TxbxContent) PList() []*CT_P {
+	children := e.FindAllChildren("w:p")
+	result := make([]*CT_P, len(children))
+	for i, c := range children {
+		result[i] = &CT_P{Element{E: c}}
+	}
+	return result
+}
+
+// AddP adds a new <w:p> in correct sequence.
+func (e *CT_TxbxContent) AddP() *CT_P {
+	return e.addP()
+}
+
+// addP adds a new <w:p> unconditionally in correct sequence.
+func (e *CT_TxbxContent) addP() *CT_P {
+	child := e.newP()
+	e.insertP(child)
 	return child
 }
 
-// newExt creates a detached <a:ext> element.
-func (e *CT_Transform2D) newExt() *CT_PositiveSize2D {
-	el := OxmlElement("a:ext")
-	return &CT_PositiveSize2D{Element{E: el}}
+// newP creates a detached <w:p> element.
+func (e *CT_TxbxContent) newP() *CT_P {
+	el := OxmlElement("w:p")
+	return &CT_P{Element{E: el}}
 }
 
-// insertExt inserts child before first successor.
-func (e *CT_Transform2D) insertExt(child *CT_PositiveSize2D) *CT_PositiveSize2D {
+// insertP inserts child before first successor.
+func (e *CT_TxbxContent) insertP(child *CT_P) *CT_P {
 	e.InsertElementBefore(child.E)
 	return child
 }
 
-// --- CT_PositiveSize2D ---
+// TblList returns all <w:tbl> child elements.
+func (e *CT_TxbxContent) TblList() []*CT_Tbl {
+	children := e.FindAllChildren("w:tbl")
+	result := make([]*CT_Tbl, len(children))
+	for i, c := range children {
+		result[i] = &CT_Tbl{Element{E: c}}
+	}
+	return result
+}
 
-// CT_PositiveSize2D — positive size 2D element
-type CT_PositiveSize2D struct {
-	Element
+// AddTbl adds a new <w:tbl> in correct sequence.
+func (e *CT_TxbxContent) AddTbl() *CT_Tbl {
+	return e.addTbl()
 }
 
-// Cx returns the value of the required "cx" attribute.
-func (e *CT_PositiveSize2D) Cx() (int64, error) {
-	val, ok := e.GetAttr("cx")
-	if !ok {
-		return 0, fmt.Errorf("required attribute %q not present on <%s>", "cx", e.Tag())
-	}
-	return parseInt64Attr(val), nil
+// addTbl adds a new <w:tbl> unconditionally in correct sequence.
+func (e *CT_TxbxContent) addTbl() *CT_Tbl {
+	child := e.newTbl()
+	e.insertTbl(child)
+	return child
 }
 
-// SetCx sets the required "cx" attribute.
-func (e *CT_PositiveSize2D) SetCx(v int64) {
-	e.SetAttr("cx", formatInt64Attr(v))
+// newTbl creates a detached <w:tbl> element.
+func (e *CT_TxbxContent) newTbl() *CT_Tbl {
+	el := OxmlElement("w:tbl")
+	return &CT_Tbl{Element{E: el}}
 }
 
-// Cy returns the value of the required "cy" attribute.
-func (e *CT_PositiveSize2D) Cy() (int64, error) {
-	val, ok := e.GetAttr("cy")
+// insertTbl inserts child before first successor.
+func (e *CT_TxbxContent) insertTbl(child *CT_Tbl) *CT_Tbl {
+	e.InsertElementBefore(child.E)
+	return child
+}
+
+// --- CT_TextBodyProperties ---
+
+// CT_TextBodyProperties — text body properties of a shape
+type CT_TextBodyProperties struct {
+	Element
+}
+
+// LIns returns the value of the "lIns" attribute, or 0 if absent.
+func (e *CT_TextBodyProperties) LIns() int64 {
+	val, ok := e.GetAttr("lIns")
 	if !ok {
-		return 0, fmt.Errorf("required attribute %q not present on <%s>", "cy", e.Tag())
+		return 0
 	}
-	return parseInt64Attr(val), nil
+	return parseInt64Attr(val)
 }
 
-// SetCy sets the required "cy" attribute.
-func (e *CT_PositiveSize2D) SetCy(v int64) {
-	e.SetAttr("cy", formatInt64Attr(v))
+// SetLIns sets the "lIns" attribute.
+// Passing 0 removes it.
+func (e *CT_TextBodyProperties) SetLIns(v int64) {
+	if v == 0 {
+		e.RemoveAttr("lIns")
+		return
+	}
+	e.SetAttr("lIns", formatInt64Attr(v))
 }
 
-// --- CT_Point2D ---
+// TIns returns the value of the "tIns" attribute, or 0 if absent.
+func (e *CT_TextBodyProperties) TIns() int64 {
+	val, ok := e.GetAttr("tIns")
+	if !ok {
+		return 0
+	}
+	return parseInt64Attr(val)
+}
 
-// CT_Point2D — point 2D element
-type CT_Point2D struct {
-	Element
+// SetTIns sets the "tIns" attribute.
+// Passing 0 removes it.
+func (e *CT_TextBodyProperties) SetTIns(v int64) {
+	if v == 0 {
+		e.RemoveAttr("tIns")
+		return
+	}
+	e.SetAttr("tIns", formatInt64Attr(v))
 }
 
-// X returns the value of the required "x" attribute.
-func (e *CT_Point2D) X() (int64, error) {
-	val, ok := e.GetAttr("x")
+// RIns returns the value of the "rIns" attribute, or 0 if absent.
+func (e *CT_TextBodyProperties) RIns() int64 {
+	val, ok := e.GetAttr("rIns")
 	if !ok {
-		return 0, fmt.Errorf("required attribute %q not present on <%s>", "x", e.Tag())
+		return 0
 	}
-	return parseInt64Attr(val), nil
+	return parseInt64Attr(val)
 }
 
-// SetX sets the required "x" attribute.
-func (e *CT_Point2D) SetX(v int64) {
-	e.SetAttr("x", formatInt64Attr(v))
+// SetRIns sets the "rIns" attribute.
+// Passing 0 removes it.
+func (e *CT_TextBodyProperties) SetRIns(v int64) {
+	if v == 0 {
+		e.RemoveAttr("rIns")
+		return
+	}
+	e.SetAttr("rIns", formatInt64Attr(v))
 }
 
-// Y returns the value of the required "y" attribute.
-func (e *CT_Point2D) Y() (int64, error) {
-	val, ok := e.GetAttr("y")
+// BIns returns the value of the "bIns" attribute, or 0 if absent.
+func (e *CT_TextBodyProperties) BIns() int64 {
+	val, ok := e.GetAttr("bIns")
 	if !ok {
-		return 0, fmt.Errorf("required attribute %q not present on <%s>", "y", e.Tag())
+		return 0
 	}
-	return parseInt64Attr(val), nil
+	return parseInt64Attr(val)
 }
 
-// SetY sets the required "y" attribute.
-func (e *CT_Point2D) SetY(v int64) {
-	e.SetAttr("y", formatInt64Attr(v))
+// SetBIns sets the "bIns" attribute.
+// Passing 0 removes it.
+func (e *CT_TextBodyProperties) SetBIns(v int64) {
+	if v == 0 {
+		e.RemoveAttr("bIns")
+		return
+	}
+	e.SetAttr("bIns", formatInt64Attr(v))
 }
 
-// --- CT_PresetGeometry2D ---
+// Anchor returns the value of the "anchor" attribute, or "" if absent.
+func (e *CT_TextBodyProperties) Anchor() string {
+	val, ok := e.GetAttr("anchor")
+	if !ok {
+		return ""
+	}
+	return val
+}
 
-// CT_PresetGeometry2D — preset geometry element
-type CT_PresetGeometry2D struct {
-	Element
+// SetAnchor sets the "anchor" attribute.
+// Passing "" removes it.
+func (e *CT_TextBodyProperties) SetAnchor(v string) {
+	if v == "" {
+		e.RemoveAttr("anchor")
+		return
+	}
+	e.SetAttr("anchor", v)
 }
 
 // --- CT_RelativeRect ---
@@ -553,3 +2552,359 @@ type CT_RelativeRect struct {
 type CT_StretchInfoProperties struct {
 	Element
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_Inline",
+		tag:  "wp:inline",
+		wrap: func(el Element) Node { return &CT_Inline{el} },
+		children: []childMeta{
+			{tag: "wp:extent", typ: "CT_PositiveSize2D", card: cardOneAndOnlyOne},
+			{tag: "wp:docPr", typ: "CT_NonVisualDrawingProps", card: cardOneAndOnlyOne},
+			{tag: "a:graphic", typ: "CT_GraphicalObject", card: cardOneAndOnlyOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Anchor",
+		tag:  "wp:anchor",
+		wrap: func(el Element) Node { return &CT_Anchor{el} },
+		children: []childMeta{
+			{tag: "wp:simplePos", typ: "CT_Point2D", card: cardOneAndOnlyOne},
+			{tag: "wp:positionH", typ: "CT_PosH", card: cardOneAndOnlyOne},
+			{tag: "wp:positionV", typ: "CT_PosV", card: cardOneAndOnlyOne},
+			{tag: "wp:extent", typ: "CT_PositiveSize2D", card: cardOneAndOnlyOne},
+			{tag: "wp:effectExtent", typ: "CT_EffectExtent", card: cardZeroOrOne, successors: []string{"wp:wrapNone", "wp:wrapSquare", "wp:wrapTight", "wp:wrapThrough", "wp:wrapTopAndBottom", "wp:docPr", "wp:cNvGraphicFramePr", "a:graphic"}},
+			{tag: "wp:docPr", typ: "CT_NonVisualDrawingProps", card: cardOneAndOnlyOne},
+			{tag: "a:graphic", typ: "CT_GraphicalObject", card: cardOneAndOnlyOne},
+			{tag: "wp:wrapNone", typ: "CT_WrapNone", card: cardZeroOrOne, group: 1, successors: []string{"wp:docPr", "wp:cNvGraphicFramePr", "a:graphic"}},
+			{tag: "wp:wrapSquare", typ: "CT_WrapSquare", card: cardZeroOrOne, group: 1, successors: []string{"wp:docPr", "wp:cNvGraphicFramePr", "a:graphic"}},
+			{tag: "wp:wrapTight", typ: "CT_WrapTight", card: cardZeroOrOne, group: 1, successors: []string{"wp:docPr", "wp:cNvGraphicFramePr", "a:graphic"}},
+			{tag: "wp:wrapThrough", typ: "CT_WrapThrough", card: cardZeroOrOne, group: 1, successors: []string{"wp:docPr", "wp:cNvGraphicFramePr", "a:graphic"}},
+			{tag: "wp:wrapTopAndBottom", typ: "CT_WrapTopBottom", card: cardZeroOrOne, group: 1, successors: []string{"wp:docPr", "wp:cNvGraphicFramePr", "a:graphic"}},
+		},
+		attributes: []attrMeta{
+			{name: "distT", check: checkInt64Attr},
+			{name: "distB", check: checkInt64Attr},
+			{name: "distL", check: checkInt64Attr},
+			{name: "distR", check: checkInt64Attr},
+			{name: "simplePos", check: checkBoolAttr},
+			{name: "relativeHeight", check: checkInt64Attr},
+			{name: "behindDoc", check: checkBoolAttr},
+			{name: "locked", check: checkBoolAttr},
+			{name: "layoutInCell", check: checkBoolAttr},
+			{name: "hidden", check: checkBoolAttr},
+			{name: "allowOverlap", check: checkBoolAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PosH",
+		tag:  "wp:positionH",
+		wrap: func(el Element) Node { return &CT_PosH{el} },
+		children: []childMeta{
+			{tag: "wp:align", typ: "CT_PosAlign", card: cardZeroOrOne, group: 1},
+			{tag: "wp:posOffset", typ: "CT_PosOffset", card: cardZeroOrOne, group: 1},
+		},
+		attributes: []attrMeta{
+			{name: "relativeFrom", check: checkEnumAttr(enum.WdRelativeHorizontalPositionFromXml)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PosV",
+		tag:  "wp:positionV",
+		wrap: func(el Element) Node { return &CT_PosV{el} },
+		children: []childMeta{
+			{tag: "wp:align", typ: "CT_PosAlign", card: cardZeroOrOne, group: 1},
+			{tag: "wp:posOffset", typ: "CT_PosOffset", card: cardZeroOrOne, group: 1},
+		},
+		attributes: []attrMeta{
+			{name: "relativeFrom", check: checkEnumAttr(enum.WdRelativeVerticalPositionFromXml)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PosAlign",
+		tag:  "wp:align",
+		wrap: func(el Element) Node { return &CT_PosAlign{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PosOffset",
+		tag:  "wp:posOffset",
+		wrap: func(el Element) Node { return &CT_PosOffset{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_EffectExtent",
+		tag:  "wp:effectExtent",
+		wrap: func(el Element) Node { return &CT_EffectExtent{el} },
+		attributes: []attrMeta{
+			{name: "l", check: checkInt64Attr},
+			{name: "t", check: checkInt64Attr},
+			{name: "r", check: checkInt64Attr},
+			{name: "b", check: checkInt64Attr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_WrapNone",
+		tag:  "wp:wrapNone",
+		wrap: func(el Element) Node { return &CT_WrapNone{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_WrapSquare",
+		tag:  "wp:wrapSquare",
+		wrap: func(el Element) Node { return &CT_WrapSquare{el} },
+		attributes: []attrMeta{
+			{name: "distT", check: checkInt64Attr},
+			{name: "distB", check: checkInt64Attr},
+			{name: "distL", check: checkInt64Attr},
+			{name: "distR", check: checkInt64Attr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_WrapTight",
+		tag:  "wp:wrapTight",
+		wrap: func(el Element) Node { return &CT_WrapTight{el} },
+		children: []childMeta{
+			{tag: "wp:wrapPolygon", typ: "CT_WrapPath", card: cardOneAndOnlyOne},
+		},
+		attributes: []attrMeta{
+			{name: "distL", check: checkInt64Attr},
+			{name: "distR", check: checkInt64Attr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_WrapThrough",
+		tag:  "wp:wrapThrough",
+		wrap: func(el Element) Node { return &CT_WrapThrough{el} },
+		children: []childMeta{
+			{tag: "wp:wrapPolygon", typ: "CT_WrapPath", card: cardOneAndOnlyOne},
+		},
+		attributes: []attrMeta{
+			{name: "distL", check: checkInt64Attr},
+			{name: "distR", check: checkInt64Attr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_WrapTopBottom",
+		tag:  "wp:wrapTopAndBottom",
+		wrap: func(el Element) Node { return &CT_WrapTopBottom{el} },
+		attributes: []attrMeta{
+			{name: "distT", check: checkInt64Attr},
+			{name: "distB", check: checkInt64Attr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_WrapPath",
+		tag:  "wp:wrapPolygon",
+		wrap: func(el Element) Node { return &CT_WrapPath{el} },
+		children: []childMeta{
+			{tag: "wp:start", typ: "CT_Point2D", card: cardOneAndOnlyOne},
+			{tag: "wp:lineTo", typ: "CT_Point2D", card: cardOneOrMore},
+		},
+		attributes: []attrMeta{
+			{name: "edited", check: checkBoolAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Picture",
+		tag:  "pic:pic",
+		wrap: func(el Element) Node { return &CT_Picture{el} },
+		children: []childMeta{
+			{tag: "pic:nvPicPr", typ: "CT_PictureNonVisual", card: cardOneAndOnlyOne},
+			{tag: "pic:blipFill", typ: "CT_BlipFillProperties", card: cardOneAndOnlyOne},
+			{tag: "pic:spPr", typ: "CT_ShapeProperties", card: cardOneAndOnlyOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PictureNonVisual",
+		tag:  "pic:nvPicPr",
+		wrap: func(el Element) Node { return &CT_PictureNonVisual{el} },
+		children: []childMeta{
+			{tag: "pic:cNvPr", typ: "CT_NonVisualDrawingProps", card: cardOneAndOnlyOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_NonVisualDrawingProps",
+		tag:  "wp:docPr",
+		wrap: func(el Element) Node { return &CT_NonVisualDrawingProps{el} },
+		attributes: []attrMeta{
+			{name: "id", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_NonVisualPictureProperties",
+		tag:  "pic:cNvPicPr",
+		wrap: func(el Element) Node { return &CT_NonVisualPictureProperties{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_GraphicalObject",
+		tag:  "a:graphic",
+		wrap: func(el Element) Node { return &CT_GraphicalObject{el} },
+		children: []childMeta{
+			{tag: "a:graphicData", typ: "CT_GraphicalObjectData", card: cardOneAndOnlyOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_GraphicalObjectData",
+		tag:  "a:graphicData",
+		wrap: func(el Element) Node { return &CT_GraphicalObjectData{el} },
+		children: []childMeta{
+			{tag: "pic:pic", typ: "CT_Picture", card: cardZeroOrOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_BlipFillProperties",
+		tag:  "pic:blipFill",
+		wrap: func(el Element) Node { return &CT_BlipFillProperties{el} },
+		children: []childMeta{
+			{tag: "a:blip", typ: "CT_Blip", card: cardZeroOrOne, successors: []string{"a:srcRect", "a:tile", "a:stretch"}},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Blip",
+		tag:  "a:blip",
+		wrap: func(el Element) Node { return &CT_Blip{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_ShapeProperties",
+		tag:  "pic:spPr",
+		wrap: func(el Element) Node { return &CT_ShapeProperties{el} },
+		children: []childMeta{
+			{tag: "a:xfrm", typ: "CT_Transform2D", card: cardZeroOrOne, successors: []string{"a:custGeom", "a:prstGeom", "a:noFill", "a:solidFill", "a:gradFill", "a:blipFill", "a:pattFill", "a:grpFill", "a:ln", "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst"}},
+			{tag: "a:prstGeom", typ: "CT_PresetGeometry2D", card: cardZeroOrOne, successors: []string{"a:noFill", "a:solidFill", "a:gradFill", "a:blipFill", "a:pattFill", "a:grpFill", "a:ln", "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst"}},
+			{tag: "a:ln", typ: "CT_LineProperties", card: cardZeroOrOne, successors: []string{"a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst"}},
+			{tag: "a:noFill", typ: "CT_NoFillProperties", card: cardZeroOrOne, group: 1, successors: []string{"a:ln", "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst"}},
+			{tag: "a:solidFill", typ: "CT_SolidColorFillProperties", card: cardZeroOrOne, group: 1, successors: []string{"a:ln", "a:effectLst", "a:effectDag", "a:scene3d", "a:sp3d", "a:extLst"}},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Transform2D",
+		tag:  "a:xfrm",
+		wrap: func(el Element) Node { return &CT_Transform2D{el} },
+		children: []childMeta{
+			{tag: "a:off", typ: "CT_Point2D", card: cardZeroOrOne, successors: []string{"a:ext"}},
+			{tag: "a:ext", typ: "CT_PositiveSize2D", card: cardZeroOrOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PositiveSize2D",
+		tag:  "wp:extent",
+		wrap: func(el Element) Node { return &CT_PositiveSize2D{el} },
+		attributes: []attrMeta{
+			{name: "cx", check: checkInt64Attr},
+			{name: "cy", check: checkInt64Attr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Point2D",
+		tag:  "a:off",
+		wrap: func(el Element) Node { return &CT_Point2D{el} },
+		attributes: []attrMeta{
+			{name: "x", check: checkInt64Attr},
+			{name: "y", check: checkInt64Attr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PresetGeometry2D",
+		tag:  "a:prstGeom",
+		wrap: func(el Element) Node { return &CT_PresetGeometry2D{el} },
+		children: []childMeta{
+			{tag: "a:avLst", typ: "CT_GeomGuideList", card: cardZeroOrOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_GeomGuideList",
+		tag:  "a:avLst",
+		wrap: func(el Element) Node { return &CT_GeomGuideList{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_LineProperties",
+		tag:  "a:ln",
+		wrap: func(el Element) Node { return &CT_LineProperties{el} },
+		children: []childMeta{
+			{tag: "a:noFill", typ: "CT_NoFillProperties", card: cardZeroOrOne, group: 1, successors: []string{"a:prstDash", "a:custDash", "a:round", "a:bevel", "a:miter", "a:headEnd", "a:tailEnd", "a:extLst"}},
+			{tag: "a:solidFill", typ: "CT_SolidColorFillProperties", card: cardZeroOrOne, group: 1, successors: []string{"a:prstDash", "a:custDash", "a:round", "a:bevel", "a:miter", "a:headEnd", "a:tailEnd", "a:extLst"}},
+		},
+		attributes: []attrMeta{
+			{name: "w", check: checkInt64Attr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_NoFillProperties",
+		tag:  "a:noFill",
+		wrap: func(el Element) Node { return &CT_NoFillProperties{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_SolidColorFillProperties",
+		tag:  "a:solidFill",
+		wrap: func(el Element) Node { return &CT_SolidColorFillProperties{el} },
+		children: []childMeta{
+			{tag: "a:srgbClr", typ: "CT_SRgbColor", card: cardZeroOrOne, group: 1},
+			{tag: "a:schemeClr", typ: "CT_SchemeColor", card: cardZeroOrOne, group: 1},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_SRgbColor",
+		tag:  "a:srgbClr",
+		wrap: func(el Element) Node { return &CT_SRgbColor{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_SchemeColor",
+		tag:  "a:schemeClr",
+		wrap: func(el Element) Node { return &CT_SchemeColor{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_WordprocessingShape",
+		tag:  "wps:wsp",
+		wrap: func(el Element) Node { return &CT_WordprocessingShape{el} },
+		children: []childMeta{
+			{tag: "wps:cNvSpPr", typ: "CT_NonVisualDrawingShapeProps", card: cardZeroOrOne, successors: []string{"wps:spPr", "wps:style", "wps:extLst", "wps:txbx", "wps:linkedTxbx", "wps:bodyPr"}},
+			{tag: "wps:spPr", typ: "CT_ShapeProperties", card: cardOneAndOnlyOne},
+			{tag: "wps:txbx", typ: "CT_TextboxInfo", card: cardZeroOrOne, successors: []string{"wps:linkedTxbx", "wps:bodyPr"}},
+			{tag: "wps:bodyPr", typ: "CT_TextBodyProperties", card: cardOneAndOnlyOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_NonVisualDrawingShapeProps",
+		tag:  "wps:cNvSpPr",
+		wrap: func(el Element) Node { return &CT_NonVisualDrawingShapeProps{el} },
+		attributes: []attrMeta{
+			{name: "txBox", check: checkBoolAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TextboxInfo",
+		tag:  "wps:txbx",
+		wrap: func(el Element) Node { return &CT_TextboxInfo{el} },
+		children: []childMeta{
+			{tag: "w:txbxContent", typ: "CT_TxbxContent", card: cardOneAndOnlyOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TxbxContent",
+		tag:  "w:txbxContent",
+		wrap: func(el Element) Node { return &CT_TxbxContent{el} },
+		children: []childMeta{
+			{tag: "w:p", typ: "CT_P", card: cardZeroOrMore},
+			{tag: "w:tbl", typ: "CT_Tbl", card: cardZeroOrMore},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TextBodyProperties",
+		tag:  "wps:bodyPr",
+		wrap: func(el Element) Node { return &CT_TextBodyProperties{el} },
+		attributes: []attrMeta{
+			{name: "lIns", check: checkInt64Attr},
+			{name: "tIns", check: checkInt64Attr},
+			{name: "rIns", check: checkInt64Attr},
+			{name: "bIns", check: checkInt64Attr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_RelativeRect",
+		tag:  "a:fillRect",
+		wrap: func(el Element) Node { return &CT_RelativeRect{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_StretchInfoProperties",
+		tag:  "a:stretch",
+		wrap: func(el Element) Node { return &CT_StretchInfoProperties{el} },
+	})
+}
@@ -43,7 +43,7 @@ func (e *CT_OnOff) Val() bool {
 	if !ok {
 		return true
 	}
-	return parseBoolAttr(val)
+	return parseOnOffAttr(val)
 }
 
 // SetVal sets the "w:val" attribute.
@@ -53,7 +53,7 @@ func (e *CT_OnOff) SetVal(v bool) {
 		e.RemoveAttr("w:val")
 		return
 	}
-	e.SetAttr("w:val", formatBoolAttr(v))
+	e.SetAttr("w:val", formatOnOffAttr(v))
 }
 
 // --- CT_String ---
@@ -76,3 +76,27 @@ func (e *CT_String) Val() (string, error) {
 func (e *CT_String) SetVal(v string) {
 	e.SetAttr("w:val", v)
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_DecimalNumber",
+		tag:  "w:decimalNumber",
+		wrap: func(el Element) Node { return &CT_DecimalNumber{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_OnOff",
+		tag:  "w:onOff",
+		wrap: func(el Element) Node { return &CT_OnOff{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkValueAttr(parseOnOff)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_String",
+		tag:  "w:string",
+		wrap: func(el Element) Node { return &CT_String{el} },
+	})
+}
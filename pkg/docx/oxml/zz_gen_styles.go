@@ -865,3 +865,64 @@ func (e *CT_LsdException) Name() (string, error) {
 func (e *CT_LsdException) SetName(v string) {
 	e.SetAttr("w:name", v)
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_Styles",
+		tag:  "w:styles",
+		wrap: func(el Element) Node { return &CT_Styles{el} },
+		children: []childMeta{
+			{tag: "w:latentStyles", typ: "CT_LatentStyles", card: cardZeroOrOne, successors: []string{"w:style"}},
+			{tag: "w:style", typ: "CT_Style", card: cardZeroOrMore},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Style",
+		tag:  "w:style",
+		wrap: func(el Element) Node { return &CT_Style{el} },
+		children: []childMeta{
+			{tag: "w:name", typ: "CT_String", card: cardZeroOrOne, successors: []string{"w:aliases", "w:basedOn", "w:next", "w:link", "w:autoRedefine", "w:hidden", "w:uiPriority", "w:semiHidden", "w:unhideWhenUsed", "w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:basedOn", typ: "CT_String", card: cardZeroOrOne, successors: []string{"w:next", "w:link", "w:autoRedefine", "w:hidden", "w:uiPriority", "w:semiHidden", "w:unhideWhenUsed", "w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:next", typ: "CT_String", card: cardZeroOrOne, successors: []string{"w:link", "w:autoRedefine", "w:hidden", "w:uiPriority", "w:semiHidden", "w:unhideWhenUsed", "w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:uiPriority", typ: "CT_DecimalNumber", card: cardZeroOrOne, successors: []string{"w:semiHidden", "w:unhideWhenUsed", "w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:semiHidden", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:unhideWhenUsed", "w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:unhideWhenUsed", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:qFormat", "w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:qFormat", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:locked", "w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:locked", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:personal", "w:personalCompose", "w:personalReply", "w:rsid", "w:pPr", "w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:pPr", typ: "CT_PPr", card: cardZeroOrOne, successors: []string{"w:rPr", "w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+			{tag: "w:rPr", typ: "CT_RPr", card: cardZeroOrOne, successors: []string{"w:tblPr", "w:trPr", "w:tcPr", "w:tblStylePr"}},
+		},
+		attributes: []attrMeta{
+			{name: "w:default", check: checkBoolAttr},
+			{name: "w:customStyle", check: checkBoolAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_LatentStyles",
+		tag:  "w:latentStyles",
+		wrap: func(el Element) Node { return &CT_LatentStyles{el} },
+		children: []childMeta{
+			{tag: "w:lsdException", typ: "CT_LsdException", card: cardZeroOrMore},
+		},
+		attributes: []attrMeta{
+			{name: "w:count", check: checkIntAttr},
+			{name: "w:defLockedState", check: checkBoolAttr},
+			{name: "w:defQFormat", check: checkBoolAttr},
+			{name: "w:defSemiHidden", check: checkBoolAttr},
+			{name: "w:defUIPriority", check: checkIntAttr},
+			{name: "w:defUnhideWhenUsed", check: checkBoolAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_LsdException",
+		tag:  "w:lsdException",
+		wrap: func(el Element) Node { return &CT_LsdException{el} },
+		attributes: []attrMeta{
+			{name: "w:locked", check: checkBoolAttr},
+			{name: "w:qFormat", check: checkBoolAttr},
+			{name: "w:semiHidden", check: checkBoolAttr},
+			{name: "w:uiPriority", check: checkIntAttr},
+			{name: "w:unhideWhenUsed", check: checkBoolAttr},
+		},
+	})
+}
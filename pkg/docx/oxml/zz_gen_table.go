@@ -1024,3 +1024,124 @@ func (e *CT_VMerge) SetVal(v string) {
 	}
 	e.SetAttr("w:val", v)
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_Tbl",
+		tag:  "w:tbl",
+		wrap: func(el Element) Node { return &CT_Tbl{el} },
+		children: []childMeta{
+			{tag: "w:tblPr", typ: "CT_TblPr", card: cardOneAndOnlyOne},
+			{tag: "w:tblGrid", typ: "CT_TblGrid", card: cardOneAndOnlyOne},
+			{tag: "w:tr", typ: "CT_Row", card: cardZeroOrMore},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Row",
+		tag:  "w:tr",
+		wrap: func(el Element) Node { return &CT_Row{el} },
+		children: []childMeta{
+			{tag: "w:tblPrEx", typ: "CT_TblPrEx", card: cardZeroOrOne, successors: []string{"w:trPr", "w:tc"}},
+			{tag: "w:trPr", typ: "CT_TrPr", card: cardZeroOrOne, successors: []string{"w:tc"}},
+			{tag: "w:tc", typ: "CT_Tc", card: cardZeroOrMore},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Tc",
+		tag:  "w:tc",
+		wrap: func(el Element) Node { return &CT_Tc{el} },
+		children: []childMeta{
+			{tag: "w:tcPr", typ: "CT_TcPr", card: cardZeroOrOne, successors: []string{"w:p", "w:tbl"}},
+			{tag: "w:p", typ: "CT_P", card: cardOneOrMore, choice: "block"},
+			{tag: "w:tbl", typ: "CT_Tbl", card: cardOneOrMore, choice: "block"},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TblPr",
+		tag:  "w:tblPr",
+		wrap: func(el Element) Node { return &CT_TblPr{el} },
+		children: []childMeta{
+			{tag: "w:tblStyle", typ: "CT_String", card: cardZeroOrOne, successors: []string{"w:tblpPr", "w:tblOverlap", "w:bidiVisual", "w:tblStyleRowBandSize", "w:tblStyleColBandSize", "w:tblW", "w:jc", "w:tblCellSpacing", "w:tblInd", "w:tblBorders", "w:shd", "w:tblLayout", "w:tblCellMar", "w:tblLook", "w:tblCaption", "w:tblDescription", "w:tblPrChange"}},
+			{tag: "w:bidiVisual", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:tblStyleRowBandSize", "w:tblStyleColBandSize", "w:tblW", "w:jc", "w:tblCellSpacing", "w:tblInd", "w:tblBorders", "w:shd", "w:tblLayout", "w:tblCellMar", "w:tblLook", "w:tblCaption", "w:tblDescription", "w:tblPrChange"}},
+			{tag: "w:jc", typ: "CT_Jc", card: cardZeroOrOne, successors: []string{"w:tblCellSpacing", "w:tblInd", "w:tblBorders", "w:shd", "w:tblLayout", "w:tblCellMar", "w:tblLook", "w:tblCaption", "w:tblDescription", "w:tblPrChange"}},
+			{tag: "w:tblLayout", typ: "CT_TblLayoutType", card: cardZeroOrOne, successors: []string{"w:tblCellMar", "w:tblLook", "w:tblCaption", "w:tblDescription", "w:tblPrChange"}},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TcPr",
+		tag:  "w:tcPr",
+		wrap: func(el Element) Node { return &CT_TcPr{el} },
+		children: []childMeta{
+			{tag: "w:tcW", typ: "CT_TblWidth", card: cardZeroOrOne, successors: []string{"w:gridSpan", "w:hMerge", "w:vMerge", "w:tcBorders", "w:shd", "w:noWrap", "w:tcMar", "w:textDirection", "w:tcFitText", "w:vAlign", "w:hideMark", "w:headers", "w:cellIns", "w:cellDel", "w:cellMerge", "w:tcPrChange"}},
+			{tag: "w:gridSpan", typ: "CT_DecimalNumber", card: cardZeroOrOne, successors: []string{"w:hMerge", "w:vMerge", "w:tcBorders", "w:shd", "w:noWrap", "w:tcMar", "w:textDirection", "w:tcFitText", "w:vAlign", "w:hideMark", "w:headers", "w:cellIns", "w:cellDel", "w:cellMerge", "w:tcPrChange"}},
+			{tag: "w:vMerge", typ: "CT_VMerge", card: cardZeroOrOne, successors: []string{"w:tcBorders", "w:shd", "w:noWrap", "w:tcMar", "w:textDirection", "w:tcFitText", "w:vAlign", "w:hideMark", "w:headers", "w:cellIns", "w:cellDel", "w:cellMerge", "w:tcPrChange"}},
+			{tag: "w:vAlign", typ: "CT_VerticalJc", card: cardZeroOrOne, successors: []string{"w:hideMark", "w:headers", "w:cellIns", "w:cellDel", "w:cellMerge", "w:tcPrChange"}},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TrPr",
+		tag:  "w:trPr",
+		wrap: func(el Element) Node { return &CT_TrPr{el} },
+		children: []childMeta{
+			{tag: "w:gridBefore", typ: "CT_DecimalNumber", card: cardZeroOrOne, successors: []string{"w:gridAfter", "w:wBefore", "w:wAfter", "w:cantSplit", "w:trHeight", "w:tblHeader", "w:tblCellSpacing", "w:jc", "w:hidden", "w:ins", "w:del", "w:trPrChange"}},
+			{tag: "w:gridAfter", typ: "CT_DecimalNumber", card: cardZeroOrOne, successors: []string{"w:wBefore", "w:wAfter", "w:cantSplit", "w:trHeight", "w:tblHeader", "w:tblCellSpacing", "w:jc", "w:hidden", "w:ins", "w:del", "w:trPrChange"}},
+			{tag: "w:trHeight", typ: "CT_Height", card: cardZeroOrOne, successors: []string{"w:tblHeader", "w:tblCellSpacing", "w:jc", "w:hidden", "w:ins", "w:del", "w:trPrChange"}},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TblGrid",
+		tag:  "w:tblGrid",
+		wrap: func(el Element) Node { return &CT_TblGrid{el} },
+		children: []childMeta{
+			{tag: "w:gridCol", typ: "CT_TblGridCol", card: cardZeroOrMore, successors: []string{"w:tblGridChange"}},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TblGridCol",
+		tag:  "w:gridCol",
+		wrap: func(el Element) Node { return &CT_TblGridCol{el} },
+		attributes: []attrMeta{
+			{name: "w:w", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Height",
+		tag:  "w:trHeight",
+		wrap: func(el Element) Node { return &CT_Height{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkIntAttr},
+			{name: "w:hRule", check: checkEnumAttr(enum.WdRowHeightRuleFromXml)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TblWidth",
+		tag:  "w:tblW",
+		wrap: func(el Element) Node { return &CT_TblWidth{el} },
+		attributes: []attrMeta{
+			{name: "w:w", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TblLayoutType",
+		tag:  "w:tblLayout",
+		wrap: func(el Element) Node { return &CT_TblLayoutType{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TblPrEx",
+		tag:  "w:tblPrEx",
+		wrap: func(el Element) Node { return &CT_TblPrEx{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_VerticalJc",
+		tag:  "w:vAlign",
+		wrap: func(el Element) Node { return &CT_VerticalJc{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkEnumAttr(enum.WdCellVerticalAlignmentFromXml)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_VMerge",
+		tag:  "w:vMerge",
+		wrap: func(el Element) Node { return &CT_VMerge{el} },
+	})
+}
@@ -4,6 +4,8 @@ package oxml
 
 import (
 	"fmt"
+	"github.com/user/go-docx/pkg/docx"
+	"github.com/user/go-docx/pkg/docx/enum"
 )
 
 // Ensure imports are used.
@@ -814,6 +816,174 @@ func (e *CT_RPr) insertColor(child *CT_Color) *CT_Color {
 	return child
 }
 
+// Spacing returns the <w:spacing> child element, or nil if not present.
+func (e *CT_RPr) Spacing() *CT_SignedTwipsMeasure {
+	child := e.FindChild("w:spacing")
+	if child == nil {
+		return nil
+	}
+	return &CT_SignedTwipsMeasure{Element{E: child}}
+}
+
+// GetOrAddSpacing returns <w:spacing>, creating it if not present.
+func (e *CT_RPr) GetOrAddSpacing() *CT_SignedTwipsMeasure {
+	child := e.Spacing()
+	if child != nil {
+		return child
+	}
+	return e.addSpacing()
+}
+
+// RemoveSpacing removes all <w:spacing> child elements.
+func (e *CT_RPr) RemoveSpacing() {
+	e.RemoveAll("w:spacing")
+}
+
+// addSpacing adds a new <w:spacing> in correct sequence.
+func (e *CT_RPr) addSpacing() *CT_SignedTwipsMeasure {
+	child := e.newSpacing()
+	e.insertSpacing(child)
+	return child
+}
+
+// newSpacing creates a detached <w:spacing> element.
+func (e *CT_RPr) newSpacing() *CT_SignedTwipsMeasure {
+	el := OxmlElement("w:spacing")
+	return &CT_SignedTwipsMeasure{Element{E: el}}
+}
+
+// insertSpacing inserts child before first successor.
+func (e *CT_RPr) insertSpacing(child *CT_SignedTwipsMeasure) *CT_SignedTwipsMeasure {
+	e.InsertElementBefore(child.E, "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
+// W returns the <w:w> child element, or nil if not present.
+func (e *CT_RPr) W() *CT_TextScale {
+	child := e.FindChild("w:w")
+	if child == nil {
+		return nil
+	}
+	return &CT_TextScale{Element{E: child}}
+}
+
+// GetOrAddW returns <w:w>, creating it if not present.
+func (e *CT_RPr) GetOrAddW() *CT_TextScale {
+	child := e.W()
+	if child != nil {
+		return child
+	}
+	return e.addW()
+}
+
+// RemoveW removes all <w:w> child elements.
+func (e *CT_RPr) RemoveW() {
+	e.RemoveAll("w:w")
+}
+
+// addW adds a new <w:w> in correct sequence.
+func (e *CT_RPr) addW() *CT_TextScale {
+	child := e.newW()
+	e.insertW(child)
+	return child
+}
+
+// newW creates a detached <w:w> element.
+func (e *CT_RPr) newW() *CT_TextScale {
+	el := OxmlElement("w:w")
+	return &CT_TextScale{Element{E: el}}
+}
+
+// insertW inserts child before first successor.
+func (e *CT_RPr) insertW(child *CT_TextScale) *CT_TextScale {
+	e.InsertElementBefore(child.E, "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
+// Kern returns the <w:kern> child element, or nil if not present.
+func (e *CT_RPr) Kern() *CT_HpsMeasure {
+	child := e.FindChild("w:kern")
+	if child == nil {
+		return nil
+	}
+	return &CT_HpsMeasure{Element{E: child}}
+}
+
+// GetOrAddKern returns <w:kern>, creating it if not present.
+func (e *CT_RPr) GetOrAddKern() *CT_HpsMeasure {
+	child := e.Kern()
+	if child != nil {
+		return child
+	}
+	return e.addKern()
+}
+
+// RemoveKern removes all <w:kern> child elements.
+func (e *CT_RPr) RemoveKern() {
+	e.RemoveAll("w:kern")
+}
+
+// addKern adds a new <w:kern> in correct sequence.
+func (e *CT_RPr) addKern() *CT_HpsMeasure {
+	child := e.newKern()
+	e.insertKern(child)
+	return child
+}
+
+// newKern creates a detached <w:kern> element.
+func (e *CT_RPr) newKern() *CT_HpsMeasure {
+	el := OxmlElement("w:kern")
+	return &CT_HpsMeasure{Element{E: el}}
+}
+
+// insertKern inserts child before first successor.
+func (e *CT_RPr) insertKern(child *CT_HpsMeasure) *CT_HpsMeasure {
+	e.InsertElementBefore(child.E, "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
+// Position returns the <w:position> child element, or nil if not present.
+func (e *CT_RPr) Position() *CT_SignedHpsMeasure {
+	child := e.FindChild("w:position")
+	if child == nil {
+		return nil
+	}
+	return &CT_SignedHpsMeasure{Element{E: child}}
+}
+
+// GetOrAddPosition returns <w:position>, creating it if not present.
+func (e *CT_RPr) GetOrAddPosition() *CT_SignedHpsMeasure {
+	child := e.Position()
+	if child != nil {
+		return child
+	}
+	return e.addPosition()
+}
+
+// RemovePosition removes all <w:position> child elements.
+func (e *CT_RPr) RemovePosition() {
+	e.RemoveAll("w:position")
+}
+
+// addPosition adds a new <w:position> in correct sequence.
+func (e *CT_RPr) addPosition() *CT_SignedHpsMeasure {
+	child := e.newPosition()
+	e.insertPosition(child)
+	return child
+}
+
+// newPosition creates a detached <w:position> element.
+func (e *CT_RPr) newPosition() *CT_SignedHpsMeasure {
+	el := OxmlElement("w:position")
+	return &CT_SignedHpsMeasure{Element{E: el}}
+}
+
+// insertPosition inserts child before first successor.
+func (e *CT_RPr) insertPosition(child *CT_SignedHpsMeasure) *CT_SignedHpsMeasure {
+	e.InsertElementBefore(child.E, "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
 // Sz returns the <w:sz> child element, or nil if not present.
 func (e *CT_RPr) Sz() *CT_HpsMeasure {
 	child := e.FindChild("w:sz")
@@ -856,6 +1026,48 @@ func (e *CT_RPr) insertSz(child *CT_HpsMeasure) *CT_HpsMeasure {
 	return child
 }
 
+// SzCs returns the <w:szCs> child element, or nil if not present.
+func (e *CT_RPr) SzCs() *CT_HpsMeasure {
+	child := e.FindChild("w:szCs")
+	if child == nil {
+		return nil
+	}
+	return &CT_HpsMeasure{Element{E: child}}
+}
+
+// GetOrAddSzCs returns <w:szCs>, creating it if not present.
+func (e *CT_RPr) GetOrAddSzCs() *CT_HpsMeasure {
+	child := e.SzCs()
+	if child != nil {
+		return child
+	}
+	return e.addSzCs()
+}
+
+// RemoveSzCs removes all <w:szCs> child elements.
+func (e *CT_RPr) RemoveSzCs() {
+	e.RemoveAll("w:szCs")
+}
+
+// addSzCs adds a new <w:szCs> in correct sequence.
+func (e *CT_RPr) addSzCs() *CT_HpsMeasure {
+	child := e.newSzCs()
+	e.insertSzCs(child)
+	return child
+}
+
+// newSzCs creates a detached <w:szCs> element.
+func (e *CT_RPr) newSzCs() *CT_HpsMeasure {
+	el := OxmlElement("w:szCs")
+	return &CT_HpsMeasure{Element{E: el}}
+}
+
+// insertSzCs inserts child before first successor.
+func (e *CT_RPr) insertSzCs(child *CT_HpsMeasure) *CT_HpsMeasure {
+	e.InsertElementBefore(child.E, "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
 // Highlight returns the <w:highlight> child element, or nil if not present.
 func (e *CT_RPr) Highlight() *CT_Highlight {
 	child := e.FindChild("w:highlight")
@@ -940,6 +1152,174 @@ func (e *CT_RPr) insertU(child *CT_Underline) *CT_Underline {
 	return child
 }
 
+// Effect returns the <w:effect> child element, or nil if not present.
+func (e *CT_RPr) Effect() *CT_TextEffect {
+	child := e.FindChild("w:effect")
+	if child == nil {
+		return nil
+	}
+	return &CT_TextEffect{Element{E: child}}
+}
+
+// GetOrAddEffect returns <w:effect>, creating it if not present.
+func (e *CT_RPr) GetOrAddEffect() *CT_TextEffect {
+	child := e.Effect()
+	if child != nil {
+		return child
+	}
+	return e.addEffect()
+}
+
+// RemoveEffect removes all <w:effect> child elements.
+func (e *CT_RPr) RemoveEffect() {
+	e.RemoveAll("w:effect")
+}
+
+// addEffect adds a new <w:effect> in correct sequence.
+func (e *CT_RPr) addEffect() *CT_TextEffect {
+	child := e.newEffect()
+	e.insertEffect(child)
+	return child
+}
+
+// newEffect creates a detached <w:effect> element.
+func (e *CT_RPr) newEffect() *CT_TextEffect {
+	el := OxmlElement("w:effect")
+	return &CT_TextEffect{Element{E: el}}
+}
+
+// insertEffect inserts child before first successor.
+func (e *CT_RPr) insertEffect(child *CT_TextEffect) *CT_TextEffect {
+	e.InsertElementBefore(child.E, "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
+// Bdr returns the <w:bdr> child element, or nil if not present.
+func (e *CT_RPr) Bdr() *CT_Border {
+	child := e.FindChild("w:bdr")
+	if child == nil {
+		return nil
+	}
+	return &CT_Border{Element{E: child}}
+}
+
+// GetOrAddBdr returns <w:bdr>, creating it if not present.
+func (e *CT_RPr) GetOrAddBdr() *CT_Border {
+	child := e.Bdr()
+	if child != nil {
+		return child
+	}
+	return e.addBdr()
+}
+
+// RemoveBdr removes all <w:bdr> child elements.
+func (e *CT_RPr) RemoveBdr() {
+	e.RemoveAll("w:bdr")
+}
+
+// addBdr adds a new <w:bdr> in correct sequence.
+func (e *CT_RPr) addBdr() *CT_Border {
+	child := e.newBdr()
+	e.insertBdr(child)
+	return child
+}
+
+// newBdr creates a detached <w:bdr> element.
+func (e *CT_RPr) newBdr() *CT_Border {
+	el := OxmlElement("w:bdr")
+	return &CT_Border{Element{E: el}}
+}
+
+// insertBdr inserts child before first successor.
+func (e *CT_RPr) insertBdr(child *CT_Border) *CT_Border {
+	e.InsertElementBefore(child.E, "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
+// Shd returns the <w:shd> child element, or nil if not present.
+func (e *CT_RPr) Shd() *CT_Shd {
+	child := e.FindChild("w:shd")
+	if child == nil {
+		return nil
+	}
+	return &CT_Shd{Element{E: child}}
+}
+
+// GetOrAddShd returns <w:shd>, creating it if not present.
+func (e *CT_RPr) GetOrAddShd() *CT_Shd {
+	child := e.Shd()
+	if child != nil {
+		return child
+	}
+	return e.addShd()
+}
+
+// RemoveShd removes all <w:shd> child elements.
+func (e *CT_RPr) RemoveShd() {
+	e.RemoveAll("w:shd")
+}
+
+// addShd adds a new <w:shd> in correct sequence.
+func (e *CT_RPr) addShd() *CT_Shd {
+	child := e.newShd()
+	e.insertShd(child)
+	return child
+}
+
+// newShd creates a detached <w:shd> element.
+func (e *CT_RPr) newShd() *CT_Shd {
+	el := OxmlElement("w:shd")
+	return &CT_Shd{Element{E: el}}
+}
+
+// insertShd inserts child before first successor.
+func (e *CT_RPr) insertShd(child *CT_Shd) *CT_Shd {
+	e.InsertElementBefore(child.E, "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
+// FitText returns the <w:fitText> child element, or nil if not present.
+func (e *CT_RPr) FitText() *CT_FitText {
+	child := e.FindChild("w:fitText")
+	if child == nil {
+		return nil
+	}
+	return &CT_FitText{Element{E: child}}
+}
+
+// GetOrAddFitText returns <w:fitText>, creating it if not present.
+func (e *CT_RPr) GetOrAddFitText() *CT_FitText {
+	child := e.FitText()
+	if child != nil {
+		return child
+	}
+	return e.addFitText()
+}
+
+// RemoveFitText removes all <w:fitText> child elements.
+func (e *CT_RPr) RemoveFitText() {
+	e.RemoveAll("w:fitText")
+}
+
+// addFitText adds a new <w:fitText> in correct sequence.
+func (e *CT_RPr) addFitText() *CT_FitText {
+	child := e.newFitText()
+	e.insertFitText(child)
+	return child
+}
+
+// newFitText creates a detached <w:fitText> element.
+func (e *CT_RPr) newFitText() *CT_FitText {
+	el := OxmlElement("w:fitText")
+	return &CT_FitText{Element{E: el}}
+}
+
+// insertFitText inserts child before first successor.
+func (e *CT_RPr) insertFitText(child *CT_FitText) *CT_FitText {
+	e.InsertElementBefore(child.E, "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
 // VertAlign returns the <w:vertAlign> child element, or nil if not present.
 func (e *CT_RPr) VertAlign() *CT_VerticalAlignRun {
 	child := e.FindChild("w:vertAlign")
@@ -1066,6 +1446,132 @@ func (e *CT_RPr) insertCs(child *CT_OnOff) *CT_OnOff {
 	return child
 }
 
+// Em returns the <w:em> child element, or nil if not present.
+func (e *CT_RPr) Em() *CT_Em {
+	child := e.FindChild("w:em")
+	if child == nil {
+		return nil
+	}
+	return &CT_Em{Element{E: child}}
+}
+
+// GetOrAddEm returns <w:em>, creating it if not present.
+func (e *CT_RPr) GetOrAddEm() *CT_Em {
+	child := e.Em()
+	if child != nil {
+		return child
+	}
+	return e.addEm()
+}
+
+// RemoveEm removes all <w:em> child elements.
+func (e *CT_RPr) RemoveEm() {
+	e.RemoveAll("w:em")
+}
+
+// addEm adds a new <w:em> in correct sequence.
+func (e *CT_RPr) addEm() *CT_Em {
+	child := e.newEm()
+	e.insertEm(child)
+	return child
+}
+
+// newEm creates a detached <w:em> element.
+func (e *CT_RPr) newEm() *CT_Em {
+	el := OxmlElement("w:em")
+	return &CT_Em{Element{E: el}}
+}
+
+// insertEm inserts child before first successor.
+func (e *CT_RPr) insertEm(child *CT_Em) *CT_Em {
+	e.InsertElementBefore(child.E, "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
+// Lang returns the <w:lang> child element, or nil if not present.
+func (e *CT_RPr) Lang() *CT_Language {
+	child := e.FindChild("w:lang")
+	if child == nil {
+		return nil
+	}
+	return &CT_Language{Element{E: child}}
+}
+
+// GetOrAddLang returns <w:lang>, creating it if not present.
+func (e *CT_RPr) GetOrAddLang() *CT_Language {
+	child := e.Lang()
+	if child != nil {
+		return child
+	}
+	return e.addLang()
+}
+
+// RemoveLang removes all <w:lang> child elements.
+func (e *CT_RPr) RemoveLang() {
+	e.RemoveAll("w:lang")
+}
+
+// addLang adds a new <w:lang> in correct sequence.
+func (e *CT_RPr) addLang() *CT_Language {
+	child := e.newLang()
+	e.insertLang(child)
+	return child
+}
+
+// newLang creates a detached <w:lang> element.
+func (e *CT_RPr) newLang() *CT_Language {
+	el := OxmlElement("w:lang")
+	return &CT_Language{Element{E: el}}
+}
+
+// insertLang inserts child before first successor.
+func (e *CT_RPr) insertLang(child *CT_Language) *CT_Language {
+	e.InsertElementBefore(child.E, "w:eastAsianLayout", "w:specVanish", "w:oMath")
+	return child
+}
+
+// EastAsianLayout returns the <w:eastAsianLayout> child element, or nil if not present.
+func (e *CT_RPr) EastAsianLayout() *CT_EastAsianLayout {
+	child := e.FindChild("w:eastAsianLayout")
+	if child == nil {
+		return nil
+	}
+	return &CT_EastAsianLayout{Element{E: child}}
+}
+
+// GetOrAddEastAsianLayout returns <w:eastAsianLayout>, creating it if not present.
+func (e *CT_RPr) GetOrAddEastAsianLayout() *CT_EastAsianLayout {
+	child := e.EastAsianLayout()
+	if child != nil {
+		return child
+	}
+	return e.addEastAsianLayout()
+}
+
+// RemoveEastAsianLayout removes all <w:eastAsianLayout> child elements.
+func (e *CT_RPr) RemoveEastAsianLayout() {
+	e.RemoveAll("w:eastAsianLayout")
+}
+
+// addEastAsianLayout adds a new <w:eastAsianLayout> in correct sequence.
+func (e *CT_RPr) addEastAsianLayout() *CT_EastAsianLayout {
+	child := e.newEastAsianLayout()
+	e.insertEastAsianLayout(child)
+	return child
+}
+
+// newEastAsianLayout creates a detached <w:eastAsianLayout> element.
+func (e *CT_RPr) newEastAsianLayout() *CT_EastAsianLayout {
+	el := OxmlElement("w:eastAsianLayout")
+	return &CT_EastAsianLayout{Element{E: el}}
+}
+
+// insertEastAsianLayout inserts child before first successor.
+func (e *CT_RPr) insertEastAsianLayout(child *CT_EastAsianLayout) *CT_EastAsianLayout {
+	e.InsertElementBefore(child.E, "w:specVanish", "w:oMath")
+	return child
+}
+
 // SpecVanish returns the <w:specVanish> child element, or nil if not present.
 func (e *CT_RPr) SpecVanish() *CT_OnOff {
 	child := e.FindChild("w:specVanish")
@@ -1150,15 +1656,1427 @@ func (e *CT_RPr) insertOMath(child *CT_OnOff) *CT_OnOff {
 	return child
 }
 
-// --- CT_Color ---
-
-// CT_Color — color element
-type CT_Color struct {
-	Element
+// StyleVal returns the "w:val" value of the <w:rStyle> child, or nil if
+// <w:rStyle> is not present.
+func (e *CT_RPr) StyleVal() *string {
+	child := e.RStyle()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := val
+	return &v
+}
+
+// SetStyleVal sets the "w:val" value of the <w:rStyle> child, adding
+// the child if needed. Passing nil removes <w:rStyle>.
+func (e *CT_RPr) SetStyleVal(val *string) {
+	if val == nil {
+		e.RemoveRStyle()
+		return
+	}
+	v := *val
+	child := e.GetOrAddRStyle()
+	child.SetAttr("w:val", v)
+}
+
+// BoldVal returns the "w:val" value of the <w:b> child, or nil if
+// <w:b> is not present. A <w:b> without the attribute is on.
+func (e *CT_RPr) BoldVal() *bool {
+	child := e.B()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetBoldVal sets the "w:val" value of the <w:b> child, adding
+// the child if needed. Passing nil removes <w:b>.
+func (e *CT_RPr) SetBoldVal(val *bool) {
+	if val == nil {
+		e.RemoveB()
+		return
+	}
+	v := *val
+	child := e.GetOrAddB()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// BoldCsVal returns the "w:val" value of the <w:bCs> child, or nil if
+// <w:bCs> is not present. A <w:bCs> without the attribute is on.
+func (e *CT_RPr) BoldCsVal() *bool {
+	child := e.BCs()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetBoldCsVal sets the "w:val" value of the <w:bCs> child, adding
+// the child if needed. Passing nil removes <w:bCs>.
+func (e *CT_RPr) SetBoldCsVal(val *bool) {
+	if val == nil {
+		e.RemoveBCs()
+		return
+	}
+	v := *val
+	child := e.GetOrAddBCs()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// ItalicVal returns the "w:val" value of the <w:i> child, or nil if
+// <w:i> is not present. A <w:i> without the attribute is on.
+func (e *CT_RPr) ItalicVal() *bool {
+	child := e.I()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetItalicVal sets the "w:val" value of the <w:i> child, adding
+// the child if needed. Passing nil removes <w:i>.
+func (e *CT_RPr) SetItalicVal(val *bool) {
+	if val == nil {
+		e.RemoveI()
+		return
+	}
+	v := *val
+	child := e.GetOrAddI()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// ItalicCsVal returns the "w:val" value of the <w:iCs> child, or nil if
+// <w:iCs> is not present. A <w:iCs> without the attribute is on.
+func (e *CT_RPr) ItalicCsVal() *bool {
+	child := e.ICs()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetItalicCsVal sets the "w:val" value of the <w:iCs> child, adding
+// the child if needed. Passing nil removes <w:iCs>.
+func (e *CT_RPr) SetItalicCsVal(val *bool) {
+	if val == nil {
+		e.RemoveICs()
+		return
+	}
+	v := *val
+	child := e.GetOrAddICs()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// CapsVal returns the "w:val" value of the <w:caps> child, or nil if
+// <w:caps> is not present. A <w:caps> without the attribute is on.
+func (e *CT_RPr) CapsVal() *bool {
+	child := e.Caps()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetCapsVal sets the "w:val" value of the <w:caps> child, adding
+// the child if needed. Passing nil removes <w:caps>.
+func (e *CT_RPr) SetCapsVal(val *bool) {
+	if val == nil {
+		e.RemoveCaps()
+		return
+	}
+	v := *val
+	child := e.GetOrAddCaps()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// SmallCapsVal returns the "w:val" value of the <w:smallCaps> child, or nil if
+// <w:smallCaps> is not present. A <w:smallCaps> without the attribute is on.
+func (e *CT_RPr) SmallCapsVal() *bool {
+	child := e.SmallCaps()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetSmallCapsVal sets the "w:val" value of the <w:smallCaps> child, adding
+// the child if needed. Passing nil removes <w:smallCaps>.
+func (e *CT_RPr) SetSmallCapsVal(val *bool) {
+	if val == nil {
+		e.RemoveSmallCaps()
+		return
+	}
+	v := *val
+	child := e.GetOrAddSmallCaps()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// StrikeVal returns the "w:val" value of the <w:strike> child, or nil if
+// <w:strike> is not present. A <w:strike> without the attribute is on.
+func (e *CT_RPr) StrikeVal() *bool {
+	child := e.Strike()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetStrikeVal sets the "w:val" value of the <w:strike> child, adding
+// the child if needed. Passing nil removes <w:strike>.
+func (e *CT_RPr) SetStrikeVal(val *bool) {
+	if val == nil {
+		e.RemoveStrike()
+		return
+	}
+	v := *val
+	child := e.GetOrAddStrike()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// DstrikeVal returns the "w:val" value of the <w:dstrike> child, or nil if
+// <w:dstrike> is not present. A <w:dstrike> without the attribute is on.
+func (e *CT_RPr) DstrikeVal() *bool {
+	child := e.Dstrike()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetDstrikeVal sets the "w:val" value of the <w:dstrike> child, adding
+// the child if needed. Passing nil removes <w:dstrike>.
+func (e *CT_RPr) SetDstrikeVal(val *bool) {
+	if val == nil {
+		e.RemoveDstrike()
+		return
+	}
+	v := *val
+	child := e.GetOrAddDstrike()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// OutlineVal returns the "w:val" value of the <w:outline> child, or nil if
+// <w:outline> is not present. A <w:outline> without the attribute is on.
+func (e *CT_RPr) OutlineVal() *bool {
+	child := e.Outline()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetOutlineVal sets the "w:val" value of the <w:outline> child, adding
+// the child if needed. Passing nil removes <w:outline>.
+func (e *CT_RPr) SetOutlineVal(val *bool) {
+	if val == nil {
+		e.RemoveOutline()
+		return
+	}
+	v := *val
+	child := e.GetOrAddOutline()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// ShadowVal returns the "w:val" value of the <w:shadow> child, or nil if
+// <w:shadow> is not present. A <w:shadow> without the attribute is on.
+func (e *CT_RPr) ShadowVal() *bool {
+	child := e.Shadow()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetShadowVal sets the "w:val" value of the <w:shadow> child, adding
+// the child if needed. Passing nil removes <w:shadow>.
+func (e *CT_RPr) SetShadowVal(val *bool) {
+	if val == nil {
+		e.RemoveShadow()
+		return
+	}
+	v := *val
+	child := e.GetOrAddShadow()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// EmbossVal returns the "w:val" value of the <w:emboss> child, or nil if
+// <w:emboss> is not present. A <w:emboss> without the attribute is on.
+func (e *CT_RPr) EmbossVal() *bool {
+	child := e.Emboss()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetEmbossVal sets the "w:val" value of the <w:emboss> child, adding
+// the child if needed. Passing nil removes <w:emboss>.
+func (e *CT_RPr) SetEmbossVal(val *bool) {
+	if val == nil {
+		e.RemoveEmboss()
+		return
+	}
+	v := *val
+	child := e.GetOrAddEmboss()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// ImprintVal returns the "w:val" value of the <w:imprint> child, or nil if
+// <w:imprint> is not present. A <w:imprint> without the attribute is on.
+func (e *CT_RPr) ImprintVal() *bool {
+	child := e.Imprint()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetImprintVal sets the "w:val" value of the <w:imprint> child, adding
+// the child if needed. Passing nil removes <w:imprint>.
+func (e *CT_RPr) SetImprintVal(val *bool) {
+	if val == nil {
+		e.RemoveImprint()
+		return
+	}
+	v := *val
+	child := e.GetOrAddImprint()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// NoProofVal returns the "w:val" value of the <w:noProof> child, or nil if
+// <w:noProof> is not present. A <w:noProof> without the attribute is on.
+func (e *CT_RPr) NoProofVal() *bool {
+	child := e.NoProof()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetNoProofVal sets the "w:val" value of the <w:noProof> child, adding
+// the child if needed. Passing nil removes <w:noProof>.
+func (e *CT_RPr) SetNoProofVal(val *bool) {
+	if val == nil {
+		e.RemoveNoProof()
+		return
+	}
+	v := *val
+	child := e.GetOrAddNoProof()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// SnapToGridVal returns the "w:val" value of the <w:snapToGrid> child, or nil if
+// <w:snapToGrid> is not present. A <w:snapToGrid> without the attribute is on.
+func (e *CT_RPr) SnapToGridVal() *bool {
+	child := e.SnapToGrid()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetSnapToGridVal sets the "w:val" value of the <w:snapToGrid> child, adding
+// the child if needed. Passing nil removes <w:snapToGrid>.
+func (e *CT_RPr) SetSnapToGridVal(val *bool) {
+	if val == nil {
+		e.RemoveSnapToGrid()
+		return
+	}
+	v := *val
+	child := e.GetOrAddSnapToGrid()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// VanishVal returns the "w:val" value of the <w:vanish> child, or nil if
+// <w:vanish> is not present. A <w:vanish> without the attribute is on.
+func (e *CT_RPr) VanishVal() *bool {
+	child := e.Vanish()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetVanishVal sets the "w:val" value of the <w:vanish> child, adding
+// the child if needed. Passing nil removes <w:vanish>.
+func (e *CT_RPr) SetVanishVal(val *bool) {
+	if val == nil {
+		e.RemoveVanish()
+		return
+	}
+	v := *val
+	child := e.GetOrAddVanish()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// WebHiddenVal returns the "w:val" value of the <w:webHidden> child, or nil if
+// <w:webHidden> is not present. A <w:webHidden> without the attribute is on.
+func (e *CT_RPr) WebHiddenVal() *bool {
+	child := e.WebHidden()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetWebHiddenVal sets the "w:val" value of the <w:webHidden> child, adding
+// the child if needed. Passing nil removes <w:webHidden>.
+func (e *CT_RPr) SetWebHiddenVal(val *bool) {
+	if val == nil {
+		e.RemoveWebHidden()
+		return
+	}
+	v := *val
+	child := e.GetOrAddWebHidden()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// ColorVal returns the "w:val" value of the <w:color> child, or nil if
+// <w:color> is not present.
+func (e *CT_RPr) ColorVal() *string {
+	child := e.Color()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := val
+	return &v
+}
+
+// SetColorVal sets the "w:val" value of the <w:color> child, adding
+// the child if needed. Passing nil removes <w:color>.
+func (e *CT_RPr) SetColorVal(val *string) {
+	if val == nil {
+		e.RemoveColor()
+		return
+	}
+	v := *val
+	child := e.GetOrAddColor()
+	child.SetAttr("w:val", v)
+}
+
+// CharSpacingVal returns the "w:val" value of the <w:spacing> child, or nil if
+// <w:spacing> is not present.
+func (e *CT_RPr) CharSpacingVal() *docx.Length {
+	child := e.Spacing()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := parseTwipsAttr(val)
+	return &v
+}
+
+// SetCharSpacingVal sets the "w:val" value of the <w:spacing> child, adding
+// the child if needed. Passing nil removes <w:spacing>.
+func (e *CT_RPr) SetCharSpacingVal(val *docx.Length) {
+	if val == nil {
+		e.RemoveSpacing()
+		return
+	}
+	v := *val
+	child := e.GetOrAddSpacing()
+	child.SetAttr("w:val", formatTwipsAttr(v))
+}
+
+// ScaleVal returns the "w:val" value of the <w:w> child, or nil if
+// <w:w> is not present.
+func (e *CT_RPr) ScaleVal() *int {
+	child := e.W()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := parseTextScaleAttr(val)
+	return &v
+}
+
+// SetScaleVal sets the "w:val" value of the <w:w> child, adding
+// the child if needed. Passing nil removes <w:w>.
+func (e *CT_RPr) SetScaleVal(val *int) {
+	if val == nil {
+		e.RemoveW()
+		return
+	}
+	v := *val
+	child := e.GetOrAddW()
+	child.SetAttr("w:val", formatTextScaleAttr(v))
+}
+
+// KernVal returns the "w:val" value of the <w:kern> child, or nil if
+// <w:kern> is not present.
+func (e *CT_RPr) KernVal() *docx.Length {
+	child := e.Kern()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := parseHalfPointsAttr(val)
+	return &v
+}
+
+// SetKernVal sets the "w:val" value of the <w:kern> child, adding
+// the child if needed. Passing nil removes <w:kern>.
+func (e *CT_RPr) SetKernVal(val *docx.Length) {
+	if val == nil {
+		e.RemoveKern()
+		return
+	}
+	v := *val
+	child := e.GetOrAddKern()
+	child.SetAttr("w:val", formatHalfPointsAttr(v))
+}
+
+// PositionVal returns the "w:val" value of the <w:position> child, or nil if
+// <w:position> is not present.
+func (e *CT_RPr) PositionVal() *docx.Length {
+	child := e.Position()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := parseHalfPointsAttr(val)
+	return &v
+}
+
+// SetPositionVal sets the "w:val" value of the <w:position> child, adding
+// the child if needed. Passing nil removes <w:position>.
+func (e *CT_RPr) SetPositionVal(val *docx.Length) {
+	if val == nil {
+		e.RemovePosition()
+		return
+	}
+	v := *val
+	child := e.GetOrAddPosition()
+	child.SetAttr("w:val", formatHalfPointsAttr(v))
+}
+
+// SzVal returns the "w:val" value of the <w:sz> child, or nil if
+// <w:sz> is not present.
+func (e *CT_RPr) SzVal() *docx.Length {
+	child := e.Sz()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := parseHalfPointsAttr(val)
+	return &v
+}
+
+// SetSzVal sets the "w:val" value of the <w:sz> child, adding
+// the child if needed. Passing nil removes <w:sz>.
+func (e *CT_RPr) SetSzVal(val *docx.Length) {
+	if val == nil {
+		e.RemoveSz()
+		return
+	}
+	v := *val
+	child := e.GetOrAddSz()
+	child.SetAttr("w:val", formatHalfPointsAttr(v))
+}
+
+// SzCsVal returns the "w:val" value of the <w:szCs> child, or nil if
+// <w:szCs> is not present.
+func (e *CT_RPr) SzCsVal() *docx.Length {
+	child := e.SzCs()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := parseHalfPointsAttr(val)
+	return &v
+}
+
+// SetSzCsVal sets the "w:val" value of the <w:szCs> child, adding
+// the child if needed. Passing nil removes <w:szCs>.
+func (e *CT_RPr) SetSzCsVal(val *docx.Length) {
+	if val == nil {
+		e.RemoveSzCs()
+		return
+	}
+	v := *val
+	child := e.GetOrAddSzCs()
+	child.SetAttr("w:val", formatHalfPointsAttr(v))
+}
+
+// HighlightVal returns the "w:val" value of the <w:highlight> child, or nil if
+// <w:highlight> is not present.
+func (e *CT_RPr) HighlightVal() *string {
+	child := e.Highlight()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := val
+	return &v
+}
+
+// SetHighlightVal sets the "w:val" value of the <w:highlight> child, adding
+// the child if needed. Passing nil removes <w:highlight>.
+func (e *CT_RPr) SetHighlightVal(val *string) {
+	if val == nil {
+		e.RemoveHighlight()
+		return
+	}
+	v := *val
+	child := e.GetOrAddHighlight()
+	child.SetAttr("w:val", v)
+}
+
+// EffectVal returns the "w:val" value of the <w:effect> child, or nil if
+// <w:effect> is not present.
+func (e *CT_RPr) EffectVal() *enum.WdAnimation {
+	child := e.Effect()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := mustParseEnum(val, enum.WdAnimationFromXml)
+	return &v
+}
+
+// SetEffectVal sets the "w:val" value of the <w:effect> child, adding
+// the child if needed. Passing nil removes <w:effect>.
+func (e *CT_RPr) SetEffectVal(val *enum.WdAnimation) {
+	if val == nil {
+		e.RemoveEffect()
+		return
+	}
+	v := *val
+	child := e.GetOrAddEffect()
+	child.SetAttr("w:val", v.ToXml())
+}
+
+// RtlVal returns the "w:val" value of the <w:rtl> child, or nil if
+// <w:rtl> is not present. A <w:rtl> without the attribute is on.
+func (e *CT_RPr) RtlVal() *bool {
+	child := e.Rtl()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetRtlVal sets the "w:val" value of the <w:rtl> child, adding
+// the child if needed. Passing nil removes <w:rtl>.
+func (e *CT_RPr) SetRtlVal(val *bool) {
+	if val == nil {
+		e.RemoveRtl()
+		return
+	}
+	v := *val
+	child := e.GetOrAddRtl()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// ComplexScriptVal returns the "w:val" value of the <w:cs> child, or nil if
+// <w:cs> is not present. A <w:cs> without the attribute is on.
+func (e *CT_RPr) ComplexScriptVal() *bool {
+	child := e.Cs()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetComplexScriptVal sets the "w:val" value of the <w:cs> child, adding
+// the child if needed. Passing nil removes <w:cs>.
+func (e *CT_RPr) SetComplexScriptVal(val *bool) {
+	if val == nil {
+		e.RemoveCs()
+		return
+	}
+	v := *val
+	child := e.GetOrAddCs()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// EmphasisMarkVal returns the "w:val" value of the <w:em> child, or nil if
+// <w:em> is not present.
+func (e *CT_RPr) EmphasisMarkVal() *enum.WdEmphasisMark {
+	child := e.Em()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := mustParseEnum(val, enum.WdEmphasisMarkFromXml)
+	return &v
+}
+
+// SetEmphasisMarkVal sets the "w:val" value of the <w:em> child, adding
+// the child if needed. Passing nil removes <w:em>.
+func (e *CT_RPr) SetEmphasisMarkVal(val *enum.WdEmphasisMark) {
+	if val == nil {
+		e.RemoveEm()
+		return
+	}
+	v := *val
+	child := e.GetOrAddEm()
+	child.SetAttr("w:val", v.ToXml())
+}
+
+// LangVal returns the "w:val" value of the <w:lang> child, or nil if
+// <w:lang> is not present.
+func (e *CT_RPr) LangVal() *string {
+	child := e.Lang()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := val
+	return &v
+}
+
+// SetLangVal sets the "w:val" value of the <w:lang> child, adding
+// the child if needed. Passing nil removes <w:lang>.
+func (e *CT_RPr) SetLangVal(val *string) {
+	if val == nil {
+		e.RemoveLang()
+		return
+	}
+	v := *val
+	child := e.GetOrAddLang()
+	child.SetAttr("w:val", v)
+}
+
+// SpecVanishVal returns the "w:val" value of the <w:specVanish> child, or nil if
+// <w:specVanish> is not present. A <w:specVanish> without the attribute is on.
+func (e *CT_RPr) SpecVanishVal() *bool {
+	child := e.SpecVanish()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetSpecVanishVal sets the "w:val" value of the <w:specVanish> child, adding
+// the child if needed. Passing nil removes <w:specVanish>.
+func (e *CT_RPr) SetSpecVanishVal(val *bool) {
+	if val == nil {
+		e.RemoveSpecVanish()
+		return
+	}
+	v := *val
+	child := e.GetOrAddSpecVanish()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// OMathVal returns the "w:val" value of the <w:oMath> child, or nil if
+// <w:oMath> is not present. A <w:oMath> without the attribute is on.
+func (e *CT_RPr) OMathVal() *bool {
+	child := e.OMath()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetOMathVal sets the "w:val" value of the <w:oMath> child, adding
+// the child if needed. Passing nil removes <w:oMath>.
+func (e *CT_RPr) SetOMathVal(val *bool) {
+	if val == nil {
+		e.RemoveOMath()
+		return
+	}
+	v := *val
+	child := e.GetOrAddOMath()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// --- CT_Color ---
+
+// CT_Color — color element
+type CT_Color struct {
+	Element
+}
+
+// ThemeColor returns the value of the "w:themeColor" attribute, or "" if absent.
+func (e *CT_Color) ThemeColor() string {
+	val, ok := e.GetAttr("w:themeColor")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetThemeColor sets the "w:themeColor" attribute.
+// Passing "" removes it.
+func (e *CT_Color) SetThemeColor(v string) {
+	if v == "" {
+		e.RemoveAttr("w:themeColor")
+		return
+	}
+	e.SetAttr("w:themeColor", v)
+}
+
+// ThemeTint returns the value of the "w:themeTint" attribute, or "" if absent.
+func (e *CT_Color) ThemeTint() string {
+	val, ok := e.GetAttr("w:themeTint")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetThemeTint sets the "w:themeTint" attribute.
+// Passing "" removes it.
+func (e *CT_Color) SetThemeTint(v string) {
+	if v == "" {
+		e.RemoveAttr("w:themeTint")
+		return
+	}
+	e.SetAttr("w:themeTint", v)
+}
+
+// ThemeShade returns the value of the "w:themeShade" attribute, or "" if absent.
+func (e *CT_Color) ThemeShade() string {
+	val, ok := e.GetAttr("w:themeShade")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetThemeShade sets the "w:themeShade" attribute.
+// Passing "" removes it.
+func (e *CT_Color) SetThemeShade(v string) {
+	if v == "" {
+		e.RemoveAttr("w:themeShade")
+		return
+	}
+	e.SetAttr("w:themeShade", v)
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_Color) Val() (*docx.RGBColor, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return nil, fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return parseHexColorAttr(val), nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_Color) SetVal(v *docx.RGBColor) {
+	e.SetAttr("w:val", formatHexColorAttr(v))
+}
+
+// --- CT_Fonts ---
+
+// CT_Fonts — run fonts element
+type CT_Fonts struct {
+	Element
+}
+
+// Ascii returns the value of the "w:ascii" attribute, or "" if absent.
+func (e *CT_Fonts) Ascii() string {
+	val, ok := e.GetAttr("w:ascii")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetAscii sets the "w:ascii" attribute.
+// Passing "" removes it.
+func (e *CT_Fonts) SetAscii(v string) {
+	if v == "" {
+		e.RemoveAttr("w:ascii")
+		return
+	}
+	e.SetAttr("w:ascii", v)
+}
+
+// HAnsi returns the value of the "w:hAnsi" attribute, or "" if absent.
+func (e *CT_Fonts) HAnsi() string {
+	val, ok := e.GetAttr("w:hAnsi")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetHAnsi sets the "w:hAnsi" attribute.
+// Passing "" removes it.
+func (e *CT_Fonts) SetHAnsi(v string) {
+	if v == "" {
+		e.RemoveAttr("w:hAnsi")
+		return
+	}
+	e.SetAttr("w:hAnsi", v)
+}
+
+// EastAsia returns the value of the "w:eastAsia" attribute, or "" if absent.
+func (e *CT_Fonts) EastAsia() string {
+	val, ok := e.GetAttr("w:eastAsia")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetEastAsia sets the "w:eastAsia" attribute.
+// Passing "" removes it.
+func (e *CT_Fonts) SetEastAsia(v string) {
+	if v == "" {
+		e.RemoveAttr("w:eastAsia")
+		return
+	}
+	e.SetAttr("w:eastAsia", v)
+}
+
+// Cs returns the value of the "w:cs" attribute, or "" if absent.
+func (e *CT_Fonts) Cs() string {
+	val, ok := e.GetAttr("w:cs")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetCs sets the "w:cs" attribute.
+// Passing "" removes it.
+func (e *CT_Fonts) SetCs(v string) {
+	if v == "" {
+		e.RemoveAttr("w:cs")
+		return
+	}
+	e.SetAttr("w:cs", v)
+}
+
+// Hint returns the value of the "w:hint" attribute, or "" if absent.
+func (e *CT_Fonts) Hint() string {
+	val, ok := e.GetAttr("w:hint")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetHint sets the "w:hint" attribute.
+// Passing "" removes it.
+func (e *CT_Fonts) SetHint(v string) {
+	if v == "" {
+		e.RemoveAttr("w:hint")
+		return
+	}
+	e.SetAttr("w:hint", v)
+}
+
+// AsciiTheme returns the value of the "w:asciiTheme" attribute, or nil if absent.
+func (e *CT_Fonts) AsciiTheme() *enum.WdThemeFont {
+	val, ok := e.GetAttr("w:asciiTheme")
+	if !ok {
+		return nil
+	}
+	return parseOptionalEnum(val, enum.WdThemeFontFromXml)
+}
+
+// SetAsciiTheme sets the "w:asciiTheme" attribute.
+// Passing nil removes it.
+func (e *CT_Fonts) SetAsciiTheme(v *enum.WdThemeFont) {
+	if v == nil {
+		e.RemoveAttr("w:asciiTheme")
+		return
+	}
+	e.SetAttr("w:asciiTheme", (*v).ToXml())
+}
+
+// HAnsiTheme returns the value of the "w:hAnsiTheme" attribute, or nil if absent.
+func (e *CT_Fonts) HAnsiTheme() *enum.WdThemeFont {
+	val, ok := e.GetAttr("w:hAnsiTheme")
+	if !ok {
+		return nil
+	}
+	return parseOptionalEnum(val, enum.WdThemeFontFromXml)
+}
+
+// SetHAnsiTheme sets the "w:hAnsiTheme" attribute.
+// Passing nil removes it.
+func (e *CT_Fonts) SetHAnsiTheme(v *enum.WdThemeFont) {
+	if v == nil {
+		e.RemoveAttr("w:hAnsiTheme")
+		return
+	}
+	e.SetAttr("w:hAnsiTheme", (*v).ToXml())
+}
+
+// EastAsiaTheme returns the value of the "w:eastAsiaTheme" attribute, or nil if absent.
+func (e *CT_Fonts) EastAsiaTheme() *enum.WdThemeFont {
+	val, ok := e.GetAttr("w:eastAsiaTheme")
+	if !ok {
+		return nil
+	}
+	return parseOptionalEnum(val, enum.WdThemeFontFromXml)
+}
+
+// SetEastAsiaTheme sets the "w:eastAsiaTheme" attribute.
+// Passing nil removes it.
+func (e *CT_Fonts) SetEastAsiaTheme(v *enum.WdThemeFont) {
+	if v == nil {
+		e.RemoveAttr("w:eastAsiaTheme")
+		return
+	}
+	e.SetAttr("w:eastAsiaTheme", (*v).ToXml())
+}
+
+// CsTheme returns the value of the "w:cstheme" attribute, or nil if absent.
+func (e *CT_Fonts) CsTheme() *enum.WdThemeFont {
+	val, ok := e.GetAttr("w:cstheme")
+	if !ok {
+		return nil
+	}
+	return parseOptionalEnum(val, enum.WdThemeFontFromXml)
+}
+
+// SetCsTheme sets the "w:cstheme" attribute.
+// Passing nil removes it.
+func (e *CT_Fonts) SetCsTheme(v *enum.WdThemeFont) {
+	if v == nil {
+		e.RemoveAttr("w:cstheme")
+		return
+	}
+	e.SetAttr("w:cstheme", (*v).ToXml())
+}
+
+// --- CT_Highlight ---
+
+// CT_Highlight — highlight color element
+type CT_Highlight struct {
+	Element
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_Highlight) Val() (string, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return val, nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_Highlight) SetVal(v string) {
+	e.SetAttr("w:val", v)
+}
+
+// --- CT_HpsMeasure ---
+
+// CT_HpsMeasure — half-point size measure element
+type CT_HpsMeasure struct {
+	Element
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_HpsMeasure) Val() (docx.Length, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return parseHalfPointsAttr(val), nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_HpsMeasure) SetVal(v docx.Length) {
+	e.SetAttr("w:val", formatHalfPointsAttr(v))
+}
+
+// --- CT_Underline ---
+
+// CT_Underline — underline element
+type CT_Underline struct {
+	Element
+}
+
+// Val returns the value of the "w:val" attribute, or "" if absent.
+func (e *CT_Underline) Val() string {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetVal sets the "w:val" attribute.
+// Passing "" removes it.
+func (e *CT_Underline) SetVal(v string) {
+	if v == "" {
+		e.RemoveAttr("w:val")
+		return
+	}
+	e.SetAttr("w:val", v)
+}
+
+// --- CT_VerticalAlignRun ---
+
+// CT_VerticalAlignRun — vertical align run element
+type CT_VerticalAlignRun struct {
+	Element
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_VerticalAlignRun) Val() (string, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return val, nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_VerticalAlignRun) SetVal(v string) {
+	e.SetAttr("w:val", v)
+}
+
+// --- CT_SignedTwipsMeasure ---
+
+// CT_SignedTwipsMeasure — signed measurement in twentieths of a point, used for w:spacing in run properties
+type CT_SignedTwipsMeasure struct {
+	Element
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_SignedTwipsMeasure) Val() (docx.Length, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return parseTwipsAttr(val), nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_SignedTwipsMeasure) SetVal(v docx.Length) {
+	e.SetAttr("w:val", formatTwipsAttr(v))
+}
+
+// --- CT_TextScale ---
+
+// CT_TextScale — character scale element, in percent of the normal width
+type CT_TextScale struct {
+	Element
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_TextScale) Val() (int, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return parseTextScaleAttr(val), nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_TextScale) SetVal(v int) {
+	e.SetAttr("w:val", formatTextScaleAttr(v))
+}
+
+// --- CT_SignedHpsMeasure ---
+
+// CT_SignedHpsMeasure — signed half-point measure element, used for w:position
+type CT_SignedHpsMeasure struct {
+	Element
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_SignedHpsMeasure) Val() (docx.Length, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return 0, fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return parseHalfPointsAttr(val), nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_SignedHpsMeasure) SetVal(v docx.Length) {
+	e.SetAttr("w:val", formatHalfPointsAttr(v))
+}
+
+// --- CT_TextEffect ---
+
+// CT_TextEffect — animated text effect element
+type CT_TextEffect struct {
+	Element
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_TextEffect) Val() (enum.WdAnimation, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return enum.WdAnimation(0), fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return mustParseEnum(val, enum.WdAnimationFromXml), nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_TextEffect) SetVal(v enum.WdAnimation) {
+	e.SetAttr("w:val", v.ToXml())
+}
+
+// --- CT_Border ---
+
+// CT_Border — border element
+type CT_Border struct {
+	Element
+}
+
+// Color returns the value of the "w:color" attribute, or nil if absent.
+func (e *CT_Border) Color() *docx.RGBColor {
+	val, ok := e.GetAttr("w:color")
+	if !ok {
+		return nil
+	}
+	return parseHexColorAttr(val)
+}
+
+// SetColor sets the "w:color" attribute.
+// Passing nil removes it.
+func (e *CT_Border) SetColor(v *docx.RGBColor) {
+	if v == nil {
+		e.RemoveAttr("w:color")
+		return
+	}
+	e.SetAttr("w:color", formatHexColorAttr(v))
 }
 
 // ThemeColor returns the value of the "w:themeColor" attribute, or "" if absent.
-func (e *CT_Color) ThemeColor() string {
+func (e *CT_Border) ThemeColor() string {
 	val, ok := e.GetAttr("w:themeColor")
 	if !ok {
 		return ""
@@ -1168,7 +3086,7 @@ func (e *CT_Color) ThemeColor() string {
 
 // SetThemeColor sets the "w:themeColor" attribute.
 // Passing "" removes it.
-func (e *CT_Color) SetThemeColor(v string) {
+func (e *CT_Border) SetThemeColor(v string) {
 	if v == "" {
 		e.RemoveAttr("w:themeColor")
 		return
@@ -1176,8 +3094,122 @@ func (e *CT_Color) SetThemeColor(v string) {
 	e.SetAttr("w:themeColor", v)
 }
 
+// ThemeTint returns the value of the "w:themeTint" attribute, or "" if absent.
+func (e *CT_Border) ThemeTint() string {
+	val, ok := e.GetAttr("w:themeTint")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetThemeTint sets the "w:themeTint" attribute.
+// Passing "" removes it.
+func (e *CT_Border) SetThemeTint(v string) {
+	if v == "" {
+		e.RemoveAttr("w:themeTint")
+		return
+	}
+	e.SetAttr("w:themeTint", v)
+}
+
+// ThemeShade returns the value of the "w:themeShade" attribute, or "" if absent.
+func (e *CT_Border) ThemeShade() string {
+	val, ok := e.GetAttr("w:themeShade")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetThemeShade sets the "w:themeShade" attribute.
+// Passing "" removes it.
+func (e *CT_Border) SetThemeShade(v string) {
+	if v == "" {
+		e.RemoveAttr("w:themeShade")
+		return
+	}
+	e.SetAttr("w:themeShade", v)
+}
+
+// Sz returns the value of the "w:sz" attribute, or nil if absent.
+func (e *CT_Border) Sz() *docx.Length {
+	val, ok := e.GetAttr("w:sz")
+	if !ok {
+		return nil
+	}
+	return parseOptionalAttr(val, parseEighthPoints)
+}
+
+// SetSz sets the "w:sz" attribute.
+// Passing nil removes it.
+func (e *CT_Border) SetSz(v *docx.Length) {
+	if v == nil {
+		e.RemoveAttr("w:sz")
+		return
+	}
+	e.SetAttr("w:sz", formatEighthPointsAttr(*v))
+}
+
+// Space returns the value of the "w:space" attribute, or 0 if absent.
+func (e *CT_Border) Space() int {
+	val, ok := e.GetAttr("w:space")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetSpace sets the "w:space" attribute.
+// Passing 0 removes it.
+func (e *CT_Border) SetSpace(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:space")
+		return
+	}
+	e.SetAttr("w:space", formatIntAttr(v))
+}
+
+// Shadow returns the value of the "w:shadow" attribute, or false if absent.
+func (e *CT_Border) Shadow() bool {
+	val, ok := e.GetAttr("w:shadow")
+	if !ok {
+		return false
+	}
+	return parseOnOffAttr(val)
+}
+
+// SetShadow sets the "w:shadow" attribute.
+// Passing false removes it.
+func (e *CT_Border) SetShadow(v bool) {
+	if v == false {
+		e.RemoveAttr("w:shadow")
+		return
+	}
+	e.SetAttr("w:shadow", formatOnOffAttr(v))
+}
+
+// Frame returns the value of the "w:frame" attribute, or false if absent.
+func (e *CT_Border) Frame() bool {
+	val, ok := e.GetAttr("w:frame")
+	if !ok {
+		return false
+	}
+	return parseOnOffAttr(val)
+}
+
+// SetFrame sets the "w:frame" attribute.
+// Passing false removes it.
+func (e *CT_Border) SetFrame(v bool) {
+	if v == false {
+		e.RemoveAttr("w:frame")
+		return
+	}
+	e.SetAttr("w:frame", formatOnOffAttr(v))
+}
+
 // Val returns the value of the required "w:val" attribute.
-func (e *CT_Color) Val() (string, error) {
+func (e *CT_Border) Val() (string, error) {
 	val, ok := e.GetAttr("w:val")
 	if !ok {
 		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
@@ -1186,64 +3218,171 @@ func (e *CT_Color) Val() (string, error) {
 }
 
 // SetVal sets the required "w:val" attribute.
-func (e *CT_Color) SetVal(v string) {
+func (e *CT_Border) SetVal(v string) {
 	e.SetAttr("w:val", v)
 }
 
-// --- CT_Fonts ---
+// --- CT_Shd ---
 
-// CT_Fonts — run fonts element
-type CT_Fonts struct {
+// CT_Shd — shading element
+type CT_Shd struct {
 	Element
 }
 
-// Ascii returns the value of the "w:ascii" attribute, or "" if absent.
-func (e *CT_Fonts) Ascii() string {
-	val, ok := e.GetAttr("w:ascii")
+// Color returns the value of the "w:color" attribute, or nil if absent.
+func (e *CT_Shd) Color() *docx.RGBColor {
+	val, ok := e.GetAttr("w:color")
+	if !ok {
+		return nil
+	}
+	return parseHexColorAttr(val)
+}
+
+// SetColor sets the "w:color" attribute.
+// Passing nil removes it.
+func (e *CT_Shd) SetColor(v *docx.RGBColor) {
+	if v == nil {
+		e.RemoveAttr("w:color")
+		return
+	}
+	e.SetAttr("w:color", formatHexColorAttr(v))
+}
+
+// ThemeColor returns the value of the "w:themeColor" attribute, or "" if absent.
+func (e *CT_Shd) ThemeColor() string {
+	val, ok := e.GetAttr("w:themeColor")
 	if !ok {
 		return ""
 	}
 	return val
 }
 
-// SetAscii sets the "w:ascii" attribute.
+// SetThemeColor sets the "w:themeColor" attribute.
 // Passing "" removes it.
-func (e *CT_Fonts) SetAscii(v string) {
+func (e *CT_Shd) SetThemeColor(v string) {
 	if v == "" {
-		e.RemoveAttr("w:ascii")
+		e.RemoveAttr("w:themeColor")
 		return
 	}
-	e.SetAttr("w:ascii", v)
+	e.SetAttr("w:themeColor", v)
 }
 
-// HAnsi returns the value of the "w:hAnsi" attribute, or "" if absent.
-func (e *CT_Fonts) HAnsi() string {
-	val, ok := e.GetAttr("w:hAnsi")
+// ThemeTint returns the value of the "w:themeTint" attribute, or "" if absent.
+func (e *CT_Shd) ThemeTint() string {
+	val, ok := e.GetAttr("w:themeTint")
 	if !ok {
 		return ""
 	}
 	return val
 }
 
-// SetHAnsi sets the "w:hAnsi" attribute.
+// SetThemeTint sets the "w:themeTint" attribute.
 // Passing "" removes it.
-func (e *CT_Fonts) SetHAnsi(v string) {
+func (e *CT_Shd) SetThemeTint(v string) {
 	if v == "" {
-		e.RemoveAttr("w:hAnsi")
+		e.RemoveAttr("w:themeTint")
 		return
 	}
-	e.SetAttr("w:hAnsi", v)
+	e.SetAttr("w:themeTint", v)
 }
 
-// --- CT_Highlight ---
+// ThemeShade returns the value of the "w:themeShade" attribute, or "" if absent.
+func (e *CT_Shd) ThemeShade() string {
+	val, ok := e.GetAttr("w:themeShade")
+	if !ok {
+		return ""
+	}
+	return val
+}
 
-// CT_Highlight — highlight color element
-type CT_Highlight struct {
-	Element
+// SetThemeShade sets the "w:themeShade" attribute.
+// Passing "" removes it.
+func (e *CT_Shd) SetThemeShade(v string) {
+	if v == "" {
+		e.RemoveAttr("w:themeShade")
+		return
+	}
+	e.SetAttr("w:themeShade", v)
+}
+
+// Fill returns the value of the "w:fill" attribute, or nil if absent.
+func (e *CT_Shd) Fill() *docx.RGBColor {
+	val, ok := e.GetAttr("w:fill")
+	if !ok {
+		return nil
+	}
+	return parseHexColorAttr(val)
+}
+
+// SetFill sets the "w:fill" attribute.
+// Passing nil removes it.
+func (e *CT_Shd) SetFill(v *docx.RGBColor) {
+	if v == nil {
+		e.RemoveAttr("w:fill")
+		return
+	}
+	e.SetAttr("w:fill", formatHexColorAttr(v))
+}
+
+// ThemeFill returns the value of the "w:themeFill" attribute, or "" if absent.
+func (e *CT_Shd) ThemeFill() string {
+	val, ok := e.GetAttr("w:themeFill")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetThemeFill sets the "w:themeFill" attribute.
+// Passing "" removes it.
+func (e *CT_Shd) SetThemeFill(v string) {
+	if v == "" {
+		e.RemoveAttr("w:themeFill")
+		return
+	}
+	e.SetAttr("w:themeFill", v)
+}
+
+// ThemeFillTint returns the value of the "w:themeFillTint" attribute, or "" if absent.
+func (e *CT_Shd) ThemeFillTint() string {
+	val, ok := e.GetAttr("w:themeFillTint")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetThemeFillTint sets the "w:themeFillTint" attribute.
+// Passing "" removes it.
+func (e *CT_Shd) SetThemeFillTint(v string) {
+	if v == "" {
+		e.RemoveAttr("w:themeFillTint")
+		return
+	}
+	e.SetAttr("w:themeFillTint", v)
+}
+
+// ThemeFillShade returns the value of the "w:themeFillShade" attribute, or "" if absent.
+func (e *CT_Shd) ThemeFillShade() string {
+	val, ok := e.GetAttr("w:themeFillShade")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetThemeFillShade sets the "w:themeFillShade" attribute.
+// Passing "" removes it.
+func (e *CT_Shd) SetThemeFillShade(v string) {
+	if v == "" {
+		e.RemoveAttr("w:themeFillShade")
+		return
+	}
+	e.SetAttr("w:themeFillShade", v)
 }
 
 // Val returns the value of the required "w:val" attribute.
-func (e *CT_Highlight) Val() (string, error) {
+func (e *CT_Shd) Val() (string, error) {
 	val, ok := e.GetAttr("w:val")
 	if !ok {
 		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
@@ -1252,40 +3391,80 @@ func (e *CT_Highlight) Val() (string, error) {
 }
 
 // SetVal sets the required "w:val" attribute.
-func (e *CT_Highlight) SetVal(v string) {
+func (e *CT_Shd) SetVal(v string) {
 	e.SetAttr("w:val", v)
 }
 
-// --- CT_HpsMeasure ---
+// --- CT_FitText ---
 
-// CT_HpsMeasure — half-point size measure element
-type CT_HpsMeasure struct {
+// CT_FitText — fit text element, fitting a run to a fixed width
+type CT_FitText struct {
 	Element
 }
 
+// Id returns the value of the "w:id" attribute, or 0 if absent.
+func (e *CT_FitText) Id() int {
+	val, ok := e.GetAttr("w:id")
+	if !ok {
+		return 0
+	}
+	return parseIntAttr(val)
+}
+
+// SetId sets the "w:id" attribute.
+// Passing 0 removes it.
+func (e *CT_FitText) SetId(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:id")
+		return
+	}
+	e.SetAttr("w:id", formatIntAttr(v))
+}
+
 // Val returns the value of the required "w:val" attribute.
-func (e *CT_HpsMeasure) Val() (int64, error) {
+func (e *CT_FitText) Val() (docx.Length, error) {
 	val, ok := e.GetAttr("w:val")
 	if !ok {
 		return 0, fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
 	}
-	return parseInt64Attr(val), nil
+	return parseTwipsAttr(val), nil
 }
 
 // SetVal sets the required "w:val" attribute.
-func (e *CT_HpsMeasure) SetVal(v int64) {
-	e.SetAttr("w:val", formatInt64Attr(v))
+func (e *CT_FitText) SetVal(v docx.Length) {
+	e.SetAttr("w:val", formatTwipsAttr(v))
 }
 
-// --- CT_Underline ---
+// --- CT_Em ---
 
-// CT_Underline — underline element
-type CT_Underline struct {
+// CT_Em — emphasis mark element
+type CT_Em struct {
+	Element
+}
+
+// Val returns the value of the required "w:val" attribute.
+func (e *CT_Em) Val() (enum.WdEmphasisMark, error) {
+	val, ok := e.GetAttr("w:val")
+	if !ok {
+		return enum.WdEmphasisMark(0), fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+	}
+	return mustParseEnum(val, enum.WdEmphasisMarkFromXml), nil
+}
+
+// SetVal sets the required "w:val" attribute.
+func (e *CT_Em) SetVal(v enum.WdEmphasisMark) {
+	e.SetAttr("w:val", v.ToXml())
+}
+
+// --- CT_Language ---
+
+// CT_Language — language element
+type CT_Language struct {
 	Element
 }
 
 // Val returns the value of the "w:val" attribute, or "" if absent.
-func (e *CT_Underline) Val() string {
+func (e *CT_Language) Val() string {
 	val, ok := e.GetAttr("w:val")
 	if !ok {
 		return ""
@@ -1295,7 +3474,7 @@ func (e *CT_Underline) Val() string {
 
 // SetVal sets the "w:val" attribute.
 // Passing "" removes it.
-func (e *CT_Underline) SetVal(v string) {
+func (e *CT_Language) SetVal(v string) {
 	if v == "" {
 		e.RemoveAttr("w:val")
 		return
@@ -1303,23 +3482,319 @@ func (e *CT_Underline) SetVal(v string) {
 	e.SetAttr("w:val", v)
 }
 
-// --- CT_VerticalAlignRun ---
+// EastAsia returns the value of the "w:eastAsia" attribute, or "" if absent.
+func (e *CT_Language) EastAsia() string {
+	val, ok := e.GetAttr("w:eastAsia")
+	if !ok {
+		return ""
+	}
+	return val
+}
 
-// CT_VerticalAlignRun — vertical align run element
-type CT_VerticalAlignRun struct {
+// SetEastAsia sets the "w:eastAsia" attribute.
+// Passing "" removes it.
+func (e *CT_Language) SetEastAsia(v string) {
+	if v == "" {
+		e.RemoveAttr("w:eastAsia")
+		return
+	}
+	e.SetAttr("w:eastAsia", v)
+}
+
+// Bidi returns the value of the "w:bidi" attribute, or "" if absent.
+func (e *CT_Language) Bidi() string {
+	val, ok := e.GetAttr("w:bidi")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetBidi sets the "w:bidi" attribute.
+// Passing "" removes it.
+func (e *CT_Language) SetBidi(v string) {
+	if v == "" {
+		e.RemoveAttr("w:bidi")
+		return
+	}
+	e.SetAttr("w:bidi", v)
+}
+
+// --- CT_EastAsianLayout ---
+
+// CT_EastAsianLayout — East Asian typography element
+type CT_EastAsianLayout struct {
 	Element
 }
 
-// Val returns the value of the required "w:val" attribute.
-func (e *CT_VerticalAlignRun) Val() (string, error) {
-	val, ok := e.GetAttr("w:val")
+// Id returns the value of the "w:id" attribute, or 0 if absent.
+func (e *CT_EastAsianLayout) Id() int {
+	val, ok := e.GetAttr("w:id")
 	if !ok {
-		return "", fmt.Errorf("required attribute %q not present on <%s>", "w:val", e.Tag())
+		return 0
 	}
-	return val, nil
+	return parseIntAttr(val)
 }
 
-// SetVal sets the required "w:val" attribute.
-func (e *CT_VerticalAlignRun) SetVal(v string) {
-	e.SetAttr("w:val", v)
+// SetId sets the "w:id" attribute.
+// Passing 0 removes it.
+func (e *CT_EastAsianLayout) SetId(v int) {
+	if v == 0 {
+		e.RemoveAttr("w:id")
+		return
+	}
+	e.SetAttr("w:id", formatIntAttr(v))
+}
+
+// Combine returns the value of the "w:combine" attribute, or false if absent.
+func (e *CT_EastAsianLayout) Combine() bool {
+	val, ok := e.GetAttr("w:combine")
+	if !ok {
+		return false
+	}
+	return parseOnOffAttr(val)
+}
+
+// SetCombine sets the "w:combine" attribute.
+// Passing false removes it.
+func (e *CT_EastAsianLayout) SetCombine(v bool) {
+	if v == false {
+		e.RemoveAttr("w:combine")
+		return
+	}
+	e.SetAttr("w:combine", formatOnOffAttr(v))
+}
+
+// CombineBrackets returns the value of the "w:combineBrackets" attribute, or "" if absent.
+func (e *CT_EastAsianLayout) CombineBrackets() string {
+	val, ok := e.GetAttr("w:combineBrackets")
+	if !ok {
+		return ""
+	}
+	return val
+}
+
+// SetCombineBrackets sets the "w:combineBrackets" attribute.
+// Passing "" removes it.
+func (e *CT_EastAsianLayout) SetCombineBrackets(v string) {
+	if v == "" {
+		e.RemoveAttr("w:combineBrackets")
+		return
+	}
+	e.SetAttr("w:combineBrackets", v)
+}
+
+// Vert returns the value of the "w:vert" attribute, or false if absent.
+func (e *CT_EastAsianLayout) Vert() bool {
+	val, ok := e.GetAttr("w:vert")
+	if !ok {
+		return false
+	}
+	return parseOnOffAttr(val)
+}
+
+// SetVert sets the "w:vert" attribute.
+// Passing false removes it.
+func (e *CT_EastAsianLayout) SetVert(v bool) {
+	if v == false {
+		e.RemoveAttr("w:vert")
+		return
+	}
+	e.SetAttr("w:vert", formatOnOffAttr(v))
+}
+
+// VertCompress returns the value of the "w:vertCompress" attribute, or false if absent.
+func (e *CT_EastAsianLayout) VertCompress() bool {
+	val, ok := e.GetAttr("w:vertCompress")
+	if !ok {
+		return false
+	}
+	return parseOnOffAttr(val)
+}
+
+// SetVertCompress sets the "w:vertCompress" attribute.
+// Passing false removes it.
+func (e *CT_EastAsianLayout) SetVertCompress(v bool) {
+	if v == false {
+		e.RemoveAttr("w:vertCompress")
+		return
+	}
+	e.SetAttr("w:vertCompress", formatOnOffAttr(v))
+}
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_RPr",
+		tag:  "w:rPr",
+		wrap: func(el Element) Node { return &CT_RPr{el} },
+		children: []childMeta{
+			{tag: "w:rStyle", typ: "CT_String", card: cardZeroOrOne, successors: []string{"w:rFonts", "w:b", "w:bCs", "w:i", "w:iCs", "w:caps", "w:smallCaps", "w:strike", "w:dstrike", "w:outline", "w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:rFonts", typ: "CT_Fonts", card: cardZeroOrOne, successors: []string{"w:b", "w:bCs", "w:i", "w:iCs", "w:caps", "w:smallCaps", "w:strike", "w:dstrike", "w:outline", "w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:b", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:bCs", "w:i", "w:iCs", "w:caps", "w:smallCaps", "w:strike", "w:dstrike", "w:outline", "w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:bCs", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:i", "w:iCs", "w:caps", "w:smallCaps", "w:strike", "w:dstrike", "w:outline", "w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:i", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:iCs", "w:caps", "w:smallCaps", "w:strike", "w:dstrike", "w:outline", "w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:iCs", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:caps", "w:smallCaps", "w:strike", "w:dstrike", "w:outline", "w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:caps", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:smallCaps", "w:strike", "w:dstrike", "w:outline", "w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:smallCaps", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:strike", "w:dstrike", "w:outline", "w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:strike", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:dstrike", "w:outline", "w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:dstrike", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:outline", "w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:outline", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:shadow", "w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:shadow", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:emboss", "w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:emboss", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:imprint", "w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:imprint", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:noProof", "w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:noProof", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:snapToGrid", "w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:snapToGrid", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:vanish", "w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:vanish", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:webHidden", "w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:webHidden", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:color", "w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:color", typ: "CT_Color", card: cardZeroOrOne, successors: []string{"w:spacing", "w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:spacing", typ: "CT_SignedTwipsMeasure", card: cardZeroOrOne, successors: []string{"w:w", "w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:w", typ: "CT_TextScale", card: cardZeroOrOne, successors: []string{"w:kern", "w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:kern", typ: "CT_HpsMeasure", card: cardZeroOrOne, successors: []string{"w:position", "w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:position", typ: "CT_SignedHpsMeasure", card: cardZeroOrOne, successors: []string{"w:sz", "w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:sz", typ: "CT_HpsMeasure", card: cardZeroOrOne, successors: []string{"w:szCs", "w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:szCs", typ: "CT_HpsMeasure", card: cardZeroOrOne, successors: []string{"w:highlight", "w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:highlight", typ: "CT_Highlight", card: cardZeroOrOne, successors: []string{"w:u", "w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:u", typ: "CT_Underline", card: cardZeroOrOne, successors: []string{"w:effect", "w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:effect", typ: "CT_TextEffect", card: cardZeroOrOne, successors: []string{"w:bdr", "w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:bdr", typ: "CT_Border", card: cardZeroOrOne, successors: []string{"w:shd", "w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:shd", typ: "CT_Shd", card: cardZeroOrOne, successors: []string{"w:fitText", "w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:fitText", typ: "CT_FitText", card: cardZeroOrOne, successors: []string{"w:vertAlign", "w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:vertAlign", typ: "CT_VerticalAlignRun", card: cardZeroOrOne, successors: []string{"w:rtl", "w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:rtl", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:cs", "w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:cs", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:em", "w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:em", typ: "CT_Em", card: cardZeroOrOne, successors: []string{"w:lang", "w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:lang", typ: "CT_Language", card: cardZeroOrOne, successors: []string{"w:eastAsianLayout", "w:specVanish", "w:oMath"}},
+			{tag: "w:eastAsianLayout", typ: "CT_EastAsianLayout", card: cardZeroOrOne, successors: []string{"w:specVanish", "w:oMath"}},
+			{tag: "w:specVanish", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:oMath"}},
+			{tag: "w:oMath", typ: "CT_OnOff", card: cardZeroOrOne},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Color",
+		tag:  "w:color",
+		wrap: func(el Element) Node { return &CT_Color{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkValueAttr(parseHexColor)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Fonts",
+		tag:  "w:rFonts",
+		wrap: func(el Element) Node { return &CT_Fonts{el} },
+		attributes: []attrMeta{
+			{name: "w:asciiTheme", check: checkEnumAttr(enum.WdThemeFontFromXml)},
+			{name: "w:hAnsiTheme", check: checkEnumAttr(enum.WdThemeFontFromXml)},
+			{name: "w:eastAsiaTheme", check: checkEnumAttr(enum.WdThemeFontFromXml)},
+			{name: "w:cstheme", check: checkEnumAttr(enum.WdThemeFontFromXml)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Highlight",
+		tag:  "w:highlight",
+		wrap: func(el Element) Node { return &CT_Highlight{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_HpsMeasure",
+		tag:  "w:sz",
+		wrap: func(el Element) Node { return &CT_HpsMeasure{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkValueAttr(parseHalfPoints)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Underline",
+		tag:  "w:u",
+		wrap: func(el Element) Node { return &CT_Underline{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_VerticalAlignRun",
+		tag:  "w:vertAlign",
+		wrap: func(el Element) Node { return &CT_VerticalAlignRun{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_SignedTwipsMeasure",
+		tag:  "w:signedTwipsMeasure",
+		wrap: func(el Element) Node { return &CT_SignedTwipsMeasure{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkValueAttr(parseTwips)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TextScale",
+		tag:  "w:w",
+		wrap: func(el Element) Node { return &CT_TextScale{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkValueAttr(parseTextScale)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_SignedHpsMeasure",
+		tag:  "w:position",
+		wrap: func(el Element) Node { return &CT_SignedHpsMeasure{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkValueAttr(parseHalfPoints)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TextEffect",
+		tag:  "w:effect",
+		wrap: func(el Element) Node { return &CT_TextEffect{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkEnumAttr(enum.WdAnimationFromXml)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Border",
+		tag:  "w:bdr",
+		wrap: func(el Element) Node { return &CT_Border{el} },
+		attributes: []attrMeta{
+			{name: "w:color", check: checkValueAttr(parseHexColor)},
+			{name: "w:sz", check: checkValueAttr(parseEighthPoints)},
+			{name: "w:space", check: checkIntAttr},
+			{name: "w:shadow", check: checkValueAttr(parseOnOff)},
+			{name: "w:frame", check: checkValueAttr(parseOnOff)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Shd",
+		tag:  "w:shd",
+		wrap: func(el Element) Node { return &CT_Shd{el} },
+		attributes: []attrMeta{
+			{name: "w:color", check: checkValueAttr(parseHexColor)},
+			{name: "w:fill", check: checkValueAttr(parseHexColor)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_FitText",
+		tag:  "w:fitText",
+		wrap: func(el Element) Node { return &CT_FitText{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkValueAttr(parseTwips)},
+			{name: "w:id", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Em",
+		tag:  "w:em",
+		wrap: func(el Element) Node { return &CT_Em{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkEnumAttr(enum.WdEmphasisMarkFromXml)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Language",
+		tag:  "w:lang",
+		wrap: func(el Element) Node { return &CT_Language{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_EastAsianLayout",
+		tag:  "w:eastAsianLayout",
+		wrap: func(el Element) Node { return &CT_EastAsianLayout{el} },
+		attributes: []attrMeta{
+			{name: "w:id", check: checkIntAttr},
+			{name: "w:combine", check: checkValueAttr(parseOnOff)},
+			{name: "w:vert", check: checkValueAttr(parseOnOff)},
+			{name: "w:vertCompress", check: checkValueAttr(parseOnOff)},
+		},
+	})
 }
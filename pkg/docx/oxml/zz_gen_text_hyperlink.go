@@ -106,3 +106,17 @@ func (e *CT_Hyperlink) SetHistory(v bool) {
 	}
 	e.SetAttr("w:history", formatBoolAttr(v))
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_Hyperlink",
+		tag:  "w:hyperlink",
+		wrap: func(el Element) Node { return &CT_Hyperlink{el} },
+		children: []childMeta{
+			{tag: "w:r", typ: "CT_R", card: cardZeroOrMore},
+		},
+		attributes: []attrMeta{
+			{name: "w:history", check: checkBoolAttr},
+		},
+	})
+}
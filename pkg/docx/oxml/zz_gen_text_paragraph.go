@@ -125,3 +125,16 @@ func (e *CT_P) insertR(child *CT_R) *CT_R {
 	e.InsertElementBefore(child.E)
 	return child
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_P",
+		tag:  "w:p",
+		wrap: func(el Element) Node { return &CT_P{el} },
+		children: []childMeta{
+			{tag: "w:pPr", typ: "CT_PPr", card: cardZeroOrOne, successors: []string{"w:hyperlink", "w:r"}},
+			{tag: "w:hyperlink", typ: "CT_Hyperlink", card: cardZeroOrMore},
+			{tag: "w:r", typ: "CT_R", card: cardZeroOrMore},
+		},
+	})
+}
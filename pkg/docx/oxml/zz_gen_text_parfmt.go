@@ -4,6 +4,7 @@ package oxml
 
 import (
 	"fmt"
+	"github.com/user/go-docx/pkg/docx"
 	"github.com/user/go-docx/pkg/docx/enum"
 )
 
@@ -521,6 +522,188 @@ func (e *CT_PPr) insertSectPr(child *CT_SectPr) *CT_SectPr {
 	return child
 }
 
+// StyleVal returns the "w:val" value of the <w:pStyle> child, or nil if
+// <w:pStyle> is not present.
+func (e *CT_PPr) StyleVal() *string {
+	child := e.PStyle()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := val
+	return &v
+}
+
+// SetStyleVal sets the "w:val" value of the <w:pStyle> child, adding
+// the child if needed. Passing nil removes <w:pStyle>.
+func (e *CT_PPr) SetStyleVal(val *string) {
+	if val == nil {
+		e.RemovePStyle()
+		return
+	}
+	v := *val
+	child := e.GetOrAddPStyle()
+	child.SetAttr("w:val", v)
+}
+
+// KeepNextVal returns the "w:val" value of the <w:keepNext> child, or nil if
+// <w:keepNext> is not present. A <w:keepNext> without the attribute is on.
+func (e *CT_PPr) KeepNextVal() *bool {
+	child := e.KeepNext()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetKeepNextVal sets the "w:val" value of the <w:keepNext> child, adding
+// the child if needed. Passing nil removes <w:keepNext>.
+func (e *CT_PPr) SetKeepNextVal(val *bool) {
+	if val == nil {
+		e.RemoveKeepNext()
+		return
+	}
+	v := *val
+	child := e.GetOrAddKeepNext()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// KeepLinesVal returns the "w:val" value of the <w:keepLines> child, or nil if
+// <w:keepLines> is not present. A <w:keepLines> without the attribute is on.
+func (e *CT_PPr) KeepLinesVal() *bool {
+	child := e.KeepLines()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetKeepLinesVal sets the "w:val" value of the <w:keepLines> child, adding
+// the child if needed. Passing nil removes <w:keepLines>.
+func (e *CT_PPr) SetKeepLinesVal(val *bool) {
+	if val == nil {
+		e.RemoveKeepLines()
+		return
+	}
+	v := *val
+	child := e.GetOrAddKeepLines()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// PageBreakBeforeVal returns the "w:val" value of the <w:pageBreakBefore> child, or nil if
+// <w:pageBreakBefore> is not present. A <w:pageBreakBefore> without the attribute is on.
+func (e *CT_PPr) PageBreakBeforeVal() *bool {
+	child := e.PageBreakBefore()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetPageBreakBeforeVal sets the "w:val" value of the <w:pageBreakBefore> child, adding
+// the child if needed. Passing nil removes <w:pageBreakBefore>.
+func (e *CT_PPr) SetPageBreakBeforeVal(val *bool) {
+	if val == nil {
+		e.RemovePageBreakBefore()
+		return
+	}
+	v := *val
+	child := e.GetOrAddPageBreakBefore()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// WidowControlVal returns the "w:val" value of the <w:widowControl> child, or nil if
+// <w:widowControl> is not present. A <w:widowControl> without the attribute is on.
+func (e *CT_PPr) WidowControlVal() *bool {
+	child := e.WidowControl()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		v := true
+		return &v
+	}
+	v := parseBoolAttr(val)
+	return &v
+}
+
+// SetWidowControlVal sets the "w:val" value of the <w:widowControl> child, adding
+// the child if needed. Passing nil removes <w:widowControl>.
+func (e *CT_PPr) SetWidowControlVal(val *bool) {
+	if val == nil {
+		e.RemoveWidowControl()
+		return
+	}
+	v := *val
+	child := e.GetOrAddWidowControl()
+	if v {
+		child.RemoveAttr("w:val")
+		return
+	}
+	child.SetAttr("w:val", formatBoolAttr(v))
+}
+
+// JcVal returns the "w:val" value of the <w:jc> child, or nil if
+// <w:jc> is not present.
+func (e *CT_PPr) JcVal() *enum.WdParagraphAlignment {
+	child := e.Jc()
+	if child == nil {
+		return nil
+	}
+	val, ok := child.GetAttr("w:val")
+	if !ok {
+		return nil
+	}
+	v := mustParseEnum(val, enum.WdParagraphAlignmentFromXml)
+	return &v
+}
+
+// SetJcVal sets the "w:val" value of the <w:jc> child, adding
+// the child if needed. Passing nil removes <w:jc>.
+func (e *CT_PPr) SetJcVal(val *enum.WdParagraphAlignment) {
+	if val == nil {
+		e.RemoveJc()
+		return
+	}
+	v := *val
+	child := e.GetOrAddJc()
+	child.SetAttr("w:val", v.ToXml())
+}
+
 // --- CT_Ind ---
 
 // CT_Ind — indentation element
@@ -528,80 +711,80 @@ type CT_Ind struct {
 	Element
 }
 
-// Left returns the value of the "w:left" attribute, or 0 if absent.
-func (e *CT_Ind) Left() int {
+// Left returns the value of the "w:left" attribute, or nil if absent.
+func (e *CT_Ind) Left() *docx.Length {
 	val, ok := e.GetAttr("w:left")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetLeft sets the "w:left" attribute.
-// Passing 0 removes it.
-func (e *CT_Ind) SetLeft(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_Ind) SetLeft(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:left")
 		return
 	}
-	e.SetAttr("w:left", formatIntAttr(v))
+	e.SetAttr("w:left", formatTwipsAttr(*v))
 }
 
-// Right returns the value of the "w:right" attribute, or 0 if absent.
-func (e *CT_Ind) Right() int {
+// Right returns the value of the "w:right" attribute, or nil if absent.
+func (e *CT_Ind) Right() *docx.Length {
 	val, ok := e.GetAttr("w:right")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetRight sets the "w:right" attribute.
-// Passing 0 removes it.
-func (e *CT_Ind) SetRight(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_Ind) SetRight(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:right")
 		return
 	}
-	e.SetAttr("w:right", formatIntAttr(v))
+	e.SetAttr("w:right", formatTwipsAttr(*v))
 }
 
-// FirstLine returns the value of the "w:firstLine" attribute, or 0 if absent.
-func (e *CT_Ind) FirstLine() int {
+// FirstLine returns the value of the "w:firstLine" attribute, or nil if absent.
+func (e *CT_Ind) FirstLine() *docx.Length {
 	val, ok := e.GetAttr("w:firstLine")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetFirstLine sets the "w:firstLine" attribute.
-// Passing 0 removes it.
-func (e *CT_Ind) SetFirstLine(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_Ind) SetFirstLine(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:firstLine")
 		return
 	}
-	e.SetAttr("w:firstLine", formatIntAttr(v))
+	e.SetAttr("w:firstLine", formatTwipsAttr(*v))
 }
 
-// Hanging returns the value of the "w:hanging" attribute, or 0 if absent.
-func (e *CT_Ind) Hanging() int {
+// Hanging returns the value of the "w:hanging" attribute, or nil if absent.
+func (e *CT_Ind) Hanging() *docx.Length {
 	val, ok := e.GetAttr("w:hanging")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetHanging sets the "w:hanging" attribute.
-// Passing 0 removes it.
-func (e *CT_Ind) SetHanging(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_Ind) SetHanging(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:hanging")
 		return
 	}
-	e.SetAttr("w:hanging", formatIntAttr(v))
+	e.SetAttr("w:hanging", formatTwipsAttr(*v))
 }
 
 // --- CT_Jc ---
@@ -632,42 +815,42 @@ type CT_Spacing struct {
 	Element
 }
 
-// After returns the value of the "w:after" attribute, or 0 if absent.
-func (e *CT_Spacing) After() int {
+// After returns the value of the "w:after" attribute, or nil if absent.
+func (e *CT_Spacing) After() *docx.Length {
 	val, ok := e.GetAttr("w:after")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetAfter sets the "w:after" attribute.
-// Passing 0 removes it.
-func (e *CT_Spacing) SetAfter(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_Spacing) SetAfter(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:after")
 		return
 	}
-	e.SetAttr("w:after", formatIntAttr(v))
+	e.SetAttr("w:after", formatTwipsAttr(*v))
 }
 
-// Before returns the value of the "w:before" attribute, or 0 if absent.
-func (e *CT_Spacing) Before() int {
+// Before returns the value of the "w:before" attribute, or nil if absent.
+func (e *CT_Spacing) Before() *docx.Length {
 	val, ok := e.GetAttr("w:before")
 	if !ok {
-		return 0
+		return nil
 	}
-	return parseIntAttr(val)
+	return parseOptionalAttr(val, parseTwips)
 }
 
 // SetBefore sets the "w:before" attribute.
-// Passing 0 removes it.
-func (e *CT_Spacing) SetBefore(v int) {
-	if v == 0 {
+// Passing nil removes it.
+func (e *CT_Spacing) SetBefore(v *docx.Length) {
+	if v == nil {
 		e.RemoveAttr("w:before")
 		return
 	}
-	e.SetAttr("w:before", formatIntAttr(v))
+	e.SetAttr("w:before", formatTwipsAttr(*v))
 }
 
 // Line returns the value of the "w:line" attribute, or 0 if absent.
@@ -894,3 +1077,81 @@ func (e *CT_NumPr) insertNumId(child *CT_DecimalNumber) *CT_DecimalNumber {
 	e.InsertElementBefore(child.E, "w:numberingChange", "w:ins")
 	return child
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_PPr",
+		tag:  "w:pPr",
+		wrap: func(el Element) Node { return &CT_PPr{el} },
+		children: []childMeta{
+			{tag: "w:pStyle", typ: "CT_String", card: cardZeroOrOne, successors: []string{"w:keepNext", "w:keepLines", "w:pageBreakBefore", "w:framePr", "w:widowControl", "w:numPr", "w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:keepNext", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:keepLines", "w:pageBreakBefore", "w:framePr", "w:widowControl", "w:numPr", "w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:keepLines", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:pageBreakBefore", "w:framePr", "w:widowControl", "w:numPr", "w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:pageBreakBefore", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:framePr", "w:widowControl", "w:numPr", "w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:widowControl", typ: "CT_OnOff", card: cardZeroOrOne, successors: []string{"w:numPr", "w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:numPr", typ: "CT_NumPr", card: cardZeroOrOne, successors: []string{"w:suppressLineNumbers", "w:pBdr", "w:shd", "w:tabs", "w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:tabs", typ: "CT_TabStops", card: cardZeroOrOne, successors: []string{"w:suppressAutoHyphens", "w:kinsoku", "w:wordWrap", "w:overflowPunct", "w:topLinePunct", "w:autoSpaceDE", "w:autoSpaceDN", "w:bidi", "w:adjustRightInd", "w:snapToGrid", "w:spacing", "w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:spacing", typ: "CT_Spacing", card: cardZeroOrOne, successors: []string{"w:ind", "w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:ind", typ: "CT_Ind", card: cardZeroOrOne, successors: []string{"w:contextualSpacing", "w:mirrorIndents", "w:suppressOverlap", "w:jc", "w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:jc", typ: "CT_Jc", card: cardZeroOrOne, successors: []string{"w:textDirection", "w:textAlignment", "w:textboxTightWrap", "w:outlineLvl", "w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:outlineLvl", typ: "CT_DecimalNumber", card: cardZeroOrOne, successors: []string{"w:divId", "w:cnfStyle", "w:rPr", "w:sectPr", "w:pPrChange"}},
+			{tag: "w:sectPr", typ: "CT_SectPr", card: cardZeroOrOne, successors: []string{"w:pPrChange"}},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Ind",
+		tag:  "w:ind",
+		wrap: func(el Element) Node { return &CT_Ind{el} },
+		attributes: []attrMeta{
+			{name: "w:left", check: checkValueAttr(parseTwips)},
+			{name: "w:right", check: checkValueAttr(parseTwips)},
+			{name: "w:firstLine", check: checkValueAttr(parseTwips)},
+			{name: "w:hanging", check: checkValueAttr(parseTwips)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Jc",
+		tag:  "w:jc",
+		wrap: func(el Element) Node { return &CT_Jc{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkEnumAttr(enum.WdParagraphAlignmentFromXml)},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Spacing",
+		tag:  "w:spacing",
+		wrap: func(el Element) Node { return &CT_Spacing{el} },
+		attributes: []attrMeta{
+			{name: "w:after", check: checkValueAttr(parseTwips)},
+			{name: "w:before", check: checkValueAttr(parseTwips)},
+			{name: "w:line", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TabStop",
+		tag:  "w:tab",
+		wrap: func(el Element) Node { return &CT_TabStop{el} },
+		attributes: []attrMeta{
+			{name: "w:val", check: checkEnumAttr(enum.WdTabAlignmentFromXml)},
+			{name: "w:leader", check: checkEnumAttr(enum.WdTabLeaderFromXml)},
+			{name: "w:pos", check: checkIntAttr},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_TabStops",
+		tag:  "w:tabs",
+		wrap: func(el Element) Node { return &CT_TabStops{el} },
+		children: []childMeta{
+			{tag: "w:tab", typ: "CT_TabStop", card: cardOneOrMore},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_NumPr",
+		tag:  "w:numPr",
+		wrap: func(el Element) Node { return &CT_NumPr{el} },
+		children: []childMeta{
+			{tag: "w:ilvl", typ: "CT_DecimalNumber", card: cardZeroOrOne, successors: []string{"w:numId", "w:numberingChange", "w:ins"}},
+			{tag: "w:numId", typ: "CT_DecimalNumber", card: cardZeroOrOne, successors: []string{"w:numberingChange", "w:ins"}},
+		},
+	})
+}
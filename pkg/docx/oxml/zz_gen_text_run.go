@@ -300,3 +300,44 @@ type CT_PTab struct {
 type CT_Text struct {
 	Element
 }
+
+func init() {
+	registerElementMeta(&elementMeta{
+		name: "CT_R",
+		tag:  "w:r",
+		wrap: func(el Element) Node { return &CT_R{el} },
+		children: []childMeta{
+			{tag: "w:rPr", typ: "CT_RPr", card: cardZeroOrOne, successors: []string{"w:br", "w:cr", "w:drawing", "w:noBreakHyphen", "w:ptab", "w:t", "w:tab"}},
+			{tag: "w:br", typ: "CT_Br", card: cardZeroOrMore},
+			{tag: "w:cr", typ: "CT_Cr", card: cardZeroOrMore},
+			{tag: "w:drawing", typ: "CT_Drawing", card: cardZeroOrMore},
+			{tag: "w:t", typ: "CT_Text", card: cardZeroOrMore},
+			{tag: "w:tab", typ: "CT_TabStop", card: cardZeroOrMore},
+		},
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Br",
+		tag:  "w:br",
+		wrap: func(el Element) Node { return &CT_Br{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Cr",
+		tag:  "w:cr",
+		wrap: func(el Element) Node { return &CT_Cr{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_NoBreakHyphen",
+		tag:  "w:noBreakHyphen",
+		wrap: func(el Element) Node { return &CT_NoBreakHyphen{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_PTab",
+		tag:  "w:ptab",
+		wrap: func(el Element) Node { return &CT_PTab{el} },
+	})
+	registerElementMeta(&elementMeta{
+		name: "CT_Text",
+		tag:  "w:t",
+		wrap: func(el Element) Node { return &CT_Text{el} },
+	})
+}